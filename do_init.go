@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/bootstrap"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/code"
+)
+
+const (
+	defaultSpecOut = "openapi.yaml"
+	starOut        = "fuzzymonkey.star"
+)
+
+// Writes an OpenAPIv3 spec & a fuzzymonkey.star inferred from recorded traffic
+func doInit(harPath, proxyAddr, upstream, specOut string) int {
+	if specOut == "" {
+		specOut = defaultSpecOut
+	}
+	for _, fn := range []string{specOut, starOut} {
+		if _, err := os.Stat(fn); err == nil {
+			err = fmt.Errorf("refusing to overwrite %s", fn)
+			return initFailed(err)
+		}
+	}
+
+	var exchanges []*bootstrap.Exchange
+	var err error
+	if harPath != "" {
+		exchanges, err = exchangesFromHAR(harPath)
+	} else {
+		exchanges, err = exchangesFromProxy(proxyAddr, upstream)
+	}
+	if err != nil {
+		return initFailed(err)
+	}
+
+	spec, err := bootstrap.Infer(context.Background(), exchanges)
+	if err != nil {
+		return initFailed(err)
+	}
+	specData, err := spec.YAML()
+	if err != nil {
+		return initFailed(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(specOut), 0755); err != nil {
+		return initFailed(err)
+	}
+	if err := ioutil.WriteFile(specOut, specData, 0644); err != nil {
+		return initFailed(err)
+	}
+	starData, err := spec.StarFile(specOut)
+	if err != nil {
+		return initFailed(err)
+	}
+	if err := ioutil.WriteFile(starOut, starData, 0644); err != nil {
+		return initFailed(err)
+	}
+
+	as.ColorNFO.Printf("Wrote %s & %s describing %s\n", specOut, starOut, spec.Server)
+	return code.OK
+}
+
+func initFailed(err error) int {
+	log.Println("[ERR]", err)
+	as.ColorERR.Println(err)
+	return code.Failed
+}
+
+func exchangesFromHAR(harPath string) ([]*bootstrap.Exchange, error) {
+	blob, err := ioutil.ReadFile(harPath)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	exchanges, err := bootstrap.FromHAR(blob)
+	if err != nil {
+		err = fmt.Errorf("%s: %v", harPath, err)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	return exchanges, nil
+}
+
+func exchangesFromProxy(proxyAddr, upstream string) ([]*bootstrap.Exchange, error) {
+	rec, err := bootstrap.NewRecorder(upstream)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	srv := &http.Server{Addr: proxyAddr, Handler: rec}
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, os.Interrupt)
+	defer signal.Stop(sigC)
+	go func() {
+		<-sigC
+		log.Println("[NFO] received ^C: stopping proxy")
+		if err := srv.Shutdown(context.Background()); err != nil {
+			log.Println("[ERR]", err)
+		}
+	}()
+
+	as.ColorNFO.Printf("Recording exchanges through http://%s to %s: hit ^C when done\n", proxyAddr, upstream)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	return rec.Exchanges(), nil
+}
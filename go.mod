@@ -3,15 +3,19 @@ module github.com/FuzzyMonkeyCo/monkey
 go 1.15
 
 require (
+	github.com/PaesslerAG/gval v1.0.0
+	github.com/PaesslerAG/jsonpath v0.1.1
 	github.com/bazelbuild/buildtools v0.0.0-20210526150809-4890966c38b9
 	github.com/docopt/docopt-go v0.0.0-20180111231733-ee0de3bc6815
 	github.com/fatih/color v1.12.0
 	github.com/getkin/kin-openapi v0.64.0
+	github.com/ghodss/yaml v1.0.0
 	github.com/go-openapi/swag v0.19.15 // indirect
 	github.com/gogo/protobuf v1.3.2
 	github.com/google/uuid v1.2.0
 	github.com/googleapis/gnostic v0.5.5
 	github.com/hashicorp/logutils v1.0.0
+	github.com/jhump/protoreflect v1.11.0
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/mattn/go-isatty v0.0.13 // indirect
 	github.com/mattn/go-tty v0.0.3 // indirect
@@ -20,6 +24,7 @@ require (
 	github.com/stretchr/testify v1.6.1
 	github.com/superhawk610/bar v0.0.2
 	github.com/superhawk610/terminal v0.1.0 // indirect
+	github.com/vektah/gqlparser/v2 v2.3.1
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0
 	go.starlark.net v0.0.0-20210602144842-1cdb82c9e17a
@@ -28,5 +33,6 @@ require (
 	golang.org/x/sys v0.0.0-20210616094352-59db8d763f22 // indirect
 	google.golang.org/genproto v0.0.0-20210617175327-b9e0b3197ced // indirect
 	google.golang.org/grpc v1.38.0
+	google.golang.org/protobuf v1.26.0
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
 )
@@ -107,6 +107,10 @@ func actualMain() int {
 		return code.OK
 	}
 
+	if args.Init {
+		return doInit(args.FromHAR, args.FromProxy, args.Upstream, args.SpecOut)
+	}
+
 	mrt, err := rt.NewMonkey(binTitle, args.Labels)
 	if err != nil {
 		as.ColorERR.Println(err)
@@ -196,7 +200,7 @@ func actualMain() int {
 	}
 
 	as.ColorNFO.Printf("%d named schemas\n", mrt.InputsCount())
-	if err = mrt.FilterEndpoints(os.Args); err != nil {
+	if err = mrt.FilterEndpoints(endpointsFilter(os.Args)); err != nil {
 		as.ColorERR.Println(err)
 		return code.Failed
 	}
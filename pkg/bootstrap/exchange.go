@@ -0,0 +1,119 @@
+// Package bootstrap infers an OpenAPIv3 spec and a fuzzymonkey.star
+// from HTTP exchanges, as recorded in HAR files or through a proxy.
+package bootstrap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Exchange is an HTTP request and its response
+type Exchange struct {
+	Method string
+	URL    *url.URL
+
+	RequestContentType string
+	RequestBody        []byte
+
+	StatusCode          int
+	ResponseContentType string
+	ResponseBody        []byte
+
+	Elapsed time.Duration
+}
+
+// har is the part of an HTTP Archive used here:
+// http://www.softwareishard.com/blog/har-12-spec/
+type har struct {
+	Log struct {
+		Entries []struct {
+			Time    float64 `json:"time"`
+			Request struct {
+				Method   string `json:"method"`
+				URL      string `json:"url"`
+				PostData *struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+				} `json:"postData"`
+			} `json:"request"`
+			Response struct {
+				Status  int `json:"status"`
+				Content struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+					Encoding string `json:"encoding"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+// FromHAR reads the exchanges of an HTTP Archive
+func FromHAR(blob []byte) (exchanges []*Exchange, err error) {
+	var h har
+	if err = json.Unmarshal(blob, &h); err != nil {
+		return
+	}
+	if len(h.Log.Entries) == 0 {
+		err = errors.New("no entries in HAR")
+		return
+	}
+
+	for i, entry := range h.Log.Entries {
+		e := &Exchange{
+			Method:              strings.ToUpper(entry.Request.Method),
+			StatusCode:          entry.Response.Status,
+			ResponseContentType: entry.Response.Content.MimeType,
+			ResponseBody:        []byte(entry.Response.Content.Text),
+			Elapsed:             time.Duration(entry.Time * float64(time.Millisecond)),
+		}
+		if e.URL, err = url.Parse(entry.Request.URL); err != nil {
+			err = fmt.Errorf("entry #%d: %v", i, err)
+			return
+		}
+		if data := entry.Request.PostData; data != nil {
+			e.RequestContentType = data.MimeType
+			e.RequestBody = []byte(data.Text)
+		}
+		if entry.Response.Content.Encoding == "base64" {
+			if e.ResponseBody, err = base64.StdEncoding.DecodeString(entry.Response.Content.Text); err != nil {
+				err = fmt.Errorf("entry #%d: %v", i, err)
+				return
+			}
+		}
+		exchanges = append(exchanges, e)
+	}
+	return
+}
+
+// server is scheme://host[:port] of u
+func server(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// isAsset is true for content that is not part of an API
+func isAsset(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "text/html", mediaType == "text/css",
+		mediaType == "application/javascript", mediaType == "text/javascript",
+		strings.HasPrefix(mediaType, "image/"), strings.HasPrefix(mediaType, "font/"):
+		return true
+	default:
+		return false
+	}
+}
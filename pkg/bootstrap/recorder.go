@@ -0,0 +1,110 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Recorder is a reverse proxy to an upstream server that records exchanges
+type Recorder struct {
+	proxy *httputil.ReverseProxy
+
+	mu        sync.Mutex
+	exchanges []*Exchange
+}
+
+var _ http.Handler = (*Recorder)(nil)
+
+// NewRecorder proxies requests to upstream, a URL such as http://localhost:3000
+func NewRecorder(upstream string) (*Recorder, error) {
+	u, err := url.Parse(upstream)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("not an HTTP URL: %q", upstream)
+	}
+
+	rec := &Recorder{}
+	rec.proxy = httputil.NewSingleHostReverseProxy(u)
+	rec.proxy.Transport = &recordingTransport{
+		rec:       rec,
+		transport: http.DefaultTransport,
+	}
+	return rec, nil
+}
+
+// ServeHTTP forwards the request upstream
+func (rec *Recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.proxy.ServeHTTP(w, r)
+}
+
+// Exchanges returns the exchanges recorded so far
+func (rec *Recorder) Exchanges() []*Exchange {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	exchanges := make([]*Exchange, len(rec.exchanges))
+	copy(exchanges, rec.exchanges)
+	return exchanges
+}
+
+type recordingTransport struct {
+	rec       *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (rep *http.Response, err error) {
+	e := &Exchange{
+		Method:             req.Method,
+		URL:                req.URL,
+		RequestContentType: req.Header.Get("Content-Type"),
+	}
+	if req.Body != nil {
+		if e.RequestBody, err = ioutil.ReadAll(req.Body); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = req.Body.Close(); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(e.RequestBody))
+	}
+
+	start := time.Now()
+	if rep, err = t.transport.RoundTrip(req); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	body, err := ioutil.ReadAll(rep.Body)
+	e.Elapsed = time.Since(start)
+	if err != nil {
+		log.Println("[ERR]", err)
+		rep.Body.Close()
+		rep = nil
+		return
+	}
+	if err = rep.Body.Close(); err != nil {
+		log.Println("[ERR]", err)
+		rep = nil
+		return
+	}
+	rep.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	e.StatusCode = rep.StatusCode
+	e.ResponseContentType = rep.Header.Get("Content-Type")
+	e.ResponseBody = body
+	log.Printf("[NFO] recorded %s %s: %d", e.Method, e.URL, e.StatusCode)
+
+	t.rec.mu.Lock()
+	t.rec.exchanges = append(t.rec.exchanges, e)
+	t.rec.mu.Unlock()
+	return
+}
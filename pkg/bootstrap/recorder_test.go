@@ -0,0 +1,80 @@
+package bootstrap
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderProxiesAndRecords(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"name": "Rex"}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1, "name": "Rex"}`))
+	}))
+	defer upstream.Close()
+
+	rec, err := NewRecorder(upstream.URL)
+	require.NoError(t, err)
+	proxy := httptest.NewServer(rec)
+	defer proxy.Close()
+
+	rep, err := http.Post(proxy.URL+"/pets?dry=true", "application/json", strings.NewReader(`{"name": "Rex"}`))
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(rep.Body)
+	require.NoError(t, err)
+	require.NoError(t, rep.Body.Close())
+	require.Equal(t, http.StatusCreated, rep.StatusCode)
+	require.Equal(t, `{"id": 1, "name": "Rex"}`, string(body))
+
+	exchanges := rec.Exchanges()
+	require.Len(t, exchanges, 1)
+	e := exchanges[0]
+	require.Equal(t, "POST", e.Method)
+	require.Equal(t, upstream.URL, server(e.URL))
+	require.Equal(t, "/pets", e.URL.Path)
+	require.Equal(t, "dry=true", e.URL.RawQuery)
+	require.Equal(t, "application/json", e.RequestContentType)
+	require.Equal(t, `{"name": "Rex"}`, string(e.RequestBody))
+	require.Equal(t, http.StatusCreated, e.StatusCode)
+	require.Equal(t, `{"id": 1, "name": "Rex"}`, string(e.ResponseBody))
+}
+
+func TestNewRecorderRejectsNonHTTP(t *testing.T) {
+	for _, upstream := range []string{"localhost:3000", "ws://localhost:3000", "http://"} {
+		_, err := NewRecorder(upstream)
+		require.Error(t, err, upstream)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+type failingBody struct{}
+
+func (failingBody) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+func (failingBody) Close() error             { return nil }
+
+func TestRecorderFailsOnUnreadableResponses(t *testing.T) {
+	rec := &Recorder{}
+	tr := &recordingTransport{
+		rec: rec,
+		transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: failingBody{}}, nil
+		}),
+	}
+	req := httptest.NewRequest("GET", "http://localhost:3000/pets", nil)
+	rep, err := tr.RoundTrip(req)
+	require.EqualError(t, err, "connection reset")
+	require.Nil(t, rep)
+	require.Empty(t, rec.Exchanges())
+}
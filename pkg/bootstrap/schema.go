@@ -0,0 +1,178 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var reUUID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// decodeJSON decodes blob keeping integers distinct from other numbers
+func decodeJSON(blob []byte) (v interface{}, ok bool) {
+	d := json.NewDecoder(bytes.NewReader(blob))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil || d.More() {
+		return nil, false
+	}
+	return v, true
+}
+
+const (
+	kindNull = "null"
+	// Other kinds are named after OpenAPIv3 types
+	kindBoolean = "boolean"
+	kindInteger = "integer"
+	kindNumber  = "number"
+	kindString  = "string"
+	kindArray   = "array"
+	kindObject  = "object"
+)
+
+func kindOf(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return kindNull
+	case bool:
+		return kindBoolean
+	case json.Number:
+		if _, err := x.Int64(); err == nil {
+			return kindInteger
+		}
+		return kindNumber
+	case string:
+		return kindString
+	case []interface{}:
+		return kindArray
+	default:
+		return kindObject
+	}
+}
+
+// inferSchema describes all samples with a schema that is
+// as strict as what was observed.
+func inferSchema(samples []interface{}) *openapi3.Schema {
+	byKind := make(map[string][]interface{})
+	for _, sample := range samples {
+		kind := kindOf(sample)
+		byKind[kind] = append(byKind[kind], sample)
+	}
+	_, nullable := byKind[kindNull]
+	delete(byKind, kindNull)
+
+	// Integers are numbers
+	if ints, ok := byKind[kindInteger]; ok {
+		if _, ok := byKind[kindNumber]; ok {
+			byKind[kindNumber] = append(byKind[kindNumber], ints...)
+			delete(byKind, kindInteger)
+		}
+	}
+
+	kinds := make([]string, 0, len(byKind))
+	for kind := range byKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	var schema *openapi3.Schema
+	switch len(kinds) {
+	case 0:
+		schema = openapi3.NewSchema()
+	case 1:
+		schema = inferKind(kinds[0], byKind[kinds[0]])
+	default:
+		oneOf := make([]*openapi3.Schema, 0, len(kinds))
+		for _, kind := range kinds {
+			oneOf = append(oneOf, inferKind(kind, byKind[kind]))
+		}
+		schema = openapi3.NewOneOfSchema(oneOf...)
+	}
+	schema.Nullable = nullable
+	return schema
+}
+
+func inferKind(kind string, samples []interface{}) *openapi3.Schema {
+	switch kind {
+	case kindBoolean:
+		return openapi3.NewBoolSchema()
+	case kindInteger:
+		return openapi3.NewIntegerSchema()
+	case kindNumber:
+		return openapi3.NewFloat64Schema()
+	case kindString:
+		return inferString(samples)
+	case kindArray:
+		var items []interface{}
+		for _, sample := range samples {
+			items = append(items, sample.([]interface{})...)
+		}
+		return openapi3.NewArraySchema().WithItems(inferSchema(items))
+	default:
+		return inferObject(samples)
+	}
+}
+
+func inferString(samples []interface{}) *openapi3.Schema {
+	for _, sample := range samples {
+		if _, err := time.Parse(time.RFC3339, sample.(string)); err != nil {
+			return openapi3.NewStringSchema()
+		}
+	}
+	return openapi3.NewDateTimeSchema()
+}
+
+func inferObject(samples []interface{}) *openapi3.Schema {
+	values := make(map[string][]interface{})
+	for _, sample := range samples {
+		for key, value := range sample.(map[string]interface{}) {
+			values[key] = append(values[key], value)
+		}
+	}
+
+	schema := openapi3.NewObjectSchema()
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		schema.WithProperty(key, inferSchema(values[key]))
+		// Required when always present
+		if len(values[key]) == len(samples) {
+			schema.Required = append(schema.Required, key)
+		}
+	}
+	return schema
+}
+
+// inferParameter describes values of path or query parameters
+func inferParameter(values []string) *openapi3.Schema {
+	ints, bools := true, true
+	samples := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		if !isDigits(value) {
+			ints = false
+		}
+		if value != "true" && value != "false" {
+			bools = false
+		}
+		samples = append(samples, value)
+	}
+	switch {
+	case ints:
+		return openapi3.NewIntegerSchema()
+	case bools:
+		return openapi3.NewBoolSchema()
+	default:
+		return inferString(samples)
+	}
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
@@ -0,0 +1,351 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+)
+
+// Spec is an OpenAPIv3 document inferred from exchanges with a server
+type Spec struct {
+	Doc    *openapi3.T
+	Server string
+
+	operations []*operation
+	exchanges  int
+	slowest    time.Duration
+}
+
+// segment is either a static part of a path or a parameter
+type segment struct {
+	static, param string
+}
+
+// operation gathers the exchanges sharing a method and a path template
+type operation struct {
+	method    string
+	segments  []segment
+	id        string
+	exchanges []*Exchange
+	op        *openapi3.Operation
+}
+
+func (o *operation) path() string {
+	if len(o.segments) == 0 {
+		return "/"
+	}
+	var b strings.Builder
+	for _, seg := range o.segments {
+		b.WriteByte('/')
+		if seg.param != "" {
+			b.WriteString("{" + seg.param + "}")
+		} else {
+			b.WriteString(seg.static)
+		}
+	}
+	return b.String()
+}
+
+// Infer describes the API of the server most exchanges were made with
+func Infer(ctx context.Context, exchanges []*Exchange) (s *Spec, err error) {
+	s = &Spec{Server: mostCommonServer(exchanges)}
+
+	byKey := make(map[string]*operation)
+	for _, e := range exchanges {
+		if isAsset(e.ResponseContentType) {
+			log.Printf("[NFO] skipping %s %s: %s", e.Method, e.URL, e.ResponseContentType)
+			continue
+		}
+		if srv := server(e.URL); srv != s.Server {
+			log.Printf("[NFO] skipping %s %s: not on %s", e.Method, e.URL, s.Server)
+			continue
+		}
+		s.exchanges++
+		if e.Elapsed > s.slowest {
+			s.slowest = e.Elapsed
+		}
+
+		segments := templatePath(e.URL.Path)
+		o := &operation{method: e.Method, segments: segments}
+		key := o.method + " " + o.path()
+		if found, ok := byKey[key]; ok {
+			o = found
+		} else {
+			byKey[key] = o
+			s.operations = append(s.operations, o)
+		}
+		o.exchanges = append(o.exchanges, e)
+	}
+	if len(s.operations) == 0 {
+		err = errors.New("no API exchange found")
+		log.Println("[ERR]", err)
+		return
+	}
+
+	sort.Slice(s.operations, func(i, j int) bool {
+		pi, pj := s.operations[i].path(), s.operations[j].path()
+		if pi != pj {
+			return pi < pj
+		}
+		return s.operations[i].method < s.operations[j].method
+	})
+
+	s.Doc = &openapi3.T{
+		OpenAPI: "3.0.0",
+		Info: &openapi3.Info{
+			Title:       strings.SplitN(s.Server, "://", 2)[1],
+			Description: fmt.Sprintf("Inferred from %d exchanges", s.exchanges),
+			Version:     "0.0.1",
+		},
+		Servers: openapi3.Servers{{URL: s.Server + "/"}},
+		Paths:   openapi3.Paths{},
+	}
+	ids := make(map[string]int, len(s.operations))
+	for _, o := range s.operations {
+		if o.id = operationID(o); ids[o.id] != 0 {
+			o.id += strconv.Itoa(ids[o.id])
+		}
+		ids[o.id]++
+
+		o.op = inferOperation(o)
+		path := o.path()
+		item := s.Doc.Paths[path]
+		if item == nil {
+			item = &openapi3.PathItem{}
+			s.Doc.Paths[path] = item
+		}
+		item.SetOperation(o.method, o.op)
+	}
+
+	if err = s.Doc.Validate(ctx); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func mostCommonServer(exchanges []*Exchange) (srv string) {
+	counts := make(map[string]int)
+	max := 0
+	for _, e := range exchanges {
+		if isAsset(e.ResponseContentType) {
+			continue
+		}
+		s := server(e.URL)
+		// Ties go to the first seen
+		if counts[s]++; counts[s] > max {
+			max, srv = counts[s], s
+		}
+	}
+	return
+}
+
+var reHexID = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+
+// isID guesses whether a path segment identifies a resource
+func isID(seg string) bool {
+	return isDigits(seg) || reUUID.MatchString(seg) ||
+		(reHexID.MatchString(seg) && strings.ContainsAny(seg, "0123456789"))
+}
+
+// templatePath turns e.g. /pets/42/toys into /pets/{petId}/toys
+func templatePath(path string) (segments []segment) {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return
+	}
+	used := make(map[string]bool)
+	for i, part := range strings.Split(path, "/") {
+		if !isID(part) {
+			segments = append(segments, segment{static: part})
+			continue
+		}
+
+		name := "id"
+		if i != 0 && segments[i-1].static != "" {
+			if thing := camelCase(singular(segments[i-1].static)); thing != "" {
+				name = thing + "Id"
+			}
+		}
+		for n := 2; used[name]; n++ {
+			name = strings.TrimRight(name, "0123456789") + strconv.Itoa(n)
+		}
+		used[name] = true
+		segments = append(segments, segment{param: name})
+	}
+	return
+}
+
+func singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 3:
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
+
+// words splits on anything that is neither a letter nor a digit
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func camelCase(s string) string {
+	var b strings.Builder
+	for i, word := range words(s) {
+		if i == 0 {
+			b.WriteString(strings.ToLower(word))
+		} else {
+			b.WriteString(title(word))
+		}
+	}
+	return b.String()
+}
+
+func snakeCase(s string) string {
+	ws := words(s)
+	for i, word := range ws {
+		ws[i] = strings.ToLower(word)
+	}
+	return strings.Join(ws, "_")
+}
+
+func title(word string) string {
+	if word == "" {
+		return ""
+	}
+	return strings.ToUpper(word[:1]) + strings.ToLower(word[1:])
+}
+
+// operationID turns e.g. GET /pets/{petId} into getPetsByPetId
+func operationID(o *operation) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(o.method))
+	if len(o.segments) == 0 {
+		b.WriteString("Root")
+	}
+	for _, seg := range o.segments {
+		if seg.param != "" {
+			b.WriteString("By" + title(seg.param[:1]) + seg.param[1:])
+		} else {
+			for _, word := range words(seg.static) {
+				b.WriteString(title(word))
+			}
+		}
+	}
+	return b.String()
+}
+
+func inferOperation(o *operation) *openapi3.Operation {
+	op := openapi3.NewOperation()
+	op.OperationID = o.id
+	if len(o.segments) != 0 && o.segments[0].static != "" {
+		op.Tags = []string{o.segments[0].static}
+	}
+
+	// Path parameters
+	for i, seg := range o.segments {
+		if seg.param == "" {
+			continue
+		}
+		values := make([]string, 0, len(o.exchanges))
+		for _, e := range o.exchanges {
+			values = append(values, strings.Split(strings.Trim(e.URL.Path, "/"), "/")[i])
+		}
+		param := openapi3.NewPathParameter(seg.param).WithSchema(inferParameter(values))
+		op.Parameters = append(op.Parameters, &openapi3.ParameterRef{Value: param})
+	}
+
+	// Query parameters
+	queries := make(map[string][]string)
+	seen := make(map[string]int)
+	for _, e := range o.exchanges {
+		for name, values := range e.URL.Query() {
+			queries[name] = append(queries[name], values...)
+			seen[name]++
+		}
+	}
+	names := make([]string, 0, len(queries))
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param := openapi3.NewQueryParameter(name).
+			WithSchema(inferParameter(queries[name])).
+			WithRequired(seen[name] == len(o.exchanges))
+		op.Parameters = append(op.Parameters, &openapi3.ParameterRef{Value: param})
+	}
+
+	// Request body
+	var bodies []interface{}
+	for _, e := range o.exchanges {
+		if body, ok := jsonBody(e.RequestContentType, e.RequestBody); ok {
+			bodies = append(bodies, body)
+		}
+	}
+	if len(bodies) != 0 {
+		body := openapi3.NewRequestBody().
+			WithJSONSchema(inferSchema(bodies)).
+			WithRequired(len(bodies) == len(o.exchanges))
+		op.RequestBody = &openapi3.RequestBodyRef{Value: body}
+	}
+
+	// Responses
+	byCode := make(map[int][]*Exchange)
+	for _, e := range o.exchanges {
+		byCode[e.StatusCode] = append(byCode[e.StatusCode], e)
+	}
+	op.Responses = openapi3.NewResponses()
+	delete(op.Responses, "default")
+	for code, es := range byCode {
+		description := http.StatusText(code)
+		if description == "" {
+			description = "Observed response"
+		}
+		rep := openapi3.NewResponse().WithDescription(description)
+
+		var bodies []interface{}
+		for _, e := range es {
+			if body, ok := jsonBody(e.ResponseContentType, e.ResponseBody); ok {
+				bodies = append(bodies, body)
+			}
+		}
+		if len(bodies) != 0 {
+			rep.WithJSONSchema(inferSchema(bodies))
+		}
+		op.Responses[strconv.Itoa(code)] = &openapi3.ResponseRef{Value: rep}
+	}
+	return op
+}
+
+// jsonBody decodes JSON bodies, guessing when no content type is given
+func jsonBody(contentType string, body []byte) (interface{}, bool) {
+	if len(body) == 0 || (contentType != "" && !isJSON(contentType)) {
+		return nil, false
+	}
+	return decodeJSON(body)
+}
+
+// YAML encodes the inferred document
+func (s *Spec) YAML() ([]byte, error) {
+	js, err := s.Doc.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(js)
+}
@@ -0,0 +1,125 @@
+package bootstrap
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func inferPetstore(t *testing.T) *Spec {
+	blob, err := ioutil.ReadFile(filepath.Join("testdata", "petstore.har"))
+	require.NoError(t, err)
+	exchanges, err := FromHAR(blob)
+	require.NoError(t, err)
+	require.Len(t, exchanges, 8)
+
+	s, err := Infer(context.Background(), exchanges)
+	require.NoError(t, err)
+	return s
+}
+
+func TestTemplatePath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"":                      "/",
+		"/":                     "/",
+		"/pets":                 "/pets",
+		"/pets/42":              "/pets/{petId}",
+		"/pets/42/":             "/pets/{petId}",
+		"/42":                   "/{id}",
+		"/categories/7/pets/42": "/categories/{categoryId}/pets/{petId}",
+		"/pets/42/42":           "/pets/{petId}/{id}",
+		"/pets/42/pets/43":      "/pets/{petId}/pets/{petId2}",
+		"/user-accounts/5f2b1c3d4e5f6a7b8c9d0e1f":    "/user-accounts/{userAccountId}",
+		"/pets/f81d4fae-7dec-11d0-a765-00a0c91e6bf6": "/pets/{petId}",
+		"/pets/deadbeefdeadbeef":                     "/pets/deadbeefdeadbeef",
+		"/v1/pets":                                   "/v1/pets",
+	} {
+		o := &operation{segments: templatePath(path)}
+		require.Equal(t, expected, o.path(), path)
+	}
+}
+
+func TestInferFromHAR(t *testing.T) {
+	s := inferPetstore(t)
+	require.Equal(t, "http://localhost:3000", s.Server)
+	require.Equal(t, 6, s.exchanges)
+
+	paths := make([]string, 0, len(s.Doc.Paths))
+	for path := range s.Doc.Paths {
+		paths = append(paths, path)
+	}
+	require.ElementsMatch(t, []string{"/pets", "/pets/{petId}", "/pets/{petId}/toys/{toyId}"}, paths)
+
+	list := s.Doc.Paths["/pets"].Get
+	require.Equal(t, "getPets", list.OperationID)
+	require.Equal(t, []string{"pets"}, list.Tags)
+	limit := list.Parameters.GetByInAndName("query", "limit")
+	require.NotNil(t, limit)
+	require.False(t, limit.Required)
+	require.Equal(t, "integer", limit.Schema.Value.Type)
+	pets := list.Responses.Get(200).Value.Content.Get("application/json").Schema.Value
+	require.Equal(t, "array", pets.Type)
+	require.Equal(t, []string{"id", "name"}, pets.Items.Value.Required)
+	require.Equal(t, "integer", pets.Items.Value.Properties["id"].Value.Type)
+
+	create := s.Doc.Paths["/pets"].Post
+	require.Equal(t, "postPets", create.OperationID)
+	require.True(t, create.RequestBody.Value.Required)
+	require.NotNil(t, create.Responses.Get(201).Value.Content.Get("application/json"))
+
+	show := s.Doc.Paths["/pets/{petId}"].Get
+	require.Equal(t, "getPetsByPetId", show.OperationID)
+	require.Equal(t, "integer", show.Parameters.GetByInAndName("path", "petId").Schema.Value.Type)
+	require.Len(t, show.Responses, 2)
+	require.Equal(t, "Not Found", *show.Responses.Get(404).Value.Description)
+
+	del := s.Doc.Paths["/pets/{petId}/toys/{toyId}"].Delete
+	require.Equal(t, "deletePetsByPetIdToysByToyId", del.OperationID)
+	require.Equal(t, "string", del.Parameters.GetByInAndName("path", "toyId").Schema.Value.Type)
+	require.Nil(t, del.Responses.Get(204).Value.Content)
+
+	data, err := s.YAML()
+	require.NoError(t, err)
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(context.Background()))
+}
+
+func TestInferNothing(t *testing.T) {
+	exchanges, err := FromHAR([]byte(`{"log": {"entries": [{"request": {"method": "GET", "url": "http://localhost/"}, "response": {"status": 200, "content": {"mimeType": "text/html"}}}]}}`))
+	require.NoError(t, err)
+	_, err = Infer(context.Background(), exchanges)
+	require.EqualError(t, err, "no API exchange found")
+
+	_, err = FromHAR([]byte(`{"log": {"entries": []}}`))
+	require.EqualError(t, err, "no entries in HAR")
+}
+
+func TestStarFile(t *testing.T) {
+	s := inferPetstore(t)
+	data, err := s.StarFile("openapi.yaml")
+	require.NoError(t, err)
+	star := string(data)
+	require.Contains(t, star, `    file = "openapi.yaml",`)
+	require.Contains(t, star, `    host = "http://localhost:3000",`)
+	// Slowest call took 730ms
+	require.Contains(t, star, `.is_at_most(1500e6)`)
+	require.Contains(t, star, `def pet_matches_url(ctx):`)
+	require.Contains(t, star, `        parts[0] == "pets",
+        ctx.response.status_code == 200,`)
+	require.Contains(t, star, `is_equal_to(int(parts[1]))`)
+}
+
+func TestStarFileQuotesValues(t *testing.T) {
+	s := inferPetstore(t)
+	s.Server = `http://localhost:3000/a"b`
+	data, err := s.StarFile(`dir\spec "v1".yaml`)
+	require.NoError(t, err)
+	star := string(data)
+	require.Contains(t, star, `    file = "dir\\spec \"v1\".yaml",`)
+	require.Contains(t, star, `    host = "http://localhost:3000/a\"b",`)
+}
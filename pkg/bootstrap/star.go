@@ -0,0 +1,159 @@
+package bootstrap
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/bazelbuild/buildtools/build"
+	"go.starlark.net/starlark"
+)
+
+// minTimely is the lowest response time threshold suggested
+const minTimely = 500 * time.Millisecond
+
+var starTemplate = template.Must(template.New("star").Funcs(template.FuncMap{
+	"quote": func(s string) string { return starlark.String(s).String() },
+}).Parse(`# Invariants of our APIs expressed in a Python-like language
+# Bootstrapped from {{.Exchanges}} recorded exchanges:
+#   review {{.SpecPath}} then express your API's properties below.
+
+OpenAPIv3(
+    name = "my_model",
+    file = {{quote .SpecPath}},
+    host = {{quote .Server}},
+
+    # Note: exec commands are executed in shells sharing the same environment variables,
+    # with ` + "`set -e`" + ` and ` + "`set -o pipefail`" + ` flags on.
+
+    # The following get executed once per test
+    #   so have these commands complete as fast as possible.
+    # Also, make sure that each test starts from a clean slate
+    #   otherwise results will be unreliable.
+    # ExecReset = """
+    # echo Resetting state...
+    # """,
+)
+
+## Ensure some general property
+
+Check(
+    name = "responds_in_a_timely_manner",
+    after_response = lambda ctx: assert.that(ctx.response.elapsed_ns).is_at_most({{.TimelyMs}}e6),
+    tags = ["timing"],
+)
+{{- with .Match}}
+
+## Ensure responses match requests
+
+def {{.Name}}(ctx):
+    """The {{.Thing}} returned is the one asked for."""
+    parts = ctx.request.url.split("?")[0].split("/")[3:]
+    if all([
+        ctx.request.method == "GET",
+        len(parts) == {{len .Parts}},
+{{- range $i, $part := .Parts}}{{if $part}}
+        parts[{{$i}}] == {{quote $part}},{{end}}{{end}}
+        ctx.response.status_code == 200,
+    ]):
+        assert.that(ctx.response.body["id"]).is_equal_to({{.Cast}}(parts[{{.Last}}]))
+
+Check(
+    name = "{{.Name}}",
+    after_response = {{.Name}},
+)
+{{- end}}
+`))
+
+// idMatch describes a GET operation on a resource identified by its last path segment
+type idMatch struct {
+	Name, Thing, Cast string
+	// Parts are the path's static segments, empty for parameters
+	Parts []string
+	Last  int
+}
+
+// StarFile suggests a fuzzymonkey.star using the spec found at specPath,
+// formatted as per monkey fmt.
+func (s *Spec) StarFile(specPath string) ([]byte, error) {
+	timely := 2 * s.slowest
+	if timely < minTimely {
+		timely = minTimely
+	}
+	// Round up to the next 100ms
+	timely = (timely + 100*time.Millisecond - 1).Truncate(100 * time.Millisecond)
+
+	var b bytes.Buffer
+	if err := starTemplate.Execute(&b, struct {
+		Exchanges        int
+		SpecPath, Server string
+		TimelyMs         int64
+		Match            *idMatch
+	}{
+		Exchanges: s.exchanges,
+		SpecPath:  specPath,
+		Server:    s.Server,
+		TimelyMs:  timely.Milliseconds(),
+		Match:     s.idMatch(),
+	}); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	f, err := build.ParseDefault("fuzzymonkey.star", b.Bytes())
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	return build.Format(f), nil
+}
+
+func (s *Spec) idMatch() *idMatch {
+	for _, o := range s.operations {
+		n := len(o.segments)
+		if o.method != "GET" || n < 2 || o.segments[n-1].param == "" || o.segments[n-2].static == "" {
+			continue
+		}
+		rep, ok := o.op.Responses["200"]
+		if !ok {
+			continue
+		}
+		mt := rep.Value.Content.Get("application/json")
+		if mt == nil || mt.Schema == nil {
+			continue
+		}
+		id, ok := mt.Schema.Value.Properties["id"]
+		if !ok {
+			continue
+		}
+
+		var cast string
+		switch id.Value.Type {
+		case kindInteger:
+			cast = "int"
+		case kindString:
+			cast = "str"
+		default:
+			continue
+		}
+		// Path parameter and body id must agree on their type
+		if o.op.Parameters.GetByInAndName("path", o.segments[n-1].param).Schema.Value.Type != id.Value.Type {
+			continue
+		}
+
+		m := &idMatch{Cast: cast, Last: n - 1}
+		for _, seg := range o.segments {
+			m.Parts = append(m.Parts, seg.static)
+		}
+		m.Thing = snakeCase(singular(o.segments[n-2].static))
+		if m.Thing == "" {
+			continue
+		}
+		m.Name = m.Thing + "_matches_url"
+		m.Thing = strings.ReplaceAll(m.Thing, "_", " ")
+		return m
+	}
+	return nil
+}
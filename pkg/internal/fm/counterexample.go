@@ -2,7 +2,11 @@ package fm
 
 import (
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // CLIString is used to display quick data on a CounterexampleItem
@@ -17,6 +21,11 @@ func (ceI *Srv_FuzzingResult_CounterexampleItem) CLIString() (s string) {
 		b.WriteString("curl -#fsSL -X ")
 		b.WriteString(req.GetMethod())
 		indent()
+		if socket := req.GetUnixSocket(); socket != "" {
+			b.WriteString("--unix-socket ")
+			b.WriteString(shellEscape(socket))
+			indent()
+		}
 		for key, vs := range req.GetHeaders() {
 			values := strings.Join(vs.GetValues(), ",")
 			switch key {
@@ -36,15 +45,111 @@ func (ceI *Srv_FuzzingResult_CounterexampleItem) CLIString() (s string) {
 		}
 		b.WriteString(shellEscape(req.GetUrl()))
 		b.WriteString("\n")
+		for _, hop := range rep.GetHistory() {
+			b.WriteString("# ")
+			b.WriteString(hop.GetReason())
+			b.WriteString(" ➜ ")
+			b.WriteString(hop.GetLocation())
+			b.WriteString("\n")
+		}
 		b.WriteString("# ")
 		b.WriteString(rep.GetReason())
+		if conn := rep.GetConnection(); conn != nil {
+			b.WriteString("\n")
+			b.WriteString("# ")
+			b.WriteString(conn.CLIString())
+		}
+		s = b.String()
+	case *Clt_CallRequestRaw_Input_GrpcRequest_:
+		req := ceI.GetCallRequest().GetGrpcRequest()
+		rep := ceI.GetCallResponse().GetGrpcResponse()
+
+		var b strings.Builder
+		indent := func() { b.WriteString(" \\\n     ") }
+		b.WriteString("grpcurl -plaintext")
+		indent()
+		keys := make([]string, 0, len(req.GetMetadata()))
+		for key := range req.GetMetadata() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			for _, value := range req.GetMetadata()[key].GetValues() {
+				b.WriteString("-H ")
+				b.WriteString(shellEscape(fmt.Sprintf("%s: %s", key, value)))
+				indent()
+			}
+		}
+		if body := req.GetBody(); len(body) != 0 {
+			b.WriteString("-d ")
+			b.WriteString(shellEscape(string(body)))
+			indent()
+		}
+		b.WriteString(shellEscape(req.GetTarget()))
+		b.WriteString(" ")
+		b.WriteString(shellEscape(strings.TrimPrefix(req.GetMethod(), "/")))
+		b.WriteString("\n")
+		b.WriteString("# ")
+		b.WriteString(rep.GetStatus())
+		if msg := rep.GetMessage(); msg != "" {
+			b.WriteString(": ")
+			b.WriteString(msg)
+		}
+		s = b.String()
+	case *Clt_CallRequestRaw_Input_WebsocketRequest:
+		req := ceI.GetCallRequest().GetWebsocketRequest()
+		rep := ceI.GetCallResponse().GetWebsocketResponse()
+
+		var b strings.Builder
+		b.WriteString("echo ")
+		b.WriteString(shellEscape(string(req.GetBody())))
+		b.WriteString(" | websocat -n1 ")
+		b.WriteString(shellEscape(req.GetUrl()))
+		b.WriteString("\n")
+		if err := rep.GetError(); err != "" {
+			b.WriteString("# ")
+			b.WriteString(err)
+		} else {
+			fmt.Fprintf(&b, "# %d message(s) received", len(rep.GetMessages())-1)
+		}
 		s = b.String()
 	default:
 		panic(fmt.Sprintf("unhandled CounterexampleItem %T %+v", x, ceI))
 	}
+	for _, l := range ceI.GetLogs() {
+		s += "\n" + l.CLIString()
+	}
 	return
 }
 
+// CLIString is used to display a line logged during a call, as a comment
+func (l *Clt_CallResponseRaw_LogLine) CLIString() string {
+	offset := time.Duration(l.GetOffsetNs()).Round(time.Microsecond)
+	return fmt.Sprintf("# %s +%s: %s", l.GetSource(), offset, l.GetLine())
+}
+
+// CLIString is used to display quick data on a Connection
+func (conn *Clt_CallResponseRaw_Output_HttpResponse_Connection) CLIString() string {
+	var b strings.Builder
+	b.WriteString(conn.GetProtocol())
+	fmt.Fprintf(&b, " %s ➜ %s", hostPort(conn.GetLocalIp(), conn.GetLocalPort()),
+		hostPort(conn.GetRemoteIp(), conn.GetRemotePort()))
+	if t := conn.GetTls(); t != nil {
+		fmt.Fprintf(&b, " %s %s", t.GetVersion(), t.GetCipherSuite())
+		if subject := t.GetPeerSubject(); subject != "" {
+			fmt.Fprintf(&b, " %q", subject)
+		}
+	}
+	return b.String()
+}
+
+func hostPort(ip string, port uint32) string {
+	if port == 0 {
+		return ip
+	}
+	return net.JoinHostPort(ip, strconv.FormatUint(uint64(port), 10))
+}
+
 func shellEscape(s string) string {
 	return `'` + strings.ReplaceAll(s, `'`, `'\''`) + `'`
 }
@@ -1,25 +1,24 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: pkg/internal/fm/fuzzymonkey.proto
 
-package fm // import "github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
-
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import types "github.com/gogo/protobuf/types"
-
-import bytes "bytes"
+package fm
 
 import (
-	context "golang.org/x/net/context"
+	bytes "bytes"
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
-import encoding_binary "encoding/binary"
-
-import io "io"
-
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -29,7 +28,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Clt_ResetProgress_Status int32
 
@@ -46,6 +45,7 @@ var Clt_ResetProgress_Status_name = map[int32]string{
 	2: "ended",
 	3: "failed",
 }
+
 var Clt_ResetProgress_Status_value = map[string]int32{
 	"NOOP":    0,
 	"started": 1,
@@ -56,8 +56,9 @@ var Clt_ResetProgress_Status_value = map[string]int32{
 func (x Clt_ResetProgress_Status) String() string {
 	return proto.EnumName(Clt_ResetProgress_Status_name, int32(x))
 }
+
 func (Clt_ResetProgress_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 1, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 1, 0}
 }
 
 type Clt_CallVerifProgress_Status int32
@@ -68,6 +69,8 @@ const (
 	Clt_CallVerifProgress_skipped   Clt_CallVerifProgress_Status = 2
 	Clt_CallVerifProgress_failure   Clt_CallVerifProgress_Status = 3
 	Clt_CallVerifProgress_done      Clt_CallVerifProgress_Status = 4
+	// Call was retried as asked by the server (e.g. 429 with Retry-After)
+	Clt_CallVerifProgress_retried Clt_CallVerifProgress_Status = 5
 )
 
 var Clt_CallVerifProgress_Status_name = map[int32]string{
@@ -76,20 +79,24 @@ var Clt_CallVerifProgress_Status_name = map[int32]string{
 	2: "skipped",
 	3: "failure",
 	4: "done",
+	5: "retried",
 }
+
 var Clt_CallVerifProgress_Status_value = map[string]int32{
 	"NO_STATUS": 0,
 	"success":   1,
 	"skipped":   2,
 	"failure":   3,
 	"done":      4,
+	"retried":   5,
 }
 
 func (x Clt_CallVerifProgress_Status) String() string {
 	return proto.EnumName(Clt_CallVerifProgress_Status_name, int32(x))
 }
+
 func (Clt_CallVerifProgress_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 4, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 4, 0}
 }
 
 type Clt_CallVerifProgress_Origin int32
@@ -105,6 +112,7 @@ var Clt_CallVerifProgress_Origin_name = map[int32]string{
 	1: "built_in",
 	2: "after_response",
 }
+
 var Clt_CallVerifProgress_Origin_value = map[string]int32{
 	"NO_ORIGIN":      0,
 	"built_in":       1,
@@ -114,8 +122,9 @@ var Clt_CallVerifProgress_Origin_value = map[string]int32{
 func (x Clt_CallVerifProgress_Origin) String() string {
 	return proto.EnumName(Clt_CallVerifProgress_Origin_name, int32(x))
 }
+
 func (Clt_CallVerifProgress_Origin) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 4, 1}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 4, 1}
 }
 
 type EndpointJSON_Method int32
@@ -145,6 +154,7 @@ var EndpointJSON_Method_name = map[int32]string{
 	8: "OPTIONS",
 	9: "TRACE",
 }
+
 var EndpointJSON_Method_value = map[string]int32{
 	"UNKNOWN": 0,
 	"GET":     1,
@@ -161,8 +171,9 @@ var EndpointJSON_Method_value = map[string]int32{
 func (x EndpointJSON_Method) String() string {
 	return proto.EnumName(EndpointJSON_Method_name, int32(x))
 }
+
 func (EndpointJSON_Method) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{7, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{7, 0}
 }
 
 type ParamJSON_Kind int32
@@ -184,6 +195,7 @@ var ParamJSON_Kind_name = map[int32]string{
 	4: "header",
 	5: "cookie",
 }
+
 var ParamJSON_Kind_value = map[string]int32{
 	"UNKNOWN": 0,
 	"body":    1,
@@ -196,8 +208,9 @@ var ParamJSON_Kind_value = map[string]int32{
 func (x ParamJSON_Kind) String() string {
 	return proto.EnumName(ParamJSON_Kind_name, int32(x))
 }
+
 func (ParamJSON_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{8, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{8, 0}
 }
 
 type Schema_JSON_Type int32
@@ -225,6 +238,7 @@ var Schema_JSON_Type_name = map[int32]string{
 	7: "string",
 	8: "object",
 }
+
 var Schema_JSON_Type_value = map[string]int32{
 	"UNKNOWN": 0,
 	"any":     1,
@@ -240,8 +254,9 @@ var Schema_JSON_Type_value = map[string]int32{
 func (x Schema_JSON_Type) String() string {
 	return proto.EnumName(Schema_JSON_Type_name, int32(x))
 }
+
 func (Schema_JSON_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{10, 0, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{10, 0, 0}
 }
 
 // type: string
@@ -294,6 +309,7 @@ var Schema_JSON_Format_name = map[int32]string{
 	23: "binary",
 	24: "password",
 }
+
 var Schema_JSON_Format_value = map[string]int32{
 	"NONE":          0,
 	"date_time":     1,
@@ -317,8 +333,9 @@ var Schema_JSON_Format_value = map[string]int32{
 func (x Schema_JSON_Format) String() string {
 	return proto.EnumName(Schema_JSON_Format_name, int32(x))
 }
+
 func (Schema_JSON_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{10, 0, 1}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{10, 0, 1}
 }
 
 type Clt struct {
@@ -338,7 +355,7 @@ func (m *Clt) Reset()         { *m = Clt{} }
 func (m *Clt) String() string { return proto.CompactTextString(m) }
 func (*Clt) ProtoMessage()    {}
 func (*Clt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0}
 }
 func (m *Clt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,15 +365,15 @@ func (m *Clt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Clt.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt.Merge(dst, src)
+func (m *Clt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt.Merge(m, src)
 }
 func (m *Clt) XXX_Size() int {
 	return m.Size()
@@ -375,19 +392,19 @@ type isClt_Msg interface {
 }
 
 type Clt_Fuzz_ struct {
-	Fuzz *Clt_Fuzz `protobuf:"bytes,1,opt,name=fuzz,oneof"`
+	Fuzz *Clt_Fuzz `protobuf:"bytes,1,opt,name=fuzz,proto3,oneof" json:"fuzz,omitempty"`
 }
 type Clt_ResetProgress_ struct {
-	ResetProgress *Clt_ResetProgress `protobuf:"bytes,2,opt,name=reset_progress,json=resetProgress,oneof"`
+	ResetProgress *Clt_ResetProgress `protobuf:"bytes,2,opt,name=reset_progress,json=resetProgress,proto3,oneof" json:"reset_progress,omitempty"`
 }
 type Clt_CallRequestRaw_ struct {
-	CallRequestRaw *Clt_CallRequestRaw `protobuf:"bytes,3,opt,name=call_request_raw,json=callRequestRaw,oneof"`
+	CallRequestRaw *Clt_CallRequestRaw `protobuf:"bytes,3,opt,name=call_request_raw,json=callRequestRaw,proto3,oneof" json:"call_request_raw,omitempty"`
 }
 type Clt_CallResponseRaw_ struct {
-	CallResponseRaw *Clt_CallResponseRaw `protobuf:"bytes,4,opt,name=call_response_raw,json=callResponseRaw,oneof"`
+	CallResponseRaw *Clt_CallResponseRaw `protobuf:"bytes,4,opt,name=call_response_raw,json=callResponseRaw,proto3,oneof" json:"call_response_raw,omitempty"`
 }
 type Clt_CallVerifProgress_ struct {
-	CallVerifProgress *Clt_CallVerifProgress `protobuf:"bytes,5,opt,name=call_verif_progress,json=callVerifProgress,oneof"`
+	CallVerifProgress *Clt_CallVerifProgress `protobuf:"bytes,5,opt,name=call_verif_progress,json=callVerifProgress,proto3,oneof" json:"call_verif_progress,omitempty"`
 }
 
 func (*Clt_Fuzz_) isClt_Msg()              {}
@@ -438,9 +455,9 @@ func (m *Clt) GetCallVerifProgress() *Clt_CallVerifProgress {
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Clt) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Clt_OneofMarshaler, _Clt_OneofUnmarshaler, _Clt_OneofSizer, []interface{}{
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Clt) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
 		(*Clt_Fuzz_)(nil),
 		(*Clt_ResetProgress_)(nil),
 		(*Clt_CallRequestRaw_)(nil),
@@ -449,137 +466,17 @@ func (*Clt) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fu
 	}
 }
 
-func _Clt_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Clt)
-	// msg
-	switch x := m.Msg.(type) {
-	case *Clt_Fuzz_:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Fuzz); err != nil {
-			return err
-		}
-	case *Clt_ResetProgress_:
-		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ResetProgress); err != nil {
-			return err
-		}
-	case *Clt_CallRequestRaw_:
-		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.CallRequestRaw); err != nil {
-			return err
-		}
-	case *Clt_CallResponseRaw_:
-		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.CallResponseRaw); err != nil {
-			return err
-		}
-	case *Clt_CallVerifProgress_:
-		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.CallVerifProgress); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Clt.Msg has unexpected type %T", x)
-	}
-	return nil
-}
-
-func _Clt_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Clt)
-	switch tag {
-	case 1: // msg.fuzz
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_Fuzz)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Clt_Fuzz_{msg}
-		return true, err
-	case 2: // msg.reset_progress
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_ResetProgress)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Clt_ResetProgress_{msg}
-		return true, err
-	case 3: // msg.call_request_raw
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_CallRequestRaw)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Clt_CallRequestRaw_{msg}
-		return true, err
-	case 4: // msg.call_response_raw
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_CallResponseRaw)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Clt_CallResponseRaw_{msg}
-		return true, err
-	case 5: // msg.call_verif_progress
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_CallVerifProgress)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Clt_CallVerifProgress_{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Clt_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Clt)
-	// msg
-	switch x := m.Msg.(type) {
-	case *Clt_Fuzz_:
-		s := proto.Size(x.Fuzz)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Clt_ResetProgress_:
-		s := proto.Size(x.ResetProgress)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Clt_CallRequestRaw_:
-		s := proto.Size(x.CallRequestRaw)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Clt_CallResponseRaw_:
-		s := proto.Size(x.CallResponseRaw)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Clt_CallVerifProgress_:
-		s := proto.Size(x.CallVerifProgress)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
 type Clt_Fuzz struct {
-	Resetter             *Clt_Fuzz_Resetter `protobuf:"bytes,1,opt,name=resetter" json:"resetter,omitempty"`
-	Model                *Clt_Fuzz_Model    `protobuf:"bytes,2,opt,name=model" json:"model,omitempty"`
-	Usage                []string           `protobuf:"bytes,3,rep,name=usage" json:"usage,omitempty"`
+	Resetter             *Clt_Fuzz_Resetter `protobuf:"bytes,1,opt,name=resetter,proto3" json:"resetter,omitempty"`
+	Model                *Clt_Fuzz_Model    `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Usage                []string           `protobuf:"bytes,3,rep,name=usage,proto3" json:"usage,omitempty"`
 	Seed                 []byte             `protobuf:"bytes,4,opt,name=seed,proto3" json:"seed,omitempty"`
 	Ntensity             uint32             `protobuf:"varint,5,opt,name=ntensity,proto3" json:"ntensity,omitempty"`
-	EIDs                 []uint32           `protobuf:"varint,6,rep,packed,name=EIDs" json:"EIDs,omitempty"`
-	Labels               map[string]string  `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	EnvRead              map[string]string  `protobuf:"bytes,8,rep,name=env_read,json=envRead" json:"env_read,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	UUIDs                []string           `protobuf:"bytes,9,rep,name=UUIDs" json:"UUIDs,omitempty"`
-	Files                map[string]string  `protobuf:"bytes,10,rep,name=files" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EIDs                 []uint32           `protobuf:"varint,6,rep,packed,name=EIDs,proto3" json:"EIDs,omitempty"`
+	Labels               map[string]string  `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EnvRead              map[string]string  `protobuf:"bytes,8,rep,name=env_read,json=envRead,proto3" json:"env_read,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UUIDs                []string           `protobuf:"bytes,9,rep,name=UUIDs,proto3" json:"UUIDs,omitempty"`
+	Files                map[string]string  `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -589,7 +486,7 @@ func (m *Clt_Fuzz) Reset()         { *m = Clt_Fuzz{} }
 func (m *Clt_Fuzz) String() string { return proto.CompactTextString(m) }
 func (*Clt_Fuzz) ProtoMessage()    {}
 func (*Clt_Fuzz) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0}
 }
 func (m *Clt_Fuzz) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,15 +496,15 @@ func (m *Clt_Fuzz) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Clt_Fuzz.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_Fuzz) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_Fuzz.Merge(dst, src)
+func (m *Clt_Fuzz) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz.Merge(m, src)
 }
 func (m *Clt_Fuzz) XXX_Size() int {
 	return m.Size()
@@ -691,6 +588,11 @@ func (m *Clt_Fuzz) GetFiles() map[string]string {
 type Clt_Fuzz_Resetter struct {
 	// Types that are valid to be assigned to Resetter:
 	//	*Clt_Fuzz_Resetter_Shell_
+	//	*Clt_Fuzz_Resetter_Process_
+	//	*Clt_Fuzz_Resetter_Http
+	//	*Clt_Fuzz_Resetter_Starlark_
+	//	*Clt_Fuzz_Resetter_Snapshot_
+	//	*Clt_Fuzz_Resetter_Sequence_
 	Resetter             isClt_Fuzz_Resetter_Resetter `protobuf_oneof:"resetter"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
@@ -701,7 +603,7 @@ func (m *Clt_Fuzz_Resetter) Reset()         { *m = Clt_Fuzz_Resetter{} }
 func (m *Clt_Fuzz_Resetter) String() string { return proto.CompactTextString(m) }
 func (*Clt_Fuzz_Resetter) ProtoMessage()    {}
 func (*Clt_Fuzz_Resetter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 0, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0}
 }
 func (m *Clt_Fuzz_Resetter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,15 +613,15 @@ func (m *Clt_Fuzz_Resetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_Clt_Fuzz_Resetter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_Fuzz_Resetter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_Fuzz_Resetter.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter.Merge(m, src)
 }
 func (m *Clt_Fuzz_Resetter) XXX_Size() int {
 	return m.Size()
@@ -738,10 +640,30 @@ type isClt_Fuzz_Resetter_Resetter interface {
 }
 
 type Clt_Fuzz_Resetter_Shell_ struct {
-	Shell *Clt_Fuzz_Resetter_Shell `protobuf:"bytes,1,opt,name=shell,oneof"`
+	Shell *Clt_Fuzz_Resetter_Shell `protobuf:"bytes,1,opt,name=shell,proto3,oneof" json:"shell,omitempty"`
+}
+type Clt_Fuzz_Resetter_Process_ struct {
+	Process *Clt_Fuzz_Resetter_Process `protobuf:"bytes,2,opt,name=process,proto3,oneof" json:"process,omitempty"`
+}
+type Clt_Fuzz_Resetter_Http struct {
+	Http *Clt_Fuzz_Resetter_HTTP `protobuf:"bytes,3,opt,name=http,proto3,oneof" json:"http,omitempty"`
+}
+type Clt_Fuzz_Resetter_Starlark_ struct {
+	Starlark *Clt_Fuzz_Resetter_Starlark `protobuf:"bytes,4,opt,name=starlark,proto3,oneof" json:"starlark,omitempty"`
+}
+type Clt_Fuzz_Resetter_Snapshot_ struct {
+	Snapshot *Clt_Fuzz_Resetter_Snapshot `protobuf:"bytes,5,opt,name=snapshot,proto3,oneof" json:"snapshot,omitempty"`
+}
+type Clt_Fuzz_Resetter_Sequence_ struct {
+	Sequence *Clt_Fuzz_Resetter_Sequence `protobuf:"bytes,6,opt,name=sequence,proto3,oneof" json:"sequence,omitempty"`
 }
 
-func (*Clt_Fuzz_Resetter_Shell_) isClt_Fuzz_Resetter_Resetter() {}
+func (*Clt_Fuzz_Resetter_Shell_) isClt_Fuzz_Resetter_Resetter()    {}
+func (*Clt_Fuzz_Resetter_Process_) isClt_Fuzz_Resetter_Resetter()  {}
+func (*Clt_Fuzz_Resetter_Http) isClt_Fuzz_Resetter_Resetter()      {}
+func (*Clt_Fuzz_Resetter_Starlark_) isClt_Fuzz_Resetter_Resetter() {}
+func (*Clt_Fuzz_Resetter_Snapshot_) isClt_Fuzz_Resetter_Resetter() {}
+func (*Clt_Fuzz_Resetter_Sequence_) isClt_Fuzz_Resetter_Resetter() {}
 
 func (m *Clt_Fuzz_Resetter) GetResetter() isClt_Fuzz_Resetter_Resetter {
 	if m != nil {
@@ -757,65 +679,63 @@ func (m *Clt_Fuzz_Resetter) GetShell() *Clt_Fuzz_Resetter_Shell {
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Clt_Fuzz_Resetter) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Clt_Fuzz_Resetter_OneofMarshaler, _Clt_Fuzz_Resetter_OneofUnmarshaler, _Clt_Fuzz_Resetter_OneofSizer, []interface{}{
-		(*Clt_Fuzz_Resetter_Shell_)(nil),
+func (m *Clt_Fuzz_Resetter) GetProcess() *Clt_Fuzz_Resetter_Process {
+	if x, ok := m.GetResetter().(*Clt_Fuzz_Resetter_Process_); ok {
+		return x.Process
 	}
+	return nil
 }
 
-func _Clt_Fuzz_Resetter_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Clt_Fuzz_Resetter)
-	// resetter
-	switch x := m.Resetter.(type) {
-	case *Clt_Fuzz_Resetter_Shell_:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Shell); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Clt_Fuzz_Resetter.Resetter has unexpected type %T", x)
+func (m *Clt_Fuzz_Resetter) GetHttp() *Clt_Fuzz_Resetter_HTTP {
+	if x, ok := m.GetResetter().(*Clt_Fuzz_Resetter_Http); ok {
+		return x.Http
 	}
 	return nil
 }
 
-func _Clt_Fuzz_Resetter_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Clt_Fuzz_Resetter)
-	switch tag {
-	case 1: // resetter.shell
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_Fuzz_Resetter_Shell)
-		err := b.DecodeMessage(msg)
-		m.Resetter = &Clt_Fuzz_Resetter_Shell_{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Clt_Fuzz_Resetter) GetStarlark() *Clt_Fuzz_Resetter_Starlark {
+	if x, ok := m.GetResetter().(*Clt_Fuzz_Resetter_Starlark_); ok {
+		return x.Starlark
 	}
+	return nil
 }
 
-func _Clt_Fuzz_Resetter_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Clt_Fuzz_Resetter)
-	// resetter
-	switch x := m.Resetter.(type) {
-	case *Clt_Fuzz_Resetter_Shell_:
-		s := proto.Size(x.Shell)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Clt_Fuzz_Resetter) GetSnapshot() *Clt_Fuzz_Resetter_Snapshot {
+	if x, ok := m.GetResetter().(*Clt_Fuzz_Resetter_Snapshot_); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (m *Clt_Fuzz_Resetter) GetSequence() *Clt_Fuzz_Resetter_Sequence {
+	if x, ok := m.GetResetter().(*Clt_Fuzz_Resetter_Sequence_); ok {
+		return x.Sequence
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Clt_Fuzz_Resetter) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Clt_Fuzz_Resetter_Shell_)(nil),
+		(*Clt_Fuzz_Resetter_Process_)(nil),
+		(*Clt_Fuzz_Resetter_Http)(nil),
+		(*Clt_Fuzz_Resetter_Starlark_)(nil),
+		(*Clt_Fuzz_Resetter_Snapshot_)(nil),
+		(*Clt_Fuzz_Resetter_Sequence_)(nil),
 	}
-	return n
 }
 
 type Clt_Fuzz_Resetter_Shell struct {
-	Start                string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	Rst                  string   `protobuf:"bytes,2,opt,name=rst,proto3" json:"rst,omitempty"`
-	Stop                 string   `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Rst   string `protobuf:"bytes,2,opt,name=rst,proto3" json:"rst,omitempty"`
+	Stop  string `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	// Shell is the interpreter's command line, /bin/bash if unset
+	Shell                string `protobuf:"bytes,4,opt,name=shell,proto3" json:"shell,omitempty"`
+	ExecTimeoutNs        int64  `protobuf:"varint,5,opt,name=exec_timeout_ns,json=execTimeoutNs,proto3" json:"exec_timeout_ns,omitempty"`
+	EnvSnapshotTimeoutNs int64  `protobuf:"varint,6,opt,name=env_snapshot_timeout_ns,json=envSnapshotTimeoutNs,proto3" json:"env_snapshot_timeout_ns,omitempty"`
+	// LogFile is tailed to capture logs during calls
+	LogFile              string   `protobuf:"bytes,7,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -825,7 +745,7 @@ func (m *Clt_Fuzz_Resetter_Shell) Reset()         { *m = Clt_Fuzz_Resetter_Shell
 func (m *Clt_Fuzz_Resetter_Shell) String() string { return proto.CompactTextString(m) }
 func (*Clt_Fuzz_Resetter_Shell) ProtoMessage()    {}
 func (*Clt_Fuzz_Resetter_Shell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 0, 0, 0}
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 0}
 }
 func (m *Clt_Fuzz_Resetter_Shell) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,15 +755,15 @@ func (m *Clt_Fuzz_Resetter_Shell) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_Clt_Fuzz_Resetter_Shell.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_Fuzz_Resetter_Shell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_Fuzz_Resetter_Shell.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_Shell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Shell.Merge(m, src)
 }
 func (m *Clt_Fuzz_Resetter_Shell) XXX_Size() int {
 	return m.Size()
@@ -875,4261 +795,5204 @@ func (m *Clt_Fuzz_Resetter_Shell) GetStop() string {
 	return ""
 }
 
-type Clt_Fuzz_Model struct {
-	// Types that are valid to be assigned to Model:
-	//	*Clt_Fuzz_Model_Openapiv3
-	Model                isClt_Fuzz_Model_Model `protobuf_oneof:"model"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *Clt_Fuzz_Resetter_Shell) GetShell() string {
+	if m != nil {
+		return m.Shell
+	}
+	return ""
 }
 
-func (m *Clt_Fuzz_Model) Reset()         { *m = Clt_Fuzz_Model{} }
-func (m *Clt_Fuzz_Model) String() string { return proto.CompactTextString(m) }
-func (*Clt_Fuzz_Model) ProtoMessage()    {}
-func (*Clt_Fuzz_Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 0, 1}
+func (m *Clt_Fuzz_Resetter_Shell) GetExecTimeoutNs() int64 {
+	if m != nil {
+		return m.ExecTimeoutNs
+	}
+	return 0
 }
-func (m *Clt_Fuzz_Model) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_Fuzz_Resetter_Shell) GetEnvSnapshotTimeoutNs() int64 {
+	if m != nil {
+		return m.EnvSnapshotTimeoutNs
+	}
+	return 0
+}
+
+func (m *Clt_Fuzz_Resetter_Shell) GetLogFile() string {
+	if m != nil {
+		return m.LogFile
+	}
+	return ""
+}
+
+type Clt_Fuzz_Resetter_Process struct {
+	// Cmd is the command line of the System Under Test
+	Cmd []string          `protobuf:"bytes,1,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	Env map[string]string `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ReadyHttp is a URL answering 2XX once the process is ready
+	ReadyHttp string `protobuf:"bytes,3,opt,name=ready_http,json=readyHttp,proto3" json:"ready_http,omitempty"`
+	// ReadyTcp is an address accepting connections once the process is ready
+	ReadyTcp       string `protobuf:"bytes,4,opt,name=ready_tcp,json=readyTcp,proto3" json:"ready_tcp,omitempty"`
+	ReadyTimeoutNs int64  `protobuf:"varint,5,opt,name=ready_timeout_ns,json=readyTimeoutNs,proto3" json:"ready_timeout_ns,omitempty"`
+	// RestartOnReset kills & restarts the process between tests
+	RestartOnReset bool `protobuf:"varint,6,opt,name=restart_on_reset,json=restartOnReset,proto3" json:"restart_on_reset,omitempty"`
+	// LogFile is tailed to capture logs during calls, along with outputs
+	LogFile              string   `protobuf:"bytes,7,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Clt_Fuzz_Resetter_Process) Reset()         { *m = Clt_Fuzz_Resetter_Process{} }
+func (m *Clt_Fuzz_Resetter_Process) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Resetter_Process) ProtoMessage()    {}
+func (*Clt_Fuzz_Resetter_Process) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 1}
+}
+func (m *Clt_Fuzz_Resetter_Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_Fuzz_Model) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Resetter_Process) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_Fuzz_Model.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Resetter_Process.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_Fuzz_Model) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_Fuzz_Model.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_Process) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Process.Merge(m, src)
 }
-func (m *Clt_Fuzz_Model) XXX_Size() int {
+func (m *Clt_Fuzz_Resetter_Process) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_Fuzz_Model) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_Fuzz_Model.DiscardUnknown(m)
+func (m *Clt_Fuzz_Resetter_Process) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Process.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_Fuzz_Model proto.InternalMessageInfo
-
-type isClt_Fuzz_Model_Model interface {
-	isClt_Fuzz_Model_Model()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
+var xxx_messageInfo_Clt_Fuzz_Resetter_Process proto.InternalMessageInfo
 
-type Clt_Fuzz_Model_Openapiv3 struct {
-	Openapiv3 *Clt_Fuzz_Model_OpenAPIv3 `protobuf:"bytes,1,opt,name=openapiv3,oneof"`
+func (m *Clt_Fuzz_Resetter_Process) GetCmd() []string {
+	if m != nil {
+		return m.Cmd
+	}
+	return nil
 }
 
-func (*Clt_Fuzz_Model_Openapiv3) isClt_Fuzz_Model_Model() {}
-
-func (m *Clt_Fuzz_Model) GetModel() isClt_Fuzz_Model_Model {
+func (m *Clt_Fuzz_Resetter_Process) GetEnv() map[string]string {
 	if m != nil {
-		return m.Model
+		return m.Env
 	}
 	return nil
 }
 
-func (m *Clt_Fuzz_Model) GetOpenapiv3() *Clt_Fuzz_Model_OpenAPIv3 {
-	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Openapiv3); ok {
-		return x.Openapiv3
+func (m *Clt_Fuzz_Resetter_Process) GetReadyHttp() string {
+	if m != nil {
+		return m.ReadyHttp
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Clt_Fuzz_Model) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Clt_Fuzz_Model_OneofMarshaler, _Clt_Fuzz_Model_OneofUnmarshaler, _Clt_Fuzz_Model_OneofSizer, []interface{}{
-		(*Clt_Fuzz_Model_Openapiv3)(nil),
+func (m *Clt_Fuzz_Resetter_Process) GetReadyTcp() string {
+	if m != nil {
+		return m.ReadyTcp
 	}
+	return ""
 }
 
-func _Clt_Fuzz_Model_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Clt_Fuzz_Model)
-	// model
-	switch x := m.Model.(type) {
-	case *Clt_Fuzz_Model_Openapiv3:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Openapiv3); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Clt_Fuzz_Model.Model has unexpected type %T", x)
+func (m *Clt_Fuzz_Resetter_Process) GetReadyTimeoutNs() int64 {
+	if m != nil {
+		return m.ReadyTimeoutNs
 	}
-	return nil
+	return 0
 }
 
-func _Clt_Fuzz_Model_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Clt_Fuzz_Model)
-	switch tag {
-	case 1: // model.openapiv3
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_Fuzz_Model_OpenAPIv3)
-		err := b.DecodeMessage(msg)
-		m.Model = &Clt_Fuzz_Model_Openapiv3{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Clt_Fuzz_Resetter_Process) GetRestartOnReset() bool {
+	if m != nil {
+		return m.RestartOnReset
 	}
+	return false
 }
 
-func _Clt_Fuzz_Model_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Clt_Fuzz_Model)
-	// model
-	switch x := m.Model.(type) {
-	case *Clt_Fuzz_Model_Openapiv3:
-		s := proto.Size(x.Openapiv3)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Clt_Fuzz_Resetter_Process) GetLogFile() string {
+	if m != nil {
+		return m.LogFile
 	}
-	return n
+	return ""
 }
 
-type Clt_Fuzz_Model_OpenAPIv3 struct {
-	// File path within current directory pointing to a YAML/JSON spec
-	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	// Host superseeds the spec's base URL
-	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	// HeaderAuthorization is added as bearer token if non-empty
-	HeaderAuthorization string `protobuf:"bytes,3,opt,name=header_authorization,json=headerAuthorization,proto3" json:"header_authorization,omitempty"`
-	// Spec is the spec pointed at by File
-	Spec                 *SpecIR  `protobuf:"bytes,4,opt,name=spec" json:"spec,omitempty"`
+type Clt_Fuzz_Resetter_HTTP struct {
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Url is requested to reset the System Under Test
+	Url     string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body    string            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// ExpectedStatus is the only status code accepted, any 2XX if unset
+	ExpectedStatus       uint32   `protobuf:"varint,5,opt,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`
+	TimeoutNs            int64    `protobuf:"varint,6,opt,name=timeout_ns,json=timeoutNs,proto3" json:"timeout_ns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) Reset()         { *m = Clt_Fuzz_Model_OpenAPIv3{} }
-func (m *Clt_Fuzz_Model_OpenAPIv3) String() string { return proto.CompactTextString(m) }
-func (*Clt_Fuzz_Model_OpenAPIv3) ProtoMessage()    {}
-func (*Clt_Fuzz_Model_OpenAPIv3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 0, 1, 0}
+func (m *Clt_Fuzz_Resetter_HTTP) Reset()         { *m = Clt_Fuzz_Resetter_HTTP{} }
+func (m *Clt_Fuzz_Resetter_HTTP) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Resetter_HTTP) ProtoMessage()    {}
+func (*Clt_Fuzz_Resetter_HTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 2}
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Resetter_HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Resetter_HTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Resetter_HTTP.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_Fuzz_Model_OpenAPIv3) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_HTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_HTTP.Merge(m, src)
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Size() int {
+func (m *Clt_Fuzz_Resetter_HTTP) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.DiscardUnknown(m)
+func (m *Clt_Fuzz_Resetter_HTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Resetter_HTTP.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3 proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Resetter_HTTP proto.InternalMessageInfo
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) GetFile() string {
+func (m *Clt_Fuzz_Resetter_HTTP) GetMethod() string {
 	if m != nil {
-		return m.File
+		return m.Method
 	}
 	return ""
 }
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) GetHost() string {
+func (m *Clt_Fuzz_Resetter_HTTP) GetUrl() string {
 	if m != nil {
-		return m.Host
+		return m.Url
 	}
 	return ""
 }
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) GetHeaderAuthorization() string {
+func (m *Clt_Fuzz_Resetter_HTTP) GetHeaders() map[string]string {
 	if m != nil {
-		return m.HeaderAuthorization
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Clt_Fuzz_Resetter_HTTP) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
 	return ""
 }
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) GetSpec() *SpecIR {
+func (m *Clt_Fuzz_Resetter_HTTP) GetExpectedStatus() uint32 {
 	if m != nil {
-		return m.Spec
+		return m.ExpectedStatus
 	}
-	return nil
+	return 0
 }
 
-type Clt_ResetProgress struct {
-	Status               Clt_ResetProgress_Status `protobuf:"varint,1,opt,name=status,proto3,enum=fm.Clt_ResetProgress_Status" json:"status,omitempty"`
-	ElapsedNs            int64                    `protobuf:"varint,2,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
-	Reason               []string                 `protobuf:"bytes,3,rep,name=reason" json:"reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *Clt_Fuzz_Resetter_HTTP) GetTimeoutNs() int64 {
+	if m != nil {
+		return m.TimeoutNs
+	}
+	return 0
 }
 
-func (m *Clt_ResetProgress) Reset()         { *m = Clt_ResetProgress{} }
-func (m *Clt_ResetProgress) String() string { return proto.CompactTextString(m) }
-func (*Clt_ResetProgress) ProtoMessage()    {}
-func (*Clt_ResetProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 1}
+type Clt_Fuzz_Resetter_Starlark struct {
+	// Names of the functions called
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Rst   string `protobuf:"bytes,2,opt,name=rst,proto3" json:"rst,omitempty"`
+	Stop  string `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	// ExecTimeoutNs bounds each call, defaults to 2 minutes
+	ExecTimeoutNs        int64    `protobuf:"varint,4,opt,name=exec_timeout_ns,json=execTimeoutNs,proto3" json:"exec_timeout_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Clt_ResetProgress) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_Fuzz_Resetter_Starlark) Reset()         { *m = Clt_Fuzz_Resetter_Starlark{} }
+func (m *Clt_Fuzz_Resetter_Starlark) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Resetter_Starlark) ProtoMessage()    {}
+func (*Clt_Fuzz_Resetter_Starlark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 3}
+}
+func (m *Clt_Fuzz_Resetter_Starlark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_ResetProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Resetter_Starlark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_ResetProgress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Resetter_Starlark.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_ResetProgress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_ResetProgress.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_Starlark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Starlark.Merge(m, src)
 }
-func (m *Clt_ResetProgress) XXX_Size() int {
+func (m *Clt_Fuzz_Resetter_Starlark) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_ResetProgress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_ResetProgress.DiscardUnknown(m)
+func (m *Clt_Fuzz_Resetter_Starlark) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Starlark.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_ResetProgress proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Resetter_Starlark proto.InternalMessageInfo
 
-func (m *Clt_ResetProgress) GetStatus() Clt_ResetProgress_Status {
+func (m *Clt_Fuzz_Resetter_Starlark) GetStart() string {
 	if m != nil {
-		return m.Status
+		return m.Start
 	}
-	return Clt_ResetProgress_NOOP
+	return ""
 }
 
-func (m *Clt_ResetProgress) GetElapsedNs() int64 {
+func (m *Clt_Fuzz_Resetter_Starlark) GetRst() string {
 	if m != nil {
-		return m.ElapsedNs
+		return m.Rst
 	}
-	return 0
+	return ""
 }
 
-func (m *Clt_ResetProgress) GetReason() []string {
+func (m *Clt_Fuzz_Resetter_Starlark) GetStop() string {
 	if m != nil {
-		return m.Reason
+		return m.Stop
 	}
-	return nil
+	return ""
 }
 
-type Clt_CallRequestRaw struct {
-	Input                *Clt_CallRequestRaw_Input `protobuf:"bytes,1,opt,name=input" json:"input,omitempty"`
-	Reason               []string                  `protobuf:"bytes,2,rep,name=reason" json:"reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *Clt_Fuzz_Resetter_Starlark) GetExecTimeoutNs() int64 {
+	if m != nil {
+		return m.ExecTimeoutNs
+	}
+	return 0
 }
 
-func (m *Clt_CallRequestRaw) Reset()         { *m = Clt_CallRequestRaw{} }
-func (m *Clt_CallRequestRaw) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallRequestRaw) ProtoMessage()    {}
-func (*Clt_CallRequestRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 2}
+type Clt_Fuzz_Resetter_Snapshot struct {
+	// Paths are files or directories restored between tests
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Process is stopped before & started after paths are restored
+	Process              *Clt_Fuzz_Resetter_Process `protobuf:"bytes,2,opt,name=process,proto3" json:"process,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
-func (m *Clt_CallRequestRaw) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_Fuzz_Resetter_Snapshot) Reset()         { *m = Clt_Fuzz_Resetter_Snapshot{} }
+func (m *Clt_Fuzz_Resetter_Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Resetter_Snapshot) ProtoMessage()    {}
+func (*Clt_Fuzz_Resetter_Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 4}
+}
+func (m *Clt_Fuzz_Resetter_Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallRequestRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Resetter_Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallRequestRaw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Resetter_Snapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallRequestRaw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallRequestRaw.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Snapshot.Merge(m, src)
 }
-func (m *Clt_CallRequestRaw) XXX_Size() int {
+func (m *Clt_Fuzz_Resetter_Snapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallRequestRaw) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallRequestRaw.DiscardUnknown(m)
+func (m *Clt_Fuzz_Resetter_Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Snapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallRequestRaw proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Resetter_Snapshot proto.InternalMessageInfo
 
-func (m *Clt_CallRequestRaw) GetInput() *Clt_CallRequestRaw_Input {
+func (m *Clt_Fuzz_Resetter_Snapshot) GetPaths() []string {
 	if m != nil {
-		return m.Input
+		return m.Paths
 	}
 	return nil
 }
 
-func (m *Clt_CallRequestRaw) GetReason() []string {
+func (m *Clt_Fuzz_Resetter_Snapshot) GetProcess() *Clt_Fuzz_Resetter_Process {
 	if m != nil {
-		return m.Reason
+		return m.Process
 	}
 	return nil
 }
 
-type Clt_CallRequestRaw_Input struct {
-	// Types that are valid to be assigned to Input:
-	//	*Clt_CallRequestRaw_Input_HttpRequest_
-	Input                isClt_CallRequestRaw_Input_Input `protobuf_oneof:"input"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+type Clt_Fuzz_Resetter_Sequence struct {
+	// Resetters run in order, stopping in reverse order
+	Resetters            []*Clt_Fuzz_Resetter `protobuf:"bytes,1,rep,name=resetters,proto3" json:"resetters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Clt_CallRequestRaw_Input) Reset()         { *m = Clt_CallRequestRaw_Input{} }
-func (m *Clt_CallRequestRaw_Input) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallRequestRaw_Input) ProtoMessage()    {}
-func (*Clt_CallRequestRaw_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 2, 0}
+func (m *Clt_Fuzz_Resetter_Sequence) Reset()         { *m = Clt_Fuzz_Resetter_Sequence{} }
+func (m *Clt_Fuzz_Resetter_Sequence) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Resetter_Sequence) ProtoMessage()    {}
+func (*Clt_Fuzz_Resetter_Sequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 0, 5}
 }
-func (m *Clt_CallRequestRaw_Input) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Resetter_Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallRequestRaw_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Resetter_Sequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallRequestRaw_Input.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Resetter_Sequence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallRequestRaw_Input) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallRequestRaw_Input.Merge(dst, src)
+func (m *Clt_Fuzz_Resetter_Sequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Sequence.Merge(m, src)
 }
-func (m *Clt_CallRequestRaw_Input) XXX_Size() int {
+func (m *Clt_Fuzz_Resetter_Sequence) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallRequestRaw_Input) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallRequestRaw_Input.DiscardUnknown(m)
+func (m *Clt_Fuzz_Resetter_Sequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Resetter_Sequence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallRequestRaw_Input proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Resetter_Sequence proto.InternalMessageInfo
 
-type isClt_CallRequestRaw_Input_Input interface {
-	isClt_CallRequestRaw_Input_Input()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *Clt_Fuzz_Resetter_Sequence) GetResetters() []*Clt_Fuzz_Resetter {
+	if m != nil {
+		return m.Resetters
+	}
+	return nil
 }
 
-type Clt_CallRequestRaw_Input_HttpRequest_ struct {
-	HttpRequest *Clt_CallRequestRaw_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,oneof"`
+type Clt_Fuzz_Model struct {
+	// Types that are valid to be assigned to Model:
+	//	*Clt_Fuzz_Model_Openapiv3
+	//	*Clt_Fuzz_Model_Graphql
+	//	*Clt_Fuzz_Model_Grpc
+	//	*Clt_Fuzz_Model_Asyncapi
+	//	*Clt_Fuzz_Model_Openrpc
+	Model                isClt_Fuzz_Model_Model `protobuf_oneof:"model"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Clt_Fuzz_Model) Reset()         { *m = Clt_Fuzz_Model{} }
+func (m *Clt_Fuzz_Model) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model) ProtoMessage()    {}
+func (*Clt_Fuzz_Model) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1}
+}
+func (m *Clt_Fuzz_Model) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clt_Fuzz_Model) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_Fuzz_Model.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Clt_Fuzz_Model) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model.Merge(m, src)
+}
+func (m *Clt_Fuzz_Model) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_Fuzz_Model) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model.DiscardUnknown(m)
 }
 
-func (*Clt_CallRequestRaw_Input_HttpRequest_) isClt_CallRequestRaw_Input_Input() {}
+var xxx_messageInfo_Clt_Fuzz_Model proto.InternalMessageInfo
 
-func (m *Clt_CallRequestRaw_Input) GetInput() isClt_CallRequestRaw_Input_Input {
+type isClt_Fuzz_Model_Model interface {
+	isClt_Fuzz_Model_Model()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Clt_Fuzz_Model_Openapiv3 struct {
+	Openapiv3 *Clt_Fuzz_Model_OpenAPIv3 `protobuf:"bytes,1,opt,name=openapiv3,proto3,oneof" json:"openapiv3,omitempty"`
+}
+type Clt_Fuzz_Model_Graphql struct {
+	Graphql *Clt_Fuzz_Model_GraphQL `protobuf:"bytes,2,opt,name=graphql,proto3,oneof" json:"graphql,omitempty"`
+}
+type Clt_Fuzz_Model_Grpc struct {
+	Grpc *Clt_Fuzz_Model_GRPC `protobuf:"bytes,3,opt,name=grpc,proto3,oneof" json:"grpc,omitempty"`
+}
+type Clt_Fuzz_Model_Asyncapi struct {
+	Asyncapi *Clt_Fuzz_Model_AsyncAPI `protobuf:"bytes,4,opt,name=asyncapi,proto3,oneof" json:"asyncapi,omitempty"`
+}
+type Clt_Fuzz_Model_Openrpc struct {
+	Openrpc *Clt_Fuzz_Model_OpenRPC `protobuf:"bytes,5,opt,name=openrpc,proto3,oneof" json:"openrpc,omitempty"`
+}
+
+func (*Clt_Fuzz_Model_Openapiv3) isClt_Fuzz_Model_Model() {}
+func (*Clt_Fuzz_Model_Graphql) isClt_Fuzz_Model_Model()   {}
+func (*Clt_Fuzz_Model_Grpc) isClt_Fuzz_Model_Model()      {}
+func (*Clt_Fuzz_Model_Asyncapi) isClt_Fuzz_Model_Model()  {}
+func (*Clt_Fuzz_Model_Openrpc) isClt_Fuzz_Model_Model()   {}
+
+func (m *Clt_Fuzz_Model) GetModel() isClt_Fuzz_Model_Model {
 	if m != nil {
-		return m.Input
+		return m.Model
 	}
 	return nil
 }
 
-func (m *Clt_CallRequestRaw_Input) GetHttpRequest() *Clt_CallRequestRaw_Input_HttpRequest {
-	if x, ok := m.GetInput().(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
-		return x.HttpRequest
+func (m *Clt_Fuzz_Model) GetOpenapiv3() *Clt_Fuzz_Model_OpenAPIv3 {
+	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Openapiv3); ok {
+		return x.Openapiv3
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Clt_CallRequestRaw_Input) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Clt_CallRequestRaw_Input_OneofMarshaler, _Clt_CallRequestRaw_Input_OneofUnmarshaler, _Clt_CallRequestRaw_Input_OneofSizer, []interface{}{
-		(*Clt_CallRequestRaw_Input_HttpRequest_)(nil),
+func (m *Clt_Fuzz_Model) GetGraphql() *Clt_Fuzz_Model_GraphQL {
+	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Graphql); ok {
+		return x.Graphql
+	}
+	return nil
+}
+
+func (m *Clt_Fuzz_Model) GetGrpc() *Clt_Fuzz_Model_GRPC {
+	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Grpc); ok {
+		return x.Grpc
 	}
+	return nil
 }
 
-func _Clt_CallRequestRaw_Input_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Clt_CallRequestRaw_Input)
-	// input
-	switch x := m.Input.(type) {
-	case *Clt_CallRequestRaw_Input_HttpRequest_:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.HttpRequest); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Clt_CallRequestRaw_Input.Input has unexpected type %T", x)
+func (m *Clt_Fuzz_Model) GetAsyncapi() *Clt_Fuzz_Model_AsyncAPI {
+	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Asyncapi); ok {
+		return x.Asyncapi
 	}
 	return nil
 }
 
-func _Clt_CallRequestRaw_Input_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Clt_CallRequestRaw_Input)
-	switch tag {
-	case 1: // input.http_request
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_CallRequestRaw_Input_HttpRequest)
-		err := b.DecodeMessage(msg)
-		m.Input = &Clt_CallRequestRaw_Input_HttpRequest_{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Clt_Fuzz_Model) GetOpenrpc() *Clt_Fuzz_Model_OpenRPC {
+	if x, ok := m.GetModel().(*Clt_Fuzz_Model_Openrpc); ok {
+		return x.Openrpc
 	}
+	return nil
 }
 
-func _Clt_CallRequestRaw_Input_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Clt_CallRequestRaw_Input)
-	// input
-	switch x := m.Input.(type) {
-	case *Clt_CallRequestRaw_Input_HttpRequest_:
-		s := proto.Size(x.HttpRequest)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Clt_Fuzz_Model) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Clt_Fuzz_Model_Openapiv3)(nil),
+		(*Clt_Fuzz_Model_Graphql)(nil),
+		(*Clt_Fuzz_Model_Grpc)(nil),
+		(*Clt_Fuzz_Model_Asyncapi)(nil),
+		(*Clt_Fuzz_Model_Openrpc)(nil),
 	}
-	return n
 }
 
-type Clt_CallRequestRaw_Input_HttpRequest struct {
-	Method               string                                                        `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Url                  string                                                        `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Headers              map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Body                 []byte                                                        `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	BodyDecoded          *types.Value                                                  `protobuf:"bytes,5,opt,name=body_decoded,json=bodyDecoded" json:"body_decoded,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                                      `json:"-"`
-	XXX_unrecognized     []byte                                                        `json:"-"`
-	XXX_sizecache        int32                                                         `json:"-"`
+type Clt_Fuzz_Model_OpenAPIv3 struct {
+	// File path within current directory pointing to a YAML/JSON spec
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Host superseeds the spec's base URL (may point to a Unix domain socket)
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// HeaderAuthorization is added as bearer token if non-empty
+	HeaderAuthorization string `protobuf:"bytes,3,opt,name=header_authorization,json=headerAuthorization,proto3" json:"header_authorization,omitempty"`
+	// Spec is the spec pointed at by File
+	Spec *SpecIR `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Proxy superseeds the HTTP(S)_PROXY environment variables
+	Proxy string `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// TlsCaFile points to PEM certificates trusted on top of the system's
+	TlsCaFile string `protobuf:"bytes,6,opt,name=tls_ca_file,json=tlsCaFile,proto3" json:"tls_ca_file,omitempty"`
+	// TlsCertFile & TlsKeyFile point to a PEM client certificate (mTLS)
+	TlsCertFile string `protobuf:"bytes,7,opt,name=tls_cert_file,json=tlsCertFile,proto3" json:"tls_cert_file,omitempty"`
+	TlsKeyFile  string `protobuf:"bytes,8,opt,name=tls_key_file,json=tlsKeyFile,proto3" json:"tls_key_file,omitempty"`
+	// TlsInsecureSkipVerify disables verification of the server's certificate
+	TlsInsecureSkipVerify bool `protobuf:"varint,9,opt,name=tls_insecure_skip_verify,json=tlsInsecureSkipVerify,proto3" json:"tls_insecure_skip_verify,omitempty"`
+	// TlsServerName superseeds the hostname used to verify the server's certificate
+	TlsServerName string `protobuf:"bytes,10,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	// Http2 attempts HTTP/2 connections
+	Http2 bool `protobuf:"varint,11,opt,name=http2,proto3" json:"http2,omitempty"`
+	// MaxConnsPerHost limits connections to the SUT (0 means no limit)
+	MaxConnsPerHost uint32 `protobuf:"varint,12,opt,name=max_conns_per_host,json=maxConnsPerHost,proto3" json:"max_conns_per_host,omitempty"`
+	// MaxIdleConnsPerHost limits connections kept alive between calls
+	MaxIdleConnsPerHost uint32 `protobuf:"varint,13,opt,name=max_idle_conns_per_host,json=maxIdleConnsPerHost,proto3" json:"max_idle_conns_per_host,omitempty"`
+	// FreshConnectionsPerTest closes kept-alive connections on each reset
+	FreshConnectionsPerTest bool `protobuf:"varint,14,opt,name=fresh_connections_per_test,json=freshConnectionsPerTest,proto3" json:"fresh_connections_per_test,omitempty"`
+	// RequestTimeoutNs bounds a call's duration, redirects included (0 means no limit)
+	RequestTimeoutNs int64 `protobuf:"varint,15,opt,name=request_timeout_ns,json=requestTimeoutNs,proto3" json:"request_timeout_ns,omitempty"`
+	// ReadTimeoutNs bounds how long the response may go without being read from (0 means no limit)
+	ReadTimeoutNs int64 `protobuf:"varint,16,opt,name=read_timeout_ns,json=readTimeoutNs,proto3" json:"read_timeout_ns,omitempty"`
+	// MaxResponseBytes bounds the size of response bodies (0 means no limit)
+	MaxResponseBytes uint64 `protobuf:"varint,17,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty"`
+	// CookieJar keeps cookies set by the SUT across calls of a same test
+	CookieJar bool `protobuf:"varint,18,opt,name=cookie_jar,json=cookieJar,proto3" json:"cookie_jar,omitempty"`
+	// StreamDurationNs bounds how long SSE & NDJSON streams are read for (0 means default)
+	StreamDurationNs int64 `protobuf:"varint,19,opt,name=stream_duration_ns,json=streamDurationNs,proto3" json:"stream_duration_ns,omitempty"`
+	// MaxCallsPerSecond throttles calls to the SUT (0 means no limit)
+	MaxCallsPerSecond uint32 `protobuf:"varint,20,opt,name=max_calls_per_second,json=maxCallsPerSecond,proto3" json:"max_calls_per_second,omitempty"`
+	// HonorRetryAfter retries 429 & 503 responses after waiting for their Retry-After
+	HonorRetryAfter bool `protobuf:"varint,21,opt,name=honor_retry_after,json=honorRetryAfter,proto3" json:"honor_retry_after,omitempty"`
+	// MaxRetryWaitNs bounds a single Retry-After wait (0 means default)
+	MaxRetryWaitNs int64 `protobuf:"varint,22,opt,name=max_retry_wait_ns,json=maxRetryWaitNs,proto3" json:"max_retry_wait_ns,omitempty"`
+	// Signer describes how requests get signed, secrets excluded
+	Signer string `protobuf:"bytes,23,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Headers are set on every request
+	Headers map[string]string `protobuf:"bytes,24,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Query params are set on every request
+	Query map[string]string `protobuf:"bytes,25,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Overrides are keyed by operationId or by path pattern (e.g. /pets/*)
+	Overrides            map[string]*Clt_Fuzz_Model_OpenAPIv3_Override `protobuf:"bytes,26,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) Reset()         { *m = Clt_CallRequestRaw_Input_HttpRequest{} }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallRequestRaw_Input_HttpRequest) ProtoMessage()    {}
-func (*Clt_CallRequestRaw_Input_HttpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 2, 0, 0}
+func (m *Clt_Fuzz_Model_OpenAPIv3) Reset()         { *m = Clt_Fuzz_Model_OpenAPIv3{} }
+func (m *Clt_Fuzz_Model_OpenAPIv3) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_OpenAPIv3) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_OpenAPIv3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 0}
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallRequestRaw_Input_HttpRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.Merge(dst, src)
+func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.Merge(m, src)
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Size() int {
+func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.DiscardUnknown(m)
+func (m *Clt_Fuzz_Model_OpenAPIv3) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3 proto.InternalMessageInfo
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) GetMethod() string {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetFile() string {
 	if m != nil {
-		return m.Method
+		return m.File
 	}
 	return ""
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) GetUrl() string {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetHost() string {
 	if m != nil {
-		return m.Url
+		return m.Host
 	}
 	return ""
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) GetHeaders() map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetHeaderAuthorization() string {
 	if m != nil {
-		return m.Headers
+		return m.HeaderAuthorization
 	}
-	return nil
+	return ""
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) GetBody() []byte {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetSpec() *SpecIR {
 	if m != nil {
-		return m.Body
+		return m.Spec
 	}
 	return nil
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) GetBodyDecoded() *types.Value {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetProxy() string {
 	if m != nil {
-		return m.BodyDecoded
+		return m.Proxy
 	}
-	return nil
+	return ""
 }
 
-type Clt_CallRequestRaw_Input_HttpRequest_HeaderValues struct {
-	Values               []string `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetTlsCaFile() string {
+	if m != nil {
+		return m.TlsCaFile
+	}
+	return ""
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Reset() {
-	*m = Clt_CallRequestRaw_Input_HttpRequest_HeaderValues{}
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetTlsCertFile() string {
+	if m != nil {
+		return m.TlsCertFile
+	}
+	return ""
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) String() string {
-	return proto.CompactTextString(m)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetTlsKeyFile() string {
+	if m != nil {
+		return m.TlsKeyFile
+	}
+	return ""
 }
-func (*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) ProtoMessage() {}
-func (*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 2, 0, 0, 0}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetTlsInsecureSkipVerify() bool {
+	if m != nil {
+		return m.TlsInsecureSkipVerify
+	}
+	return false
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetTlsServerName() string {
+	if m != nil {
+		return m.TlsServerName
+	}
+	return ""
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetHttp2() bool {
+	if m != nil {
+		return m.Http2
 	}
+	return false
 }
-func (dst *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.Merge(dst, src)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetMaxConnsPerHost() uint32 {
+	if m != nil {
+		return m.MaxConnsPerHost
+	}
+	return 0
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Size() int {
-	return m.Size()
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetMaxIdleConnsPerHost() uint32 {
+	if m != nil {
+		return m.MaxIdleConnsPerHost
+	}
+	return 0
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.DiscardUnknown(m)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetFreshConnectionsPerTest() bool {
+	if m != nil {
+		return m.FreshConnectionsPerTest
+	}
+	return false
 }
 
-var xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues proto.InternalMessageInfo
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetRequestTimeoutNs() int64 {
+	if m != nil {
+		return m.RequestTimeoutNs
+	}
+	return 0
+}
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) GetValues() []string {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetReadTimeoutNs() int64 {
 	if m != nil {
-		return m.Values
+		return m.ReadTimeoutNs
 	}
-	return nil
+	return 0
 }
 
-type Clt_CallResponseRaw struct {
-	Output               *Clt_CallResponseRaw_Output `protobuf:"bytes,1,opt,name=output" json:"output,omitempty"`
-	OutputId             uint32                      `protobuf:"varint,2,opt,name=outputId,proto3" json:"outputId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetMaxResponseBytes() uint64 {
+	if m != nil {
+		return m.MaxResponseBytes
+	}
+	return 0
 }
 
-func (m *Clt_CallResponseRaw) Reset()         { *m = Clt_CallResponseRaw{} }
-func (m *Clt_CallResponseRaw) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallResponseRaw) ProtoMessage()    {}
-func (*Clt_CallResponseRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 3}
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetCookieJar() bool {
+	if m != nil {
+		return m.CookieJar
+	}
+	return false
 }
-func (m *Clt_CallResponseRaw) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetStreamDurationNs() int64 {
+	if m != nil {
+		return m.StreamDurationNs
+	}
+	return 0
 }
-func (m *Clt_CallResponseRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Clt_CallResponseRaw.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetMaxCallsPerSecond() uint32 {
+	if m != nil {
+		return m.MaxCallsPerSecond
 	}
+	return 0
 }
-func (dst *Clt_CallResponseRaw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallResponseRaw.Merge(dst, src)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetHonorRetryAfter() bool {
+	if m != nil {
+		return m.HonorRetryAfter
+	}
+	return false
 }
-func (m *Clt_CallResponseRaw) XXX_Size() int {
-	return m.Size()
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetMaxRetryWaitNs() int64 {
+	if m != nil {
+		return m.MaxRetryWaitNs
+	}
+	return 0
 }
-func (m *Clt_CallResponseRaw) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallResponseRaw.DiscardUnknown(m)
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-var xxx_messageInfo_Clt_CallResponseRaw proto.InternalMessageInfo
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
 
-func (m *Clt_CallResponseRaw) GetOutput() *Clt_CallResponseRaw_Output {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetQuery() map[string]string {
 	if m != nil {
-		return m.Output
+		return m.Query
 	}
 	return nil
 }
 
-func (m *Clt_CallResponseRaw) GetOutputId() uint32 {
+func (m *Clt_Fuzz_Model_OpenAPIv3) GetOverrides() map[string]*Clt_Fuzz_Model_OpenAPIv3_Override {
 	if m != nil {
-		return m.OutputId
+		return m.Overrides
 	}
-	return 0
+	return nil
 }
 
-type Clt_CallResponseRaw_Output struct {
-	// Types that are valid to be assigned to Output:
-	//	*Clt_CallResponseRaw_Output_HttpResponse_
-	Output               isClt_CallResponseRaw_Output_Output `protobuf_oneof:"output"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+type Clt_Fuzz_Model_OpenAPIv3_Override struct {
+	Headers              map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Query                map[string]string `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Clt_CallResponseRaw_Output) Reset()         { *m = Clt_CallResponseRaw_Output{} }
-func (m *Clt_CallResponseRaw_Output) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallResponseRaw_Output) ProtoMessage()    {}
-func (*Clt_CallResponseRaw_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 3, 0}
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) Reset()         { *m = Clt_Fuzz_Model_OpenAPIv3_Override{} }
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_OpenAPIv3_Override) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_OpenAPIv3_Override) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 0, 2}
 }
-func (m *Clt_CallResponseRaw_Output) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallResponseRaw_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallResponseRaw_Output.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3_Override.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallResponseRaw_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallResponseRaw_Output.Merge(dst, src)
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3_Override.Merge(m, src)
 }
-func (m *Clt_CallResponseRaw_Output) XXX_Size() int {
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallResponseRaw_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallResponseRaw_Output.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Clt_CallResponseRaw_Output proto.InternalMessageInfo
-
-type isClt_CallResponseRaw_Output_Output interface {
-	isClt_CallResponseRaw_Output_Output()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Clt_CallResponseRaw_Output_HttpResponse_ struct {
-	HttpResponse *Clt_CallResponseRaw_Output_HttpResponse `protobuf:"bytes,1,opt,name=http_response,json=httpResponse,oneof"`
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3_Override.DiscardUnknown(m)
 }
 
-func (*Clt_CallResponseRaw_Output_HttpResponse_) isClt_CallResponseRaw_Output_Output() {}
+var xxx_messageInfo_Clt_Fuzz_Model_OpenAPIv3_Override proto.InternalMessageInfo
 
-func (m *Clt_CallResponseRaw_Output) GetOutput() isClt_CallResponseRaw_Output_Output {
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) GetHeaders() map[string]string {
 	if m != nil {
-		return m.Output
-	}
-	return nil
-}
-
-func (m *Clt_CallResponseRaw_Output) GetHttpResponse() *Clt_CallResponseRaw_Output_HttpResponse {
-	if x, ok := m.GetOutput().(*Clt_CallResponseRaw_Output_HttpResponse_); ok {
-		return x.HttpResponse
+		return m.Headers
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Clt_CallResponseRaw_Output) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Clt_CallResponseRaw_Output_OneofMarshaler, _Clt_CallResponseRaw_Output_OneofUnmarshaler, _Clt_CallResponseRaw_Output_OneofSizer, []interface{}{
-		(*Clt_CallResponseRaw_Output_HttpResponse_)(nil),
-	}
-}
-
-func _Clt_CallResponseRaw_Output_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Clt_CallResponseRaw_Output)
-	// output
-	switch x := m.Output.(type) {
-	case *Clt_CallResponseRaw_Output_HttpResponse_:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.HttpResponse); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Clt_CallResponseRaw_Output.Output has unexpected type %T", x)
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) GetQuery() map[string]string {
+	if m != nil {
+		return m.Query
 	}
 	return nil
 }
 
-func _Clt_CallResponseRaw_Output_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Clt_CallResponseRaw_Output)
-	switch tag {
-	case 1: // output.http_response
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Clt_CallResponseRaw_Output_HttpResponse)
-		err := b.DecodeMessage(msg)
-		m.Output = &Clt_CallResponseRaw_Output_HttpResponse_{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Clt_CallResponseRaw_Output_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Clt_CallResponseRaw_Output)
-	// output
-	switch x := m.Output.(type) {
-	case *Clt_CallResponseRaw_Output_HttpResponse_:
-		s := proto.Size(x.HttpResponse)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type Clt_CallResponseRaw_Output_HttpResponse struct {
-	Error                string                                                           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	StatusCode           uint32                                                           `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Reason               string                                                           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Headers              map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Body                 []byte                                                           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	BodyDecoded          *types.Value                                                     `protobuf:"bytes,6,opt,name=body_decoded,json=bodyDecoded" json:"body_decoded,omitempty"`
-	ElapsedNs            int64                                                            `protobuf:"varint,7,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                                         `json:"-"`
-	XXX_unrecognized     []byte                                                           `json:"-"`
-	XXX_sizecache        int32                                                            `json:"-"`
+type Clt_Fuzz_Model_GraphQL struct {
+	// Schema is a file path within current directory pointing to an SDL schema
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Endpoint is the URL queries and mutations are POSTed to
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Http holds the HTTP configuration and the Spec built from Schema
+	Http                 *Clt_Fuzz_Model_OpenAPIv3 `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) Reset() {
-	*m = Clt_CallResponseRaw_Output_HttpResponse{}
-}
-func (m *Clt_CallResponseRaw_Output_HttpResponse) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallResponseRaw_Output_HttpResponse) ProtoMessage()    {}
-func (*Clt_CallResponseRaw_Output_HttpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 3, 0, 0}
+func (m *Clt_Fuzz_Model_GraphQL) Reset()         { *m = Clt_Fuzz_Model_GraphQL{} }
+func (m *Clt_Fuzz_Model_GraphQL) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_GraphQL) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_GraphQL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 1}
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Model_GraphQL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_GraphQL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_GraphQL.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallResponseRaw_Output_HttpResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.Merge(dst, src)
+func (m *Clt_Fuzz_Model_GraphQL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_GraphQL.Merge(m, src)
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Size() int {
+func (m *Clt_Fuzz_Model_GraphQL) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.DiscardUnknown(m)
+func (m *Clt_Fuzz_Model_GraphQL) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_GraphQL.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Model_GraphQL proto.InternalMessageInfo
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetError() string {
+func (m *Clt_Fuzz_Model_GraphQL) GetSchema() string {
 	if m != nil {
-		return m.Error
+		return m.Schema
 	}
 	return ""
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetStatusCode() uint32 {
-	if m != nil {
-		return m.StatusCode
-	}
-	return 0
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetReason() string {
+func (m *Clt_Fuzz_Model_GraphQL) GetEndpoint() string {
 	if m != nil {
-		return m.Reason
+		return m.Endpoint
 	}
 	return ""
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetHeaders() map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues {
-	if m != nil {
-		return m.Headers
-	}
-	return nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetBody() []byte {
-	if m != nil {
-		return m.Body
-	}
-	return nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetBodyDecoded() *types.Value {
+func (m *Clt_Fuzz_Model_GraphQL) GetHttp() *Clt_Fuzz_Model_OpenAPIv3 {
 	if m != nil {
-		return m.BodyDecoded
+		return m.Http
 	}
 	return nil
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) GetElapsedNs() int64 {
-	if m != nil {
-		return m.ElapsedNs
-	}
-	return 0
-}
-
-type Clt_CallResponseRaw_Output_HttpResponse_HeaderValues struct {
-	Values               []string `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+type Clt_Fuzz_Model_GRPC struct {
+	// ProtoFiles are paths within current directory pointing to .proto files or descriptor sets
+	ProtoFiles []string `protobuf:"bytes,1,rep,name=proto_files,json=protoFiles,proto3" json:"proto_files,omitempty"`
+	// Target is the address of the server (e.g. localhost:50051)
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Metadata is sent along every call
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Spec is built from the unary RPCs of services in ProtoFiles
+	Spec                 *SpecIR  `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Reset() {
-	*m = Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{}
+func (m *Clt_Fuzz_Model_GRPC) Reset()         { *m = Clt_Fuzz_Model_GRPC{} }
+func (m *Clt_Fuzz_Model_GRPC) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_GRPC) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_GRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 2}
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) String() string {
-	return proto.CompactTextString(m)
-}
-func (*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) ProtoMessage() {}
-func (*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 3, 0, 0, 0}
-}
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Model_GRPC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_GRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_GRPC.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.Merge(dst, src)
+func (m *Clt_Fuzz_Model_GRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_GRPC.Merge(m, src)
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Size() int {
+func (m *Clt_Fuzz_Model_GRPC) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.DiscardUnknown(m)
+func (m *Clt_Fuzz_Model_GRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_GRPC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Model_GRPC proto.InternalMessageInfo
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) GetValues() []string {
+func (m *Clt_Fuzz_Model_GRPC) GetProtoFiles() []string {
 	if m != nil {
-		return m.Values
+		return m.ProtoFiles
 	}
 	return nil
 }
 
-type Clt_CallVerifProgress struct {
-	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status               Clt_CallVerifProgress_Status `protobuf:"varint,2,opt,name=status,proto3,enum=fm.Clt_CallVerifProgress_Status" json:"status,omitempty"`
-	Origin               Clt_CallVerifProgress_Origin `protobuf:"varint,3,opt,name=origin,proto3,enum=fm.Clt_CallVerifProgress_Origin" json:"origin,omitempty"`
-	Reason               []string                     `protobuf:"bytes,4,rep,name=reason" json:"reason,omitempty"`
-	ElapsedNs            int64                        `protobuf:"varint,5,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
-	ExecutionSteps       uint64                       `protobuf:"varint,6,opt,name=execution_steps,json=executionSteps,proto3" json:"execution_steps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+func (m *Clt_Fuzz_Model_GRPC) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *Clt_CallVerifProgress) Reset()         { *m = Clt_CallVerifProgress{} }
-func (m *Clt_CallVerifProgress) String() string { return proto.CompactTextString(m) }
-func (*Clt_CallVerifProgress) ProtoMessage()    {}
-func (*Clt_CallVerifProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{0, 4}
+func (m *Clt_Fuzz_Model_GRPC) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
-func (m *Clt_CallVerifProgress) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_Fuzz_Model_GRPC) GetSpec() *SpecIR {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type Clt_Fuzz_Model_AsyncAPI struct {
+	// File is a path within current directory pointing to an AsyncAPI document
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Url is the WebSocket server channels are relative to (e.g. ws://localhost:8080)
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// ReceiveWindowNs is how long to wait for messages after publishing one
+	ReceiveWindowNs int64 `protobuf:"varint,3,opt,name=receive_window_ns,json=receiveWindowNs,proto3" json:"receive_window_ns,omitempty"`
+	// Headers are sent along the WebSocket handshake
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Spec is built from the publish & subscribe operations of channels
+	Spec                 *SpecIR  `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Clt_Fuzz_Model_AsyncAPI) Reset()         { *m = Clt_Fuzz_Model_AsyncAPI{} }
+func (m *Clt_Fuzz_Model_AsyncAPI) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_AsyncAPI) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_AsyncAPI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 3}
+}
+func (m *Clt_Fuzz_Model_AsyncAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clt_CallVerifProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_AsyncAPI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clt_CallVerifProgress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_AsyncAPI.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Clt_CallVerifProgress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clt_CallVerifProgress.Merge(dst, src)
+func (m *Clt_Fuzz_Model_AsyncAPI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_AsyncAPI.Merge(m, src)
 }
-func (m *Clt_CallVerifProgress) XXX_Size() int {
+func (m *Clt_Fuzz_Model_AsyncAPI) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clt_CallVerifProgress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clt_CallVerifProgress.DiscardUnknown(m)
+func (m *Clt_Fuzz_Model_AsyncAPI) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_AsyncAPI.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clt_CallVerifProgress proto.InternalMessageInfo
+var xxx_messageInfo_Clt_Fuzz_Model_AsyncAPI proto.InternalMessageInfo
 
-func (m *Clt_CallVerifProgress) GetName() string {
+func (m *Clt_Fuzz_Model_AsyncAPI) GetFile() string {
 	if m != nil {
-		return m.Name
+		return m.File
 	}
 	return ""
 }
 
-func (m *Clt_CallVerifProgress) GetStatus() Clt_CallVerifProgress_Status {
+func (m *Clt_Fuzz_Model_AsyncAPI) GetUrl() string {
 	if m != nil {
-		return m.Status
+		return m.Url
 	}
-	return Clt_CallVerifProgress_NO_STATUS
+	return ""
 }
 
-func (m *Clt_CallVerifProgress) GetOrigin() Clt_CallVerifProgress_Origin {
+func (m *Clt_Fuzz_Model_AsyncAPI) GetReceiveWindowNs() int64 {
 	if m != nil {
-		return m.Origin
+		return m.ReceiveWindowNs
 	}
-	return Clt_CallVerifProgress_NO_ORIGIN
+	return 0
 }
 
-func (m *Clt_CallVerifProgress) GetReason() []string {
+func (m *Clt_Fuzz_Model_AsyncAPI) GetHeaders() map[string]string {
 	if m != nil {
-		return m.Reason
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *Clt_CallVerifProgress) GetElapsedNs() int64 {
-	if m != nil {
-		return m.ElapsedNs
-	}
-	return 0
-}
-
-func (m *Clt_CallVerifProgress) GetExecutionSteps() uint64 {
+func (m *Clt_Fuzz_Model_AsyncAPI) GetSpec() *SpecIR {
 	if m != nil {
-		return m.ExecutionSteps
+		return m.Spec
 	}
-	return 0
+	return nil
 }
 
-type Srv struct {
-	FuzzingProgress *Srv_FuzzingProgress `protobuf:"bytes,1,opt,name=fuzzing_progress,json=fuzzingProgress" json:"fuzzing_progress,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//	*Srv_FuzzRep_
-	//	*Srv_Call_
-	//	*Srv_Reset_
-	//	*Srv_FuzzingResult_
-	Msg                  isSrv_Msg `protobuf_oneof:"msg"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type Clt_Fuzz_Model_OpenRPC struct {
+	// File is a path within current directory pointing to an OpenRPC document
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Host is the URL JSON-RPC requests are POSTed to
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// Http holds the HTTP configuration and the Spec built from File
+	Http                 *Clt_Fuzz_Model_OpenAPIv3 `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *Srv) Reset()         { *m = Srv{} }
-func (m *Srv) String() string { return proto.CompactTextString(m) }
-func (*Srv) ProtoMessage()    {}
-func (*Srv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1}
+func (m *Clt_Fuzz_Model_OpenRPC) Reset()         { *m = Clt_Fuzz_Model_OpenRPC{} }
+func (m *Clt_Fuzz_Model_OpenRPC) String() string { return proto.CompactTextString(m) }
+func (*Clt_Fuzz_Model_OpenRPC) ProtoMessage()    {}
+func (*Clt_Fuzz_Model_OpenRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 0, 1, 4}
 }
-func (m *Srv) XXX_Unmarshal(b []byte) error {
+func (m *Clt_Fuzz_Model_OpenRPC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_Fuzz_Model_OpenRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_Fuzz_Model_OpenRPC.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv.Merge(dst, src)
+func (m *Clt_Fuzz_Model_OpenRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenRPC.Merge(m, src)
 }
-func (m *Srv) XXX_Size() int {
+func (m *Clt_Fuzz_Model_OpenRPC) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Srv proto.InternalMessageInfo
-
-type isSrv_Msg interface {
-	isSrv_Msg()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Srv_FuzzRep_ struct {
-	FuzzRep *Srv_FuzzRep `protobuf:"bytes,2,opt,name=fuzz_rep,json=fuzzRep,oneof"`
-}
-type Srv_Call_ struct {
-	Call *Srv_Call `protobuf:"bytes,3,opt,name=call,oneof"`
-}
-type Srv_Reset_ struct {
-	Reset_ *Srv_Reset `protobuf:"bytes,4,opt,name=reset,oneof"`
-}
-type Srv_FuzzingResult_ struct {
-	FuzzingResult *Srv_FuzzingResult `protobuf:"bytes,5,opt,name=fuzzing_result,json=fuzzingResult,oneof"`
+func (m *Clt_Fuzz_Model_OpenRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_Fuzz_Model_OpenRPC.DiscardUnknown(m)
 }
 
-func (*Srv_FuzzRep_) isSrv_Msg()       {}
-func (*Srv_Call_) isSrv_Msg()          {}
-func (*Srv_Reset_) isSrv_Msg()         {}
-func (*Srv_FuzzingResult_) isSrv_Msg() {}
+var xxx_messageInfo_Clt_Fuzz_Model_OpenRPC proto.InternalMessageInfo
 
-func (m *Srv) GetMsg() isSrv_Msg {
+func (m *Clt_Fuzz_Model_OpenRPC) GetFile() string {
 	if m != nil {
-		return m.Msg
+		return m.File
 	}
-	return nil
+	return ""
 }
 
-func (m *Srv) GetFuzzingProgress() *Srv_FuzzingProgress {
+func (m *Clt_Fuzz_Model_OpenRPC) GetHost() string {
 	if m != nil {
-		return m.FuzzingProgress
+		return m.Host
 	}
-	return nil
+	return ""
 }
 
-func (m *Srv) GetFuzzRep() *Srv_FuzzRep {
-	if x, ok := m.GetMsg().(*Srv_FuzzRep_); ok {
-		return x.FuzzRep
+func (m *Clt_Fuzz_Model_OpenRPC) GetHttp() *Clt_Fuzz_Model_OpenAPIv3 {
+	if m != nil {
+		return m.Http
 	}
 	return nil
 }
 
-func (m *Srv) GetCall() *Srv_Call {
-	if x, ok := m.GetMsg().(*Srv_Call_); ok {
-		return x.Call
-	}
-	return nil
+type Clt_ResetProgress struct {
+	Status               Clt_ResetProgress_Status `protobuf:"varint,1,opt,name=status,proto3,enum=fm.Clt_ResetProgress_Status" json:"status,omitempty"`
+	ElapsedNs            int64                    `protobuf:"varint,2,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	Reason               []string                 `protobuf:"bytes,3,rep,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *Srv) GetReset_() *Srv_Reset {
-	if x, ok := m.GetMsg().(*Srv_Reset_); ok {
-		return x.Reset_
-	}
-	return nil
+func (m *Clt_ResetProgress) Reset()         { *m = Clt_ResetProgress{} }
+func (m *Clt_ResetProgress) String() string { return proto.CompactTextString(m) }
+func (*Clt_ResetProgress) ProtoMessage()    {}
+func (*Clt_ResetProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 1}
 }
-
-func (m *Srv) GetFuzzingResult() *Srv_FuzzingResult {
-	if x, ok := m.GetMsg().(*Srv_FuzzingResult_); ok {
-		return x.FuzzingResult
+func (m *Clt_ResetProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clt_ResetProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_ResetProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *Clt_ResetProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_ResetProgress.Merge(m, src)
+}
+func (m *Clt_ResetProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_ResetProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_ResetProgress.DiscardUnknown(m)
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Srv) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Srv_OneofMarshaler, _Srv_OneofUnmarshaler, _Srv_OneofSizer, []interface{}{
-		(*Srv_FuzzRep_)(nil),
-		(*Srv_Call_)(nil),
-		(*Srv_Reset_)(nil),
-		(*Srv_FuzzingResult_)(nil),
+var xxx_messageInfo_Clt_ResetProgress proto.InternalMessageInfo
+
+func (m *Clt_ResetProgress) GetStatus() Clt_ResetProgress_Status {
+	if m != nil {
+		return m.Status
 	}
+	return Clt_ResetProgress_NOOP
 }
 
-func _Srv_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Srv)
-	// msg
-	switch x := m.Msg.(type) {
-	case *Srv_FuzzRep_:
-		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.FuzzRep); err != nil {
-			return err
-		}
-	case *Srv_Call_:
-		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Call); err != nil {
-			return err
-		}
-	case *Srv_Reset_:
-		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Reset_); err != nil {
-			return err
-		}
-	case *Srv_FuzzingResult_:
-		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.FuzzingResult); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Srv.Msg has unexpected type %T", x)
+func (m *Clt_ResetProgress) GetElapsedNs() int64 {
+	if m != nil {
+		return m.ElapsedNs
 	}
-	return nil
+	return 0
 }
 
-func _Srv_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Srv)
-	switch tag {
-	case 2: // msg.fuzz_rep
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Srv_FuzzRep)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Srv_FuzzRep_{msg}
-		return true, err
-	case 3: // msg.call
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Srv_Call)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Srv_Call_{msg}
-		return true, err
-	case 4: // msg.reset
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Srv_Reset)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Srv_Reset_{msg}
-		return true, err
-	case 5: // msg.fuzzing_result
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Srv_FuzzingResult)
-		err := b.DecodeMessage(msg)
-		m.Msg = &Srv_FuzzingResult_{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Srv_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Srv)
-	// msg
-	switch x := m.Msg.(type) {
-	case *Srv_FuzzRep_:
-		s := proto.Size(x.FuzzRep)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Srv_Call_:
-		s := proto.Size(x.Call)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Srv_Reset_:
-		s := proto.Size(x.Reset_)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Srv_FuzzingResult_:
-		s := proto.Size(x.FuzzingResult)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Clt_ResetProgress) GetReason() []string {
+	if m != nil {
+		return m.Reason
 	}
-	return n
+	return nil
 }
 
-type Srv_FuzzingProgress struct {
-	Failure              bool     `protobuf:"varint,1,opt,name=failure,proto3" json:"failure,omitempty"`
-	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	LastCallSuccess      bool     `protobuf:"varint,3,opt,name=last_call_success,json=lastCallSuccess,proto3" json:"last_call_success,omitempty"`
-	LastCheckFailure     bool     `protobuf:"varint,4,opt,name=last_check_failure,json=lastCheckFailure,proto3" json:"last_check_failure,omitempty"`
-	LastCheckSuccess     bool     `protobuf:"varint,5,opt,name=last_check_success,json=lastCheckSuccess,proto3" json:"last_check_success,omitempty"`
-	TotalTestsCount      uint32   `protobuf:"varint,6,opt,name=total_tests_count,json=totalTestsCount,proto3" json:"total_tests_count,omitempty"`
-	TotalCallsCount      uint32   `protobuf:"varint,7,opt,name=total_calls_count,json=totalCallsCount,proto3" json:"total_calls_count,omitempty"`
-	TotalChecksCount     uint32   `protobuf:"varint,8,opt,name=total_checks_count,json=totalChecksCount,proto3" json:"total_checks_count,omitempty"`
-	TestCallsCount       uint32   `protobuf:"varint,9,opt,name=test_calls_count,json=testCallsCount,proto3" json:"test_calls_count,omitempty"`
-	CallChecksCount      uint32   `protobuf:"varint,10,opt,name=call_checks_count,json=callChecksCount,proto3" json:"call_checks_count,omitempty"`
-	CallChecksSkipped    uint32   `protobuf:"varint,11,opt,name=call_checks_skipped,json=callChecksSkipped,proto3" json:"call_checks_skipped,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Clt_CallRequestRaw struct {
+	Input                *Clt_CallRequestRaw_Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Reason               []string                  `protobuf:"bytes,2,rep,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *Srv_FuzzingProgress) Reset()         { *m = Srv_FuzzingProgress{} }
-func (m *Srv_FuzzingProgress) String() string { return proto.CompactTextString(m) }
-func (*Srv_FuzzingProgress) ProtoMessage()    {}
-func (*Srv_FuzzingProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 0}
+func (m *Clt_CallRequestRaw) Reset()         { *m = Clt_CallRequestRaw{} }
+func (m *Clt_CallRequestRaw) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallRequestRaw) ProtoMessage()    {}
+func (*Clt_CallRequestRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2}
 }
-func (m *Srv_FuzzingProgress) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallRequestRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_FuzzingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_FuzzingProgress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_FuzzingProgress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_FuzzingProgress.Merge(dst, src)
+func (m *Clt_CallRequestRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw.Merge(m, src)
 }
-func (m *Srv_FuzzingProgress) XXX_Size() int {
+func (m *Clt_CallRequestRaw) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_FuzzingProgress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_FuzzingProgress.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_FuzzingProgress proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw proto.InternalMessageInfo
 
-func (m *Srv_FuzzingProgress) GetFailure() bool {
+func (m *Clt_CallRequestRaw) GetInput() *Clt_CallRequestRaw_Input {
 	if m != nil {
-		return m.Failure
+		return m.Input
 	}
-	return false
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetSuccess() bool {
+func (m *Clt_CallRequestRaw) GetReason() []string {
 	if m != nil {
-		return m.Success
+		return m.Reason
 	}
-	return false
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetLastCallSuccess() bool {
-	if m != nil {
-		return m.LastCallSuccess
-	}
-	return false
+type Clt_CallRequestRaw_Input struct {
+	// Types that are valid to be assigned to Input:
+	//	*Clt_CallRequestRaw_Input_HttpRequest_
+	//	*Clt_CallRequestRaw_Input_GrpcRequest_
+	//	*Clt_CallRequestRaw_Input_WebsocketRequest
+	Input                isClt_CallRequestRaw_Input_Input `protobuf_oneof:"input"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *Srv_FuzzingProgress) GetLastCheckFailure() bool {
-	if m != nil {
-		return m.LastCheckFailure
+func (m *Clt_CallRequestRaw_Input) Reset()         { *m = Clt_CallRequestRaw_Input{} }
+func (m *Clt_CallRequestRaw_Input) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallRequestRaw_Input) ProtoMessage()    {}
+func (*Clt_CallRequestRaw_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0}
+}
+func (m *Clt_CallRequestRaw_Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clt_CallRequestRaw_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_CallRequestRaw_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *Clt_CallRequestRaw_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input.Merge(m, src)
+}
+func (m *Clt_CallRequestRaw_Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_CallRequestRaw_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input.DiscardUnknown(m)
 }
 
-func (m *Srv_FuzzingProgress) GetLastCheckSuccess() bool {
-	if m != nil {
-		return m.LastCheckSuccess
-	}
-	return false
+var xxx_messageInfo_Clt_CallRequestRaw_Input proto.InternalMessageInfo
+
+type isClt_CallRequestRaw_Input_Input interface {
+	isClt_CallRequestRaw_Input_Input()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *Srv_FuzzingProgress) GetTotalTestsCount() uint32 {
-	if m != nil {
-		return m.TotalTestsCount
-	}
-	return 0
+type Clt_CallRequestRaw_Input_HttpRequest_ struct {
+	HttpRequest *Clt_CallRequestRaw_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,proto3,oneof" json:"http_request,omitempty"`
+}
+type Clt_CallRequestRaw_Input_GrpcRequest_ struct {
+	GrpcRequest *Clt_CallRequestRaw_Input_GrpcRequest `protobuf:"bytes,2,opt,name=grpc_request,json=grpcRequest,proto3,oneof" json:"grpc_request,omitempty"`
+}
+type Clt_CallRequestRaw_Input_WebsocketRequest struct {
+	WebsocketRequest *Clt_CallRequestRaw_Input_WebSocketRequest `protobuf:"bytes,3,opt,name=websocket_request,json=websocketRequest,proto3,oneof" json:"websocket_request,omitempty"`
 }
 
-func (m *Srv_FuzzingProgress) GetTotalCallsCount() uint32 {
+func (*Clt_CallRequestRaw_Input_HttpRequest_) isClt_CallRequestRaw_Input_Input()     {}
+func (*Clt_CallRequestRaw_Input_GrpcRequest_) isClt_CallRequestRaw_Input_Input()     {}
+func (*Clt_CallRequestRaw_Input_WebsocketRequest) isClt_CallRequestRaw_Input_Input() {}
+
+func (m *Clt_CallRequestRaw_Input) GetInput() isClt_CallRequestRaw_Input_Input {
 	if m != nil {
-		return m.TotalCallsCount
+		return m.Input
 	}
-	return 0
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetTotalChecksCount() uint32 {
-	if m != nil {
-		return m.TotalChecksCount
+func (m *Clt_CallRequestRaw_Input) GetHttpRequest() *Clt_CallRequestRaw_Input_HttpRequest {
+	if x, ok := m.GetInput().(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
+		return x.HttpRequest
 	}
-	return 0
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetTestCallsCount() uint32 {
-	if m != nil {
-		return m.TestCallsCount
+func (m *Clt_CallRequestRaw_Input) GetGrpcRequest() *Clt_CallRequestRaw_Input_GrpcRequest {
+	if x, ok := m.GetInput().(*Clt_CallRequestRaw_Input_GrpcRequest_); ok {
+		return x.GrpcRequest
 	}
-	return 0
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetCallChecksCount() uint32 {
-	if m != nil {
-		return m.CallChecksCount
+func (m *Clt_CallRequestRaw_Input) GetWebsocketRequest() *Clt_CallRequestRaw_Input_WebSocketRequest {
+	if x, ok := m.GetInput().(*Clt_CallRequestRaw_Input_WebsocketRequest); ok {
+		return x.WebsocketRequest
 	}
-	return 0
+	return nil
 }
 
-func (m *Srv_FuzzingProgress) GetCallChecksSkipped() uint32 {
-	if m != nil {
-		return m.CallChecksSkipped
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Clt_CallRequestRaw_Input) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Clt_CallRequestRaw_Input_HttpRequest_)(nil),
+		(*Clt_CallRequestRaw_Input_GrpcRequest_)(nil),
+		(*Clt_CallRequestRaw_Input_WebsocketRequest)(nil),
 	}
-	return 0
 }
 
-type Srv_FuzzRep struct {
-	MaxTestsCount             uint32   `protobuf:"varint,1,opt,name=max_tests_count,json=maxTestsCount,proto3" json:"max_tests_count,omitempty"`
-	Seed                      []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
-	Token                     string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	MaxExecutionStepsPerCheck uint64   `protobuf:"varint,4,opt,name=max_execution_steps_per_check,json=maxExecutionStepsPerCheck,proto3" json:"max_execution_steps_per_check,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
-	XXX_unrecognized          []byte   `json:"-"`
-	XXX_sizecache             int32    `json:"-"`
+type Clt_CallRequestRaw_Input_HttpRequest struct {
+	Method      string                                                        `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url         string                                                        `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers     map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body        []byte                                                        `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded *types.Value                                                  `protobuf:"bytes,5,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	// UnixSocket is the path to the Unix domain socket the request was sent over
+	UnixSocket string `protobuf:"bytes,6,opt,name=unix_socket,json=unixSocket,proto3" json:"unix_socket,omitempty"`
+	// Graphql is set when the request carries a GraphQL operation
+	Graphql              *Clt_CallRequestRaw_Input_HttpRequest_GraphQL `protobuf:"bytes,7,opt,name=graphql,proto3" json:"graphql,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *Srv_FuzzRep) Reset()         { *m = Srv_FuzzRep{} }
-func (m *Srv_FuzzRep) String() string { return proto.CompactTextString(m) }
-func (*Srv_FuzzRep) ProtoMessage()    {}
-func (*Srv_FuzzRep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 1}
+func (m *Clt_CallRequestRaw_Input_HttpRequest) Reset()         { *m = Clt_CallRequestRaw_Input_HttpRequest{} }
+func (m *Clt_CallRequestRaw_Input_HttpRequest) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallRequestRaw_Input_HttpRequest) ProtoMessage()    {}
+func (*Clt_CallRequestRaw_Input_HttpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 0}
 }
-func (m *Srv_FuzzRep) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_FuzzRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_FuzzRep.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_FuzzRep) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_FuzzRep.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.Merge(m, src)
 }
-func (m *Srv_FuzzRep) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_FuzzRep) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_FuzzRep.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw_Input_HttpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_FuzzRep proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest proto.InternalMessageInfo
 
-func (m *Srv_FuzzRep) GetMaxTestsCount() uint32 {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetMethod() string {
 	if m != nil {
-		return m.MaxTestsCount
+		return m.Method
 	}
-	return 0
+	return ""
 }
 
-func (m *Srv_FuzzRep) GetSeed() []byte {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetUrl() string {
 	if m != nil {
-		return m.Seed
+		return m.Url
 	}
-	return nil
+	return ""
 }
 
-func (m *Srv_FuzzRep) GetToken() string {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetHeaders() map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues {
 	if m != nil {
-		return m.Token
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetBodyDecoded() *types.Value {
+	if m != nil {
+		return m.BodyDecoded
+	}
+	return nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetUnixSocket() string {
+	if m != nil {
+		return m.UnixSocket
 	}
 	return ""
 }
 
-func (m *Srv_FuzzRep) GetMaxExecutionStepsPerCheck() uint64 {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) GetGraphql() *Clt_CallRequestRaw_Input_HttpRequest_GraphQL {
 	if m != nil {
-		return m.MaxExecutionStepsPerCheck
+		return m.Graphql
 	}
-	return 0
+	return nil
 }
 
-type Srv_Call struct {
-	Input                *Srv_Call_Input `protobuf:"bytes,1,opt,name=input" json:"input,omitempty"`
-	EID                  uint32          `protobuf:"varint,2,opt,name=EID,proto3" json:"EID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type Clt_CallRequestRaw_Input_HttpRequest_HeaderValues struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Srv_Call) Reset()         { *m = Srv_Call{} }
-func (m *Srv_Call) String() string { return proto.CompactTextString(m) }
-func (*Srv_Call) ProtoMessage()    {}
-func (*Srv_Call) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 2}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Reset() {
+	*m = Clt_CallRequestRaw_Input_HttpRequest_HeaderValues{}
 }
-func (m *Srv_Call) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) ProtoMessage() {}
+func (*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 0, 0}
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_Call) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_Call.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_Call) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_Call.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.Merge(m, src)
 }
-func (m *Srv_Call) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_Call) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_Call.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_Call proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_HeaderValues proto.InternalMessageInfo
 
-func (m *Srv_Call) GetInput() *Srv_Call_Input {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) GetValues() []string {
 	if m != nil {
-		return m.Input
+		return m.Values
 	}
 	return nil
 }
 
-func (m *Srv_Call) GetEID() uint32 {
-	if m != nil {
-		return m.EID
-	}
-	return 0
+type Clt_CallRequestRaw_Input_HttpRequest_GraphQL struct {
+	Query                string       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Variables            *types.Value `protobuf:"bytes,2,opt,name=variables,proto3" json:"variables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-type Srv_Call_Input struct {
-	// Types that are valid to be assigned to Input:
-	//	*Srv_Call_Input_HttpRequest_
-	Input                isSrv_Call_Input_Input `protobuf_oneof:"input"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Reset() {
+	*m = Clt_CallRequestRaw_Input_HttpRequest_GraphQL{}
 }
-
-func (m *Srv_Call_Input) Reset()         { *m = Srv_Call_Input{} }
-func (m *Srv_Call_Input) String() string { return proto.CompactTextString(m) }
-func (*Srv_Call_Input) ProtoMessage()    {}
-func (*Srv_Call_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 2, 0}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *Srv_Call_Input) XXX_Unmarshal(b []byte) error {
+func (*Clt_CallRequestRaw_Input_HttpRequest_GraphQL) ProtoMessage() {}
+func (*Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 0, 2}
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_Call_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_Call_Input.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_GraphQL.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_Call_Input) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_Call_Input.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_GraphQL.Merge(m, src)
 }
-func (m *Srv_Call_Input) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_Call_Input) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_Call_Input.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Srv_Call_Input proto.InternalMessageInfo
-
-type isSrv_Call_Input_Input interface {
-	isSrv_Call_Input_Input()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Srv_Call_Input_HttpRequest_ struct {
-	HttpRequest *Srv_Call_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,oneof"`
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_GraphQL.DiscardUnknown(m)
 }
 
-func (*Srv_Call_Input_HttpRequest_) isSrv_Call_Input_Input() {}
+var xxx_messageInfo_Clt_CallRequestRaw_Input_HttpRequest_GraphQL proto.InternalMessageInfo
 
-func (m *Srv_Call_Input) GetInput() isSrv_Call_Input_Input {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) GetQuery() string {
 	if m != nil {
-		return m.Input
-	}
-	return nil
-}
-
-func (m *Srv_Call_Input) GetHttpRequest() *Srv_Call_Input_HttpRequest {
-	if x, ok := m.GetInput().(*Srv_Call_Input_HttpRequest_); ok {
-		return x.HttpRequest
-	}
-	return nil
-}
-
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Srv_Call_Input) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Srv_Call_Input_OneofMarshaler, _Srv_Call_Input_OneofUnmarshaler, _Srv_Call_Input_OneofSizer, []interface{}{
-		(*Srv_Call_Input_HttpRequest_)(nil),
+		return m.Query
 	}
+	return ""
 }
 
-func _Srv_Call_Input_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Srv_Call_Input)
-	// input
-	switch x := m.Input.(type) {
-	case *Srv_Call_Input_HttpRequest_:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.HttpRequest); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Srv_Call_Input.Input has unexpected type %T", x)
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) GetVariables() *types.Value {
+	if m != nil {
+		return m.Variables
 	}
 	return nil
 }
 
-func _Srv_Call_Input_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Srv_Call_Input)
-	switch tag {
-	case 1: // input.http_request
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Srv_Call_Input_HttpRequest)
-		err := b.DecodeMessage(msg)
-		m.Input = &Srv_Call_Input_HttpRequest_{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Srv_Call_Input_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Srv_Call_Input)
-	// input
-	switch x := m.Input.(type) {
-	case *Srv_Call_Input_HttpRequest_:
-		s := proto.Size(x.HttpRequest)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type Srv_Call_Input_HttpRequest struct {
-	Method               string                                              `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Url                  string                                              `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Headers              map[string]*Srv_Call_Input_HttpRequest_HeaderValues `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Body                 *types.Value                                        `protobuf:"bytes,4,opt,name=body" json:"body,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
-	XXX_unrecognized     []byte                                              `json:"-"`
-	XXX_sizecache        int32                                               `json:"-"`
+type Clt_CallRequestRaw_Input_GrpcRequest struct {
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Method is the full name of the RPC, e.g. /helloworld.Greeter/SayHello
+	Method   string                                                          `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Metadata map[string]*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Body is the request message encoded as JSON
+	Body                 []byte       `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded          *types.Value `protobuf:"bytes,5,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Srv_Call_Input_HttpRequest) Reset()         { *m = Srv_Call_Input_HttpRequest{} }
-func (m *Srv_Call_Input_HttpRequest) String() string { return proto.CompactTextString(m) }
-func (*Srv_Call_Input_HttpRequest) ProtoMessage()    {}
-func (*Srv_Call_Input_HttpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 2, 0, 0}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) Reset()         { *m = Clt_CallRequestRaw_Input_GrpcRequest{} }
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallRequestRaw_Input_GrpcRequest) ProtoMessage()    {}
+func (*Clt_CallRequestRaw_Input_GrpcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 1}
 }
-func (m *Srv_Call_Input_HttpRequest) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_Call_Input_HttpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_Call_Input_HttpRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_Call_Input_HttpRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_Call_Input_HttpRequest.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest.Merge(m, src)
 }
-func (m *Srv_Call_Input_HttpRequest) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_Call_Input_HttpRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_Call_Input_HttpRequest.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_Call_Input_HttpRequest proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest proto.InternalMessageInfo
 
-func (m *Srv_Call_Input_HttpRequest) GetMethod() string {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) GetTarget() string {
 	if m != nil {
-		return m.Method
+		return m.Target
 	}
 	return ""
 }
 
-func (m *Srv_Call_Input_HttpRequest) GetUrl() string {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) GetMethod() string {
 	if m != nil {
-		return m.Url
+		return m.Method
 	}
 	return ""
 }
 
-func (m *Srv_Call_Input_HttpRequest) GetHeaders() map[string]*Srv_Call_Input_HttpRequest_HeaderValues {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) GetMetadata() map[string]*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues {
 	if m != nil {
-		return m.Headers
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *Srv_Call_Input_HttpRequest) GetBody() *types.Value {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) GetBody() []byte {
 	if m != nil {
 		return m.Body
 	}
 	return nil
 }
 
-type Srv_Call_Input_HttpRequest_HeaderValues struct {
-	Values               []string `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) GetBodyDecoded() *types.Value {
+	if m != nil {
+		return m.BodyDecoded
+	}
+	return nil
+}
+
+type Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) Reset() {
-	*m = Srv_Call_Input_HttpRequest_HeaderValues{}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Reset() {
+	*m = Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues{}
 }
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) String() string { return proto.CompactTextString(m) }
-func (*Srv_Call_Input_HttpRequest_HeaderValues) ProtoMessage()    {}
-func (*Srv_Call_Input_HttpRequest_HeaderValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 2, 0, 0, 0}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Unmarshal(b []byte) error {
+func (*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) ProtoMessage() {}
+func (*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 1, 0}
+}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues.Merge(m, src)
 }
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues proto.InternalMessageInfo
 
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) GetValues() []string {
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) GetValues() []string {
 	if m != nil {
 		return m.Values
 	}
 	return nil
 }
 
-type Srv_Reset struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Clt_CallRequestRaw_Input_WebSocketRequest struct {
+	// Url is where the WebSocket connection was opened
+	Url     string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Body is the published message
+	Body                 []byte       `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded          *types.Value `protobuf:"bytes,4,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Srv_Reset) Reset()         { *m = Srv_Reset{} }
-func (m *Srv_Reset) String() string { return proto.CompactTextString(m) }
-func (*Srv_Reset) ProtoMessage()    {}
-func (*Srv_Reset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 3}
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) Reset() {
+	*m = Clt_CallRequestRaw_Input_WebSocketRequest{}
 }
-func (m *Srv_Reset) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallRequestRaw_Input_WebSocketRequest) ProtoMessage() {}
+func (*Clt_CallRequestRaw_Input_WebSocketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 2, 0, 2}
+}
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_Reset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_Reset.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallRequestRaw_Input_WebSocketRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_Reset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_Reset.Merge(dst, src)
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_WebSocketRequest.Merge(m, src)
 }
-func (m *Srv_Reset) XXX_Size() int {
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_Reset) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_Reset.DiscardUnknown(m)
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallRequestRaw_Input_WebSocketRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_Reset proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallRequestRaw_Input_WebSocketRequest proto.InternalMessageInfo
 
-type Srv_FuzzingResult struct {
-	SeedUsed             []byte                                  `protobuf:"bytes,1,opt,name=seed_used,json=seedUsed,proto3" json:"seed_used,omitempty"`
-	WasShrinking         bool                                    `protobuf:"varint,2,opt,name=was_shrinking,json=wasShrinking,proto3" json:"was_shrinking,omitempty"`
-	NextSeed             []byte                                  `protobuf:"bytes,3,opt,name=next_seed,json=nextSeed,proto3" json:"next_seed,omitempty"`
-	WillNowShrink        bool                                    `protobuf:"varint,4,opt,name=will_now_shrink,json=willNowShrink,proto3" json:"will_now_shrink,omitempty"`
-	SuggestedSeed        []byte                                  `protobuf:"bytes,5,opt,name=suggested_seed,json=suggestedSeed,proto3" json:"suggested_seed,omitempty"`
-	Counterexample       []*Srv_FuzzingResult_CounterexampleItem `protobuf:"bytes,6,rep,name=counterexample" json:"counterexample,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-func (m *Srv_FuzzingResult) Reset()         { *m = Srv_FuzzingResult{} }
-func (m *Srv_FuzzingResult) String() string { return proto.CompactTextString(m) }
-func (*Srv_FuzzingResult) ProtoMessage()    {}
-func (*Srv_FuzzingResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 4}
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
-func (m *Srv_FuzzingResult) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) GetBodyDecoded() *types.Value {
+	if m != nil {
+		return m.BodyDecoded
+	}
+	return nil
+}
+
+type Clt_CallResponseRaw struct {
+	Output   *Clt_CallResponseRaw_Output `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	OutputId uint32                      `protobuf:"varint,2,opt,name=outputId,proto3" json:"outputId,omitempty"`
+	// Logs holds what the System Under Test output during the call
+	Logs                 []*Clt_CallResponseRaw_LogLine `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *Clt_CallResponseRaw) Reset()         { *m = Clt_CallResponseRaw{} }
+func (m *Clt_CallResponseRaw) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallResponseRaw) ProtoMessage()    {}
+func (*Clt_CallResponseRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3}
+}
+func (m *Clt_CallResponseRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_FuzzingResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_FuzzingResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_FuzzingResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_FuzzingResult.Merge(dst, src)
+func (m *Clt_CallResponseRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw.Merge(m, src)
 }
-func (m *Srv_FuzzingResult) XXX_Size() int {
+func (m *Clt_CallResponseRaw) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_FuzzingResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_FuzzingResult.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_FuzzingResult proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw proto.InternalMessageInfo
 
-func (m *Srv_FuzzingResult) GetSeedUsed() []byte {
+func (m *Clt_CallResponseRaw) GetOutput() *Clt_CallResponseRaw_Output {
 	if m != nil {
-		return m.SeedUsed
+		return m.Output
 	}
 	return nil
 }
 
-func (m *Srv_FuzzingResult) GetWasShrinking() bool {
+func (m *Clt_CallResponseRaw) GetOutputId() uint32 {
 	if m != nil {
-		return m.WasShrinking
+		return m.OutputId
 	}
-	return false
+	return 0
 }
 
-func (m *Srv_FuzzingResult) GetNextSeed() []byte {
+func (m *Clt_CallResponseRaw) GetLogs() []*Clt_CallResponseRaw_LogLine {
 	if m != nil {
-		return m.NextSeed
+		return m.Logs
 	}
 	return nil
 }
 
-func (m *Srv_FuzzingResult) GetWillNowShrink() bool {
-	if m != nil {
-		return m.WillNowShrink
+type Clt_CallResponseRaw_Output struct {
+	// Types that are valid to be assigned to Output:
+	//	*Clt_CallResponseRaw_Output_HttpResponse_
+	//	*Clt_CallResponseRaw_Output_GrpcResponse_
+	//	*Clt_CallResponseRaw_Output_WebsocketResponse
+	Output               isClt_CallResponseRaw_Output_Output `protobuf_oneof:"output"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *Clt_CallResponseRaw_Output) Reset()         { *m = Clt_CallResponseRaw_Output{} }
+func (m *Clt_CallResponseRaw_Output) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallResponseRaw_Output) ProtoMessage()    {}
+func (*Clt_CallResponseRaw_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0}
+}
+func (m *Clt_CallResponseRaw_Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clt_CallResponseRaw_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_CallResponseRaw_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *Clt_CallResponseRaw_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output.Merge(m, src)
+}
+func (m *Clt_CallResponseRaw_Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_CallResponseRaw_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output.DiscardUnknown(m)
 }
 
-func (m *Srv_FuzzingResult) GetSuggestedSeed() []byte {
+var xxx_messageInfo_Clt_CallResponseRaw_Output proto.InternalMessageInfo
+
+type isClt_CallResponseRaw_Output_Output interface {
+	isClt_CallResponseRaw_Output_Output()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Clt_CallResponseRaw_Output_HttpResponse_ struct {
+	HttpResponse *Clt_CallResponseRaw_Output_HttpResponse `protobuf:"bytes,1,opt,name=http_response,json=httpResponse,proto3,oneof" json:"http_response,omitempty"`
+}
+type Clt_CallResponseRaw_Output_GrpcResponse_ struct {
+	GrpcResponse *Clt_CallResponseRaw_Output_GrpcResponse `protobuf:"bytes,2,opt,name=grpc_response,json=grpcResponse,proto3,oneof" json:"grpc_response,omitempty"`
+}
+type Clt_CallResponseRaw_Output_WebsocketResponse struct {
+	WebsocketResponse *Clt_CallResponseRaw_Output_WebSocketResponse `protobuf:"bytes,3,opt,name=websocket_response,json=websocketResponse,proto3,oneof" json:"websocket_response,omitempty"`
+}
+
+func (*Clt_CallResponseRaw_Output_HttpResponse_) isClt_CallResponseRaw_Output_Output()     {}
+func (*Clt_CallResponseRaw_Output_GrpcResponse_) isClt_CallResponseRaw_Output_Output()     {}
+func (*Clt_CallResponseRaw_Output_WebsocketResponse) isClt_CallResponseRaw_Output_Output() {}
+
+func (m *Clt_CallResponseRaw_Output) GetOutput() isClt_CallResponseRaw_Output_Output {
 	if m != nil {
-		return m.SuggestedSeed
+		return m.Output
 	}
 	return nil
 }
 
-func (m *Srv_FuzzingResult) GetCounterexample() []*Srv_FuzzingResult_CounterexampleItem {
-	if m != nil {
-		return m.Counterexample
+func (m *Clt_CallResponseRaw_Output) GetHttpResponse() *Clt_CallResponseRaw_Output_HttpResponse {
+	if x, ok := m.GetOutput().(*Clt_CallResponseRaw_Output_HttpResponse_); ok {
+		return x.HttpResponse
 	}
 	return nil
 }
 
-type Srv_FuzzingResult_CounterexampleItem struct {
-	CallRequest          *Clt_CallRequestRaw_Input   `protobuf:"bytes,1,opt,name=call_request,json=callRequest" json:"call_request,omitempty"`
-	CallResponse         *Clt_CallResponseRaw_Output `protobuf:"bytes,2,opt,name=call_response,json=callResponse" json:"call_response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *Clt_CallResponseRaw_Output) GetGrpcResponse() *Clt_CallResponseRaw_Output_GrpcResponse {
+	if x, ok := m.GetOutput().(*Clt_CallResponseRaw_Output_GrpcResponse_); ok {
+		return x.GrpcResponse
+	}
+	return nil
 }
 
-func (m *Srv_FuzzingResult_CounterexampleItem) Reset()         { *m = Srv_FuzzingResult_CounterexampleItem{} }
-func (m *Srv_FuzzingResult_CounterexampleItem) String() string { return proto.CompactTextString(m) }
-func (*Srv_FuzzingResult_CounterexampleItem) ProtoMessage()    {}
-func (*Srv_FuzzingResult_CounterexampleItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{1, 4, 0}
+func (m *Clt_CallResponseRaw_Output) GetWebsocketResponse() *Clt_CallResponseRaw_Output_WebSocketResponse {
+	if x, ok := m.GetOutput().(*Clt_CallResponseRaw_Output_WebsocketResponse); ok {
+		return x.WebsocketResponse
+	}
+	return nil
 }
-func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Unmarshal(b []byte) error {
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Clt_CallResponseRaw_Output) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Clt_CallResponseRaw_Output_HttpResponse_)(nil),
+		(*Clt_CallResponseRaw_Output_GrpcResponse_)(nil),
+		(*Clt_CallResponseRaw_Output_WebsocketResponse)(nil),
+	}
+}
+
+type Clt_CallResponseRaw_Output_HttpResponse struct {
+	Error       string                                                           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	StatusCode  uint32                                                           `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Reason      string                                                           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Headers     map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body        []byte                                                           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded *types.Value                                                     `protobuf:"bytes,6,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	ElapsedNs   int64                                                            `protobuf:"varint,7,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	Timings     *Clt_CallResponseRaw_Output_HttpResponse_Timings                 `protobuf:"bytes,8,opt,name=timings,proto3" json:"timings,omitempty"`
+	// History holds the redirects that were followed, oldest first
+	History    []*Clt_CallResponseRaw_Output_HttpResponse_Redirect `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+	Connection *Clt_CallResponseRaw_Output_HttpResponse_Connection `protobuf:"bytes,10,opt,name=connection,proto3" json:"connection,omitempty"`
+	// Events holds a streamed (SSE or NDJSON) response's events, in order
+	Events []*Clt_CallResponseRaw_Output_HttpResponse_Event `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	// StreamCut is set when the stream was still open after stream_duration
+	StreamCut            bool     `protobuf:"varint,12,opt,name=stream_cut,json=streamCut,proto3" json:"stream_cut,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse{}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallResponseRaw_Output_HttpResponse) ProtoMessage()    {}
+func (*Clt_CallResponseRaw_Output_HttpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Srv_FuzzingResult_CounterexampleItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.Merge(m, src)
 }
-func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Srv_FuzzingResult_CounterexampleItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse proto.InternalMessageInfo
 
-func (m *Srv_FuzzingResult_CounterexampleItem) GetCallRequest() *Clt_CallRequestRaw_Input {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetError() string {
 	if m != nil {
-		return m.CallRequest
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *Srv_FuzzingResult_CounterexampleItem) GetCallResponse() *Clt_CallResponseRaw_Output {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetStatusCode() uint32 {
 	if m != nil {
-		return m.CallResponse
+		return m.StatusCode
 	}
-	return nil
+	return 0
 }
 
-type SpecIR struct {
-	Schemas *Schemas `protobuf:"bytes,1,opt,name=schemas" json:"schemas,omitempty"`
-	// All endpoints are here.
-	// Start at 1 then increases monotonously. 0 (zero) is reserved for bug
-	// finding.
-	Endpoints            map[uint32]*Endpoint `protobuf:"bytes,2,rep,name=endpoints" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *SpecIR) Reset()         { *m = SpecIR{} }
-func (m *SpecIR) String() string { return proto.CompactTextString(m) }
-func (*SpecIR) ProtoMessage()    {}
-func (*SpecIR) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{2}
-}
-func (m *SpecIR) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetHeaders() map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
-func (m *SpecIR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SpecIR.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetBody() []byte {
+	if m != nil {
+		return m.Body
 	}
+	return nil
 }
-func (dst *SpecIR) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecIR.Merge(dst, src)
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetBodyDecoded() *types.Value {
+	if m != nil {
+		return m.BodyDecoded
+	}
+	return nil
 }
-func (m *SpecIR) XXX_Size() int {
-	return m.Size()
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetElapsedNs() int64 {
+	if m != nil {
+		return m.ElapsedNs
+	}
+	return 0
 }
-func (m *SpecIR) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecIR.DiscardUnknown(m)
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetTimings() *Clt_CallResponseRaw_Output_HttpResponse_Timings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
 }
 
-var xxx_messageInfo_SpecIR proto.InternalMessageInfo
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetHistory() []*Clt_CallResponseRaw_Output_HttpResponse_Redirect {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
 
-func (m *SpecIR) GetSchemas() *Schemas {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetConnection() *Clt_CallResponseRaw_Output_HttpResponse_Connection {
 	if m != nil {
-		return m.Schemas
+		return m.Connection
 	}
 	return nil
 }
 
-func (m *SpecIR) GetEndpoints() map[uint32]*Endpoint {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetEvents() []*Clt_CallResponseRaw_Output_HttpResponse_Event {
 	if m != nil {
-		return m.Endpoints
+		return m.Events
 	}
 	return nil
 }
 
-type Schemas struct {
-	// All schemas are here.
-	// Start at 1. 0 (zero) is reserved for bug finding.
-	Json                 map[uint32]*RefOrSchemaJSON `protobuf:"bytes,1,rep,name=json" json:"json,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse) GetStreamCut() bool {
+	if m != nil {
+		return m.StreamCut
+	}
+	return false
 }
 
-func (m *Schemas) Reset()         { *m = Schemas{} }
-func (m *Schemas) String() string { return proto.CompactTextString(m) }
-func (*Schemas) ProtoMessage()    {}
-func (*Schemas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{3}
+type Clt_CallResponseRaw_Output_HttpResponse_HeaderValues struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Schemas) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 0}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Schemas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Schemas.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Schemas) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Schemas.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.Merge(m, src)
 }
-func (m *Schemas) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_Size() int {
 	return m.Size()
 }
-func (m *Schemas) XXX_DiscardUnknown() {
-	xxx_messageInfo_Schemas.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Schemas proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_HeaderValues proto.InternalMessageInfo
 
-func (m *Schemas) GetJson() map[uint32]*RefOrSchemaJSON {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) GetValues() []string {
 	if m != nil {
-		return m.Json
+		return m.Values
 	}
 	return nil
 }
 
-type RefOrSchemaJSON struct {
-	// Types that are valid to be assigned to PtrOrSchema:
-	//	*RefOrSchemaJSON_Ptr
-	//	*RefOrSchemaJSON_Schema
-	PtrOrSchema          isRefOrSchemaJSON_PtrOrSchema `protobuf_oneof:"ptr_or_schema"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+// Timings similar to
+// https://developers.google.com/web/tools/chrome-devtools/network/reference#timing-explanation
+// Durations are zero when the step did not happen (e.g. reused connection)
+type Clt_CallResponseRaw_Output_HttpResponse_Timings struct {
+	DnsNs                int64    `protobuf:"varint,1,opt,name=dns_ns,json=dnsNs,proto3" json:"dns_ns,omitempty"`
+	ConnectNs            int64    `protobuf:"varint,2,opt,name=connect_ns,json=connectNs,proto3" json:"connect_ns,omitempty"`
+	TlsHandshakeNs       int64    `protobuf:"varint,3,opt,name=tls_handshake_ns,json=tlsHandshakeNs,proto3" json:"tls_handshake_ns,omitempty"`
+	TtfbNs               int64    `protobuf:"varint,4,opt,name=ttfb_ns,json=ttfbNs,proto3" json:"ttfb_ns,omitempty"`
+	TransferNs           int64    `protobuf:"varint,5,opt,name=transfer_ns,json=transferNs,proto3" json:"transfer_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RefOrSchemaJSON) Reset()         { *m = RefOrSchemaJSON{} }
-func (m *RefOrSchemaJSON) String() string { return proto.CompactTextString(m) }
-func (*RefOrSchemaJSON) ProtoMessage()    {}
-func (*RefOrSchemaJSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{4}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_Timings{}
 }
-func (m *RefOrSchemaJSON) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Timings) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Timings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 2}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefOrSchemaJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefOrSchemaJSON.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Timings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *RefOrSchemaJSON) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefOrSchemaJSON.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Timings.Merge(m, src)
 }
-func (m *RefOrSchemaJSON) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefOrSchemaJSON) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefOrSchemaJSON.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Timings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefOrSchemaJSON proto.InternalMessageInfo
-
-type isRefOrSchemaJSON_PtrOrSchema interface {
-	isRefOrSchemaJSON_PtrOrSchema()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type RefOrSchemaJSON_Ptr struct {
-	Ptr *SchemaPtr `protobuf:"bytes,1,opt,name=ptr,oneof"`
-}
-type RefOrSchemaJSON_Schema struct {
-	Schema *Schema_JSON `protobuf:"bytes,2,opt,name=schema,oneof"`
-}
-
-func (*RefOrSchemaJSON_Ptr) isRefOrSchemaJSON_PtrOrSchema()    {}
-func (*RefOrSchemaJSON_Schema) isRefOrSchemaJSON_PtrOrSchema() {}
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Timings proto.InternalMessageInfo
 
-func (m *RefOrSchemaJSON) GetPtrOrSchema() isRefOrSchemaJSON_PtrOrSchema {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetDnsNs() int64 {
 	if m != nil {
-		return m.PtrOrSchema
+		return m.DnsNs
 	}
-	return nil
+	return 0
 }
 
-func (m *RefOrSchemaJSON) GetPtr() *SchemaPtr {
-	if x, ok := m.GetPtrOrSchema().(*RefOrSchemaJSON_Ptr); ok {
-		return x.Ptr
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetConnectNs() int64 {
+	if m != nil {
+		return m.ConnectNs
 	}
-	return nil
+	return 0
 }
 
-func (m *RefOrSchemaJSON) GetSchema() *Schema_JSON {
-	if x, ok := m.GetPtrOrSchema().(*RefOrSchemaJSON_Schema); ok {
-		return x.Schema
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTlsHandshakeNs() int64 {
+	if m != nil {
+		return m.TlsHandshakeNs
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*RefOrSchemaJSON) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _RefOrSchemaJSON_OneofMarshaler, _RefOrSchemaJSON_OneofUnmarshaler, _RefOrSchemaJSON_OneofSizer, []interface{}{
-		(*RefOrSchemaJSON_Ptr)(nil),
-		(*RefOrSchemaJSON_Schema)(nil),
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTtfbNs() int64 {
+	if m != nil {
+		return m.TtfbNs
 	}
+	return 0
 }
 
-func _RefOrSchemaJSON_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*RefOrSchemaJSON)
-	// ptr_or_schema
-	switch x := m.PtrOrSchema.(type) {
-	case *RefOrSchemaJSON_Ptr:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Ptr); err != nil {
-			return err
-		}
-	case *RefOrSchemaJSON_Schema:
-		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Schema); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("RefOrSchemaJSON.PtrOrSchema has unexpected type %T", x)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTransferNs() int64 {
+	if m != nil {
+		return m.TransferNs
 	}
-	return nil
+	return 0
 }
 
-func _RefOrSchemaJSON_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*RefOrSchemaJSON)
-	switch tag {
-	case 1: // ptr_or_schema.ptr
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(SchemaPtr)
-		err := b.DecodeMessage(msg)
-		m.PtrOrSchema = &RefOrSchemaJSON_Ptr{msg}
-		return true, err
-	case 2: // ptr_or_schema.schema
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Schema_JSON)
-		err := b.DecodeMessage(msg)
-		m.PtrOrSchema = &RefOrSchemaJSON_Schema{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _RefOrSchemaJSON_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*RefOrSchemaJSON)
-	// ptr_or_schema
-	switch x := m.PtrOrSchema.(type) {
-	case *RefOrSchemaJSON_Ptr:
-		s := proto.Size(x.Ptr)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *RefOrSchemaJSON_Schema:
-		s := proto.Size(x.Schema)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+type Clt_CallResponseRaw_Output_HttpResponse_Redirect struct {
+	Url                  string                                                           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	StatusCode           uint32                                                           `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Reason               string                                                           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Location             string                                                           `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Headers              map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                                         `json:"-"`
+	XXX_unrecognized     []byte                                                           `json:"-"`
+	XXX_sizecache        int32                                                            `json:"-"`
 }
 
-type SchemaPtr struct {
-	// Pointer to actual schema. i.e. key in Schemas message.
-	SID uint32 `protobuf:"varint,1,opt,name=SID,proto3" json:"SID,omitempty"`
-	// $ref if was ref'd, in canonical/absolute (#/...-like) format.
-	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_Redirect{}
 }
-
-func (m *SchemaPtr) Reset()         { *m = SchemaPtr{} }
-func (m *SchemaPtr) String() string { return proto.CompactTextString(m) }
-func (*SchemaPtr) ProtoMessage()    {}
-func (*SchemaPtr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{5}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *SchemaPtr) XXX_Unmarshal(b []byte) error {
+func (*Clt_CallResponseRaw_Output_HttpResponse_Redirect) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Redirect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 3}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SchemaPtr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchemaPtr.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Redirect.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *SchemaPtr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchemaPtr.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Redirect.Merge(m, src)
 }
-func (m *SchemaPtr) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) XXX_Size() int {
 	return m.Size()
 }
-func (m *SchemaPtr) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchemaPtr.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Redirect.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchemaPtr proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Redirect proto.InternalMessageInfo
 
-func (m *SchemaPtr) GetSID() uint32 {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetUrl() string {
 	if m != nil {
-		return m.SID
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
 	}
 	return 0
 }
 
-func (m *SchemaPtr) GetRef() string {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetReason() string {
 	if m != nil {
-		return m.Ref
+		return m.Reason
 	}
 	return ""
 }
 
-type Endpoint struct {
-	// Types that are valid to be assigned to Endpoint:
-	//	*Endpoint_Json
-	Endpoint             isEndpoint_Endpoint `protobuf_oneof:"endpoint"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
 }
 
-func (m *Endpoint) Reset()         { *m = Endpoint{} }
-func (m *Endpoint) String() string { return proto.CompactTextString(m) }
-func (*Endpoint) ProtoMessage()    {}
-func (*Endpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{6}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetHeaders() map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
-func (m *Endpoint) XXX_Unmarshal(b []byte) error {
+
+type Clt_CallResponseRaw_Output_HttpResponse_Connection struct {
+	RemoteIp             string                                                  `protobuf:"bytes,1,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	RemotePort           uint32                                                  `protobuf:"varint,2,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	LocalIp              string                                                  `protobuf:"bytes,3,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	LocalPort            uint32                                                  `protobuf:"varint,4,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	Protocol             string                                                  `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Reused               bool                                                    `protobuf:"varint,6,opt,name=reused,proto3" json:"reused,omitempty"`
+	Tls                  *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS `protobuf:"bytes,7,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                                `json:"-"`
+	XXX_unrecognized     []byte                                                  `json:"-"`
+	XXX_sizecache        int32                                                   `json:"-"`
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_Connection{}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Connection) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Connection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 4}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Endpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Endpoint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Endpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Endpoint.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection.Merge(m, src)
 }
-func (m *Endpoint) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) XXX_Size() int {
 	return m.Size()
 }
-func (m *Endpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Endpoint.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Endpoint proto.InternalMessageInfo
-
-type isEndpoint_Endpoint interface {
-	isEndpoint_Endpoint()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection proto.InternalMessageInfo
 
-type Endpoint_Json struct {
-	Json *EndpointJSON `protobuf:"bytes,1,opt,name=json,oneof"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetRemoteIp() string {
+	if m != nil {
+		return m.RemoteIp
+	}
+	return ""
 }
 
-func (*Endpoint_Json) isEndpoint_Endpoint() {}
-
-func (m *Endpoint) GetEndpoint() isEndpoint_Endpoint {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetRemotePort() uint32 {
 	if m != nil {
-		return m.Endpoint
+		return m.RemotePort
 	}
-	return nil
+	return 0
 }
 
-func (m *Endpoint) GetJson() *EndpointJSON {
-	if x, ok := m.GetEndpoint().(*Endpoint_Json); ok {
-		return x.Json
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetLocalIp() string {
+	if m != nil {
+		return m.LocalIp
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Endpoint) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Endpoint_OneofMarshaler, _Endpoint_OneofUnmarshaler, _Endpoint_OneofSizer, []interface{}{
-		(*Endpoint_Json)(nil),
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetLocalPort() uint32 {
+	if m != nil {
+		return m.LocalPort
 	}
+	return 0
 }
 
-func _Endpoint_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Endpoint)
-	// endpoint
-	switch x := m.Endpoint.(type) {
-	case *Endpoint_Json:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Json); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Endpoint.Endpoint has unexpected type %T", x)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
 	}
-	return nil
+	return ""
 }
 
-func _Endpoint_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Endpoint)
-	switch tag {
-	case 1: // endpoint.json
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(EndpointJSON)
-		err := b.DecodeMessage(msg)
-		m.Endpoint = &Endpoint_Json{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetReused() bool {
+	if m != nil {
+		return m.Reused
 	}
+	return false
 }
 
-func _Endpoint_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Endpoint)
-	// endpoint
-	switch x := m.Endpoint.(type) {
-	case *Endpoint_Json:
-		s := proto.Size(x.Json)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) GetTls() *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS {
+	if m != nil {
+		return m.Tls
 	}
-	return n
+	return nil
 }
 
-type EndpointJSON struct {
-	Method       EndpointJSON_Method `protobuf:"varint,1,opt,name=method,proto3,enum=fm.EndpointJSON_Method" json:"method,omitempty"`
-	PathPartials []*PathPartial      `protobuf:"bytes,2,rep,name=path_partials,json=pathPartials" json:"path_partials,omitempty"`
-	Inputs       []*ParamJSON        `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
-	// The uint32 key replaces an enum of 1XX,...,201,204,...,5XX,XXX.
-	// The uint32 values are SID
-	Outputs              map[uint32]uint32 `protobuf:"bytes,4,rep,name=outputs" json:"outputs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CipherSuite          string   `protobuf:"bytes,2,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	PeerSubject          string   `protobuf:"bytes,3,opt,name=peer_subject,json=peerSubject,proto3" json:"peer_subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EndpointJSON) Reset()         { *m = EndpointJSON{} }
-func (m *EndpointJSON) String() string { return proto.CompactTextString(m) }
-func (*EndpointJSON) ProtoMessage()    {}
-func (*EndpointJSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{7}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS{}
 }
-func (m *EndpointJSON) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 4, 0}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndpointJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndpointJSON.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *EndpointJSON) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndpointJSON.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS.Merge(m, src)
 }
-func (m *EndpointJSON) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndpointJSON) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndpointJSON.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndpointJSON proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS proto.InternalMessageInfo
 
-func (m *EndpointJSON) GetMethod() EndpointJSON_Method {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) GetVersion() string {
 	if m != nil {
-		return m.Method
+		return m.Version
 	}
-	return EndpointJSON_UNKNOWN
+	return ""
 }
 
-func (m *EndpointJSON) GetPathPartials() []*PathPartial {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) GetCipherSuite() string {
 	if m != nil {
-		return m.PathPartials
+		return m.CipherSuite
 	}
-	return nil
+	return ""
 }
 
-func (m *EndpointJSON) GetInputs() []*ParamJSON {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) GetPeerSubject() string {
 	if m != nil {
-		return m.Inputs
+		return m.PeerSubject
 	}
-	return nil
+	return ""
 }
 
-func (m *EndpointJSON) GetOutputs() map[uint32]uint32 {
-	if m != nil {
-		return m.Outputs
-	}
-	return nil
+// Event is a server-sent event or a NDJSON record
+type Clt_CallResponseRaw_Output_HttpResponse_Event struct {
+	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event                string       `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Data                 string       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	DataDecoded          *types.Value `protobuf:"bytes,4,opt,name=data_decoded,json=dataDecoded,proto3" json:"data_decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-type ParamJSON struct {
-	IsRequired bool   `protobuf:"varint,1,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
-	SID        uint32 `protobuf:"varint,2,opt,name=SID,proto3" json:"SID,omitempty"`
-	// Note: bodies have an empty name
-	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Kind                 ParamJSON_Kind `protobuf:"varint,4,opt,name=kind,proto3,enum=fm.ParamJSON_Kind" json:"kind,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) Reset() {
+	*m = Clt_CallResponseRaw_Output_HttpResponse_Event{}
 }
-
-func (m *ParamJSON) Reset()         { *m = ParamJSON{} }
-func (m *ParamJSON) String() string { return proto.CompactTextString(m) }
-func (*ParamJSON) ProtoMessage()    {}
-func (*ParamJSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{8}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *ParamJSON) XXX_Unmarshal(b []byte) error {
+func (*Clt_CallResponseRaw_Output_HttpResponse_Event) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 0, 5}
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamJSON.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Event.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *ParamJSON) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamJSON.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Event.Merge(m, src)
 }
-func (m *ParamJSON) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamJSON) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamJSON.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Event.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamJSON proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_HttpResponse_Event proto.InternalMessageInfo
 
-func (m *ParamJSON) GetIsRequired() bool {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) GetId() string {
 	if m != nil {
-		return m.IsRequired
+		return m.Id
 	}
-	return false
+	return ""
 }
 
-func (m *ParamJSON) GetSID() uint32 {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) GetEvent() string {
 	if m != nil {
-		return m.SID
+		return m.Event
 	}
-	return 0
+	return ""
 }
 
-func (m *ParamJSON) GetName() string {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) GetData() string {
 	if m != nil {
-		return m.Name
+		return m.Data
 	}
 	return ""
 }
 
-func (m *ParamJSON) GetKind() ParamJSON_Kind {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) GetDataDecoded() *types.Value {
 	if m != nil {
-		return m.Kind
+		return m.DataDecoded
 	}
-	return ParamJSON_UNKNOWN
-}
-
-type PathPartial struct {
-	// Types that are valid to be assigned to Pp:
-	//	*PathPartial_Part
-	//	*PathPartial_Ptr
-	Pp                   isPathPartial_Pp `protobuf_oneof:"pp"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	return nil
 }
 
-func (m *PathPartial) Reset()         { *m = PathPartial{} }
-func (m *PathPartial) String() string { return proto.CompactTextString(m) }
-func (*PathPartial) ProtoMessage()    {}
-func (*PathPartial) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{9}
-}
-func (m *PathPartial) XXX_Unmarshal(b []byte) error {
+type Clt_CallResponseRaw_Output_GrpcResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Code is the gRPC status code, e.g. 5 for NotFound
+	Code     uint32                                                             `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Status   string                                                             `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message  string                                                             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Headers  map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Trailers map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues `protobuf:"bytes,6,rep,name=trailers,proto3" json:"trailers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Body is the response message encoded as JSON
+	Body                 []byte       `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded          *types.Value `protobuf:"bytes,8,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	ElapsedNs            int64        `protobuf:"varint,9,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) Reset() {
+	*m = Clt_CallResponseRaw_Output_GrpcResponse{}
+}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallResponseRaw_Output_GrpcResponse) ProtoMessage()    {}
+func (*Clt_CallResponseRaw_Output_GrpcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 1}
+}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PathPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PathPartial.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *PathPartial) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PathPartial.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse.Merge(m, src)
 }
-func (m *PathPartial) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PathPartial) XXX_DiscardUnknown() {
-	xxx_messageInfo_PathPartial.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PathPartial proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse proto.InternalMessageInfo
 
-type isPathPartial_Pp interface {
-	isPathPartial_Pp()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type PathPartial_Part struct {
-	Part string `protobuf:"bytes,1,opt,name=part,proto3,oneof"`
-}
-type PathPartial_Ptr struct {
-	Ptr string `protobuf:"bytes,2,opt,name=ptr,proto3,oneof"`
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (*PathPartial_Part) isPathPartial_Pp() {}
-func (*PathPartial_Ptr) isPathPartial_Pp()  {}
-
-func (m *PathPartial) GetPp() isPathPartial_Pp {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetCode() uint32 {
 	if m != nil {
-		return m.Pp
+		return m.Code
 	}
-	return nil
+	return 0
 }
 
-func (m *PathPartial) GetPart() string {
-	if x, ok := m.GetPp().(*PathPartial_Part); ok {
-		return x.Part
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
 	return ""
 }
 
-func (m *PathPartial) GetPtr() string {
-	if x, ok := m.GetPp().(*PathPartial_Ptr); ok {
-		return x.Ptr
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
 	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*PathPartial) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _PathPartial_OneofMarshaler, _PathPartial_OneofUnmarshaler, _PathPartial_OneofSizer, []interface{}{
-		(*PathPartial_Part)(nil),
-		(*PathPartial_Ptr)(nil),
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetHeaders() map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetTrailers() map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues {
+	if m != nil {
+		return m.Trailers
 	}
+	return nil
 }
 
-func _PathPartial_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*PathPartial)
-	// pp
-	switch x := m.Pp.(type) {
-	case *PathPartial_Part:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		_ = b.EncodeStringBytes(x.Part)
-	case *PathPartial_Ptr:
-		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
-		_ = b.EncodeStringBytes(x.Ptr)
-	case nil:
-	default:
-		return fmt.Errorf("PathPartial.Pp has unexpected type %T", x)
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetBody() []byte {
+	if m != nil {
+		return m.Body
 	}
 	return nil
 }
 
-func _PathPartial_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*PathPartial)
-	switch tag {
-	case 1: // pp.part
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Pp = &PathPartial_Part{x}
-		return true, err
-	case 2: // pp.ptr
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Pp = &PathPartial_Ptr{x}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _PathPartial_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*PathPartial)
-	// pp
-	switch x := m.Pp.(type) {
-	case *PathPartial_Part:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.Part)))
-		n += len(x.Part)
-	case *PathPartial_Ptr:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.Ptr)))
-		n += len(x.Ptr)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetBodyDecoded() *types.Value {
+	if m != nil {
+		return m.BodyDecoded
 	}
-	return n
+	return nil
 }
 
-type Schema struct {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) GetElapsedNs() int64 {
+	if m != nil {
+		return m.ElapsedNs
+	}
+	return 0
+}
+
+type Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Schema) Reset()         { *m = Schema{} }
-func (m *Schema) String() string { return proto.CompactTextString(m) }
-func (*Schema) ProtoMessage()    {}
-func (*Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{10}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Reset() {
+	*m = Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues{}
 }
-func (m *Schema) XXX_Unmarshal(b []byte) error {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 1, 0}
+}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Schema) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Schema.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues.Merge(m, src)
 }
-func (m *Schema) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) XXX_Size() int {
 	return m.Size()
 }
-func (m *Schema) XXX_DiscardUnknown() {
-	xxx_messageInfo_Schema.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Schema proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues proto.InternalMessageInfo
 
-type Schema_JSON struct {
-	Types        []Schema_JSON_Type `protobuf:"varint,1,rep,packed,name=types,enum=fm.Schema_JSON_Type" json:"types,omitempty"`
-	Enum         []*types.Value     `protobuf:"bytes,2,rep,name=enum" json:"enum,omitempty"`
-	Format       Schema_JSON_Format `protobuf:"varint,3,opt,name=format,proto3,enum=fm.Schema_JSON_Format" json:"format,omitempty"`
-	MinLength    uint64             `protobuf:"varint,4,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
-	MaxLength    uint64             `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
-	HasMaxLength bool               `protobuf:"varint,6,opt,name=has_max_length,json=hasMaxLength,proto3" json:"has_max_length,omitempty"`
-	Pattern      string             `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	// type: number | integer
-	Minimum              float64 `protobuf:"fixed64,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
-	Maximum              float64 `protobuf:"fixed64,9,opt,name=maximum,proto3" json:"maximum,omitempty"`
-	HasMinimum           bool    `protobuf:"varint,10,opt,name=has_minimum,json=hasMinimum,proto3" json:"has_minimum,omitempty"`
-	HasMaximum           bool    `protobuf:"varint,11,opt,name=has_maximum,json=hasMaximum,proto3" json:"has_maximum,omitempty"`
-	TranslatedMultipleOf float64 `protobuf:"fixed64,12,opt,name=translated_multiple_of,json=translatedMultipleOf,proto3" json:"translated_multiple_of,omitempty"`
-	ExclusiveMinimum     bool    `protobuf:"varint,13,opt,name=exclusive_minimum,json=exclusiveMinimum,proto3" json:"exclusive_minimum,omitempty"`
-	ExclusiveMaximum     bool    `protobuf:"varint,14,opt,name=exclusive_maximum,json=exclusiveMaximum,proto3" json:"exclusive_maximum,omitempty"`
-	// type: array
-	Items       []uint32 `protobuf:"varint,15,rep,packed,name=items" json:"items,omitempty"`
-	UniqueItems bool     `protobuf:"varint,16,opt,name=unique_items,json=uniqueItems,proto3" json:"unique_items,omitempty"`
-	MinItems    uint64   `protobuf:"varint,17,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
-	MaxItems    uint64   `protobuf:"varint,18,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
-	HasMaxItems bool     `protobuf:"varint,19,opt,name=has_max_items,json=hasMaxItems,proto3" json:"has_max_items,omitempty"`
-	// type: object
-	Properties              map[string]uint32                 `protobuf:"bytes,20,rep,name=properties" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Required                []string                          `protobuf:"bytes,21,rep,name=required" json:"required,omitempty"`
-	MinProperties           uint64                            `protobuf:"varint,22,opt,name=min_properties,json=minProperties,proto3" json:"min_properties,omitempty"`
-	MaxProperties           uint64                            `protobuf:"varint,23,opt,name=max_properties,json=maxProperties,proto3" json:"max_properties,omitempty"`
-	HasMaxProperties        bool                              `protobuf:"varint,24,opt,name=has_max_properties,json=hasMaxProperties,proto3" json:"has_max_properties,omitempty"`
-	AdditionalProperties    *Schema_JSON_AdditionalProperties `protobuf:"bytes,25,opt,name=additional_properties,json=additionalProperties" json:"additional_properties,omitempty"`
-	HasAdditionalProperties bool                              `protobuf:"varint,26,opt,name=has_additional_properties,json=hasAdditionalProperties,proto3" json:"has_additional_properties,omitempty"`
-	AllOf                   []uint32                          `protobuf:"varint,27,rep,packed,name=all_of,json=allOf" json:"all_of,omitempty"`
-	AnyOf                   []uint32                          `protobuf:"varint,28,rep,packed,name=any_of,json=anyOf" json:"any_of,omitempty"`
-	OneOf                   []uint32                          `protobuf:"varint,29,rep,packed,name=one_of,json=oneOf" json:"one_of,omitempty"`
-	Not                     uint32                            `protobuf:"varint,30,opt,name=not,proto3" json:"not,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                          `json:"-"`
-	XXX_unrecognized        []byte                            `json:"-"`
-	XXX_sizecache           int32                             `json:"-"`
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *Schema_JSON) Reset()         { *m = Schema_JSON{} }
-func (m *Schema_JSON) String() string { return proto.CompactTextString(m) }
-func (*Schema_JSON) ProtoMessage()    {}
-func (*Schema_JSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{10, 0}
+type Clt_CallResponseRaw_Output_WebSocketResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Messages lists messages exchanged on the connection, in order
+	Messages             []*Clt_CallResponseRaw_Output_WebSocketResponse_Message `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	ElapsedNs            int64                                                   `protobuf:"varint,3,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                                `json:"-"`
+	XXX_unrecognized     []byte                                                  `json:"-"`
+	XXX_sizecache        int32                                                   `json:"-"`
 }
-func (m *Schema_JSON) XXX_Unmarshal(b []byte) error {
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) Reset() {
+	*m = Clt_CallResponseRaw_Output_WebSocketResponse{}
+}
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Clt_CallResponseRaw_Output_WebSocketResponse) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_WebSocketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 2}
+}
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Schema_JSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Schema_JSON.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Schema_JSON) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Schema_JSON.Merge(dst, src)
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse.Merge(m, src)
 }
-func (m *Schema_JSON) XXX_Size() int {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Schema_JSON) XXX_DiscardUnknown() {
-	xxx_messageInfo_Schema_JSON.DiscardUnknown(m)
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Schema_JSON proto.InternalMessageInfo
+var xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse proto.InternalMessageInfo
 
-func (m *Schema_JSON) GetTypes() []Schema_JSON_Type {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) GetError() string {
 	if m != nil {
-		return m.Types
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *Schema_JSON) GetEnum() []*types.Value {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) GetMessages() []*Clt_CallResponseRaw_Output_WebSocketResponse_Message {
 	if m != nil {
-		return m.Enum
+		return m.Messages
 	}
 	return nil
 }
 
-func (m *Schema_JSON) GetFormat() Schema_JSON_Format {
-	if m != nil {
-		return m.Format
-	}
-	return Schema_JSON_NONE
-}
-
-func (m *Schema_JSON) GetMinLength() uint64 {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) GetElapsedNs() int64 {
 	if m != nil {
-		return m.MinLength
+		return m.ElapsedNs
 	}
 	return 0
 }
 
-func (m *Schema_JSON) GetMaxLength() uint64 {
-	if m != nil {
-		return m.MaxLength
-	}
-	return 0
+type Clt_CallResponseRaw_Output_WebSocketResponse_Message struct {
+	// Sent is set on the published message, unset on received ones
+	Sent        bool         `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Data        []byte       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DataDecoded *types.Value `protobuf:"bytes,3,opt,name=data_decoded,json=dataDecoded,proto3" json:"data_decoded,omitempty"`
+	// ElapsedNs is the time since the connection was written to
+	ElapsedNs            int64    `protobuf:"varint,4,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Schema_JSON) GetHasMaxLength() bool {
-	if m != nil {
-		return m.HasMaxLength
-	}
-	return false
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) Reset() {
+	*m = Clt_CallResponseRaw_Output_WebSocketResponse_Message{}
 }
-
-func (m *Schema_JSON) GetPattern() string {
-	if m != nil {
-		return m.Pattern
-	}
-	return ""
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *Schema_JSON) GetMinimum() float64 {
-	if m != nil {
-		return m.Minimum
-	}
-	return 0
+func (*Clt_CallResponseRaw_Output_WebSocketResponse_Message) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_WebSocketResponse_Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 0, 2, 0}
 }
-
-func (m *Schema_JSON) GetMaximum() float64 {
-	if m != nil {
-		return m.Maximum
-	}
-	return 0
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Schema_JSON) GetHasMinimum() bool {
-	if m != nil {
-		return m.HasMinimum
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse_Message.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
 }
-
-func (m *Schema_JSON) GetHasMaximum() bool {
-	if m != nil {
-		return m.HasMaximum
-	}
-	return false
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse_Message.Merge(m, src)
 }
-
-func (m *Schema_JSON) GetTranslatedMultipleOf() float64 {
-	if m != nil {
-		return m.TranslatedMultipleOf
-	}
-	return 0
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Schema_JSON) GetExclusiveMinimum() bool {
-	if m != nil {
-		return m.ExclusiveMinimum
-	}
-	return false
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse_Message.DiscardUnknown(m)
 }
 
-func (m *Schema_JSON) GetExclusiveMaximum() bool {
+var xxx_messageInfo_Clt_CallResponseRaw_Output_WebSocketResponse_Message proto.InternalMessageInfo
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) GetSent() bool {
 	if m != nil {
-		return m.ExclusiveMaximum
+		return m.Sent
 	}
 	return false
 }
 
-func (m *Schema_JSON) GetItems() []uint32 {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) GetData() []byte {
 	if m != nil {
-		return m.Items
+		return m.Data
 	}
 	return nil
 }
 
-func (m *Schema_JSON) GetUniqueItems() bool {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) GetDataDecoded() *types.Value {
 	if m != nil {
-		return m.UniqueItems
+		return m.DataDecoded
 	}
-	return false
+	return nil
 }
 
-func (m *Schema_JSON) GetMinItems() uint64 {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) GetElapsedNs() int64 {
 	if m != nil {
-		return m.MinItems
+		return m.ElapsedNs
 	}
 	return 0
 }
 
-func (m *Schema_JSON) GetMaxItems() uint64 {
-	if m != nil {
-		return m.MaxItems
-	}
-	return 0
+// LogLine is a line output by the System Under Test
+type Clt_CallResponseRaw_LogLine struct {
+	// Source is STDOUT, STDERR or the path of a tailed log file
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Line   string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	// OffsetNs is when the line was read, relative to the start of the call
+	OffsetNs             int64    `protobuf:"varint,3,opt,name=offset_ns,json=offsetNs,proto3" json:"offset_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Schema_JSON) GetHasMaxItems() bool {
-	if m != nil {
-		return m.HasMaxItems
-	}
-	return false
+func (m *Clt_CallResponseRaw_LogLine) Reset()         { *m = Clt_CallResponseRaw_LogLine{} }
+func (m *Clt_CallResponseRaw_LogLine) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallResponseRaw_LogLine) ProtoMessage()    {}
+func (*Clt_CallResponseRaw_LogLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 3, 1}
 }
-
-func (m *Schema_JSON) GetProperties() map[string]uint32 {
-	if m != nil {
-		return m.Properties
-	}
-	return nil
+func (m *Clt_CallResponseRaw_LogLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Schema_JSON) GetRequired() []string {
-	if m != nil {
-		return m.Required
+func (m *Clt_CallResponseRaw_LogLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_CallResponseRaw_LogLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *Schema_JSON) GetMinProperties() uint64 {
-	if m != nil {
-		return m.MinProperties
-	}
-	return 0
+func (m *Clt_CallResponseRaw_LogLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallResponseRaw_LogLine.Merge(m, src)
+}
+func (m *Clt_CallResponseRaw_LogLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_CallResponseRaw_LogLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallResponseRaw_LogLine.DiscardUnknown(m)
 }
 
-func (m *Schema_JSON) GetMaxProperties() uint64 {
+var xxx_messageInfo_Clt_CallResponseRaw_LogLine proto.InternalMessageInfo
+
+func (m *Clt_CallResponseRaw_LogLine) GetSource() string {
 	if m != nil {
-		return m.MaxProperties
+		return m.Source
 	}
-	return 0
+	return ""
 }
 
-func (m *Schema_JSON) GetHasMaxProperties() bool {
+func (m *Clt_CallResponseRaw_LogLine) GetLine() string {
 	if m != nil {
-		return m.HasMaxProperties
+		return m.Line
 	}
-	return false
+	return ""
 }
 
-func (m *Schema_JSON) GetAdditionalProperties() *Schema_JSON_AdditionalProperties {
+func (m *Clt_CallResponseRaw_LogLine) GetOffsetNs() int64 {
 	if m != nil {
-		return m.AdditionalProperties
+		return m.OffsetNs
 	}
-	return nil
+	return 0
 }
 
-func (m *Schema_JSON) GetHasAdditionalProperties() bool {
+type Clt_CallVerifProgress struct {
+	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status               Clt_CallVerifProgress_Status `protobuf:"varint,2,opt,name=status,proto3,enum=fm.Clt_CallVerifProgress_Status" json:"status,omitempty"`
+	Origin               Clt_CallVerifProgress_Origin `protobuf:"varint,3,opt,name=origin,proto3,enum=fm.Clt_CallVerifProgress_Origin" json:"origin,omitempty"`
+	Reason               []string                     `protobuf:"bytes,4,rep,name=reason,proto3" json:"reason,omitempty"`
+	ElapsedNs            int64                        `protobuf:"varint,5,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	ExecutionSteps       uint64                       `protobuf:"varint,6,opt,name=execution_steps,json=executionSteps,proto3" json:"execution_steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *Clt_CallVerifProgress) Reset()         { *m = Clt_CallVerifProgress{} }
+func (m *Clt_CallVerifProgress) String() string { return proto.CompactTextString(m) }
+func (*Clt_CallVerifProgress) ProtoMessage()    {}
+func (*Clt_CallVerifProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{0, 4}
+}
+func (m *Clt_CallVerifProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clt_CallVerifProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clt_CallVerifProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Clt_CallVerifProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clt_CallVerifProgress.Merge(m, src)
+}
+func (m *Clt_CallVerifProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clt_CallVerifProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clt_CallVerifProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Clt_CallVerifProgress proto.InternalMessageInfo
+
+func (m *Clt_CallVerifProgress) GetName() string {
 	if m != nil {
-		return m.HasAdditionalProperties
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *Schema_JSON) GetAllOf() []uint32 {
+func (m *Clt_CallVerifProgress) GetStatus() Clt_CallVerifProgress_Status {
 	if m != nil {
-		return m.AllOf
+		return m.Status
 	}
-	return nil
+	return Clt_CallVerifProgress_NO_STATUS
 }
 
-func (m *Schema_JSON) GetAnyOf() []uint32 {
+func (m *Clt_CallVerifProgress) GetOrigin() Clt_CallVerifProgress_Origin {
 	if m != nil {
-		return m.AnyOf
+		return m.Origin
 	}
-	return nil
+	return Clt_CallVerifProgress_NO_ORIGIN
 }
 
-func (m *Schema_JSON) GetOneOf() []uint32 {
+func (m *Clt_CallVerifProgress) GetReason() []string {
 	if m != nil {
-		return m.OneOf
+		return m.Reason
 	}
 	return nil
 }
 
-func (m *Schema_JSON) GetNot() uint32 {
+func (m *Clt_CallVerifProgress) GetElapsedNs() int64 {
 	if m != nil {
-		return m.Not
+		return m.ElapsedNs
 	}
 	return 0
 }
 
-type Schema_JSON_AdditionalProperties struct {
-	// Types that are valid to be assigned to AddProps:
-	//	*Schema_JSON_AdditionalProperties_AlwaysSucceed
-	//	*Schema_JSON_AdditionalProperties_SID
-	AddProps             isSchema_JSON_AdditionalProperties_AddProps `protobuf_oneof:"add_props"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
-	XXX_unrecognized     []byte                                      `json:"-"`
-	XXX_sizecache        int32                                       `json:"-"`
+func (m *Clt_CallVerifProgress) GetExecutionSteps() uint64 {
+	if m != nil {
+		return m.ExecutionSteps
+	}
+	return 0
 }
 
-func (m *Schema_JSON_AdditionalProperties) Reset()         { *m = Schema_JSON_AdditionalProperties{} }
-func (m *Schema_JSON_AdditionalProperties) String() string { return proto.CompactTextString(m) }
-func (*Schema_JSON_AdditionalProperties) ProtoMessage()    {}
-func (*Schema_JSON_AdditionalProperties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fuzzymonkey_5e0d18cbb143f986, []int{10, 0, 1}
+type Srv struct {
+	FuzzingProgress *Srv_FuzzingProgress `protobuf:"bytes,1,opt,name=fuzzing_progress,json=fuzzingProgress,proto3" json:"fuzzing_progress,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//	*Srv_FuzzRep_
+	//	*Srv_Call_
+	//	*Srv_Reset_
+	//	*Srv_FuzzingResult_
+	Msg                  isSrv_Msg `protobuf_oneof:"msg"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *Schema_JSON_AdditionalProperties) XXX_Unmarshal(b []byte) error {
+
+func (m *Srv) Reset()         { *m = Srv{} }
+func (m *Srv) String() string { return proto.CompactTextString(m) }
+func (*Srv) ProtoMessage()    {}
+func (*Srv) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1}
+}
+func (m *Srv) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Schema_JSON_AdditionalProperties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Srv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Schema_JSON_AdditionalProperties.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Srv.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Schema_JSON_AdditionalProperties) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Schema_JSON_AdditionalProperties.Merge(dst, src)
+func (m *Srv) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv.Merge(m, src)
 }
-func (m *Schema_JSON_AdditionalProperties) XXX_Size() int {
+func (m *Srv) XXX_Size() int {
 	return m.Size()
 }
-func (m *Schema_JSON_AdditionalProperties) XXX_DiscardUnknown() {
-	xxx_messageInfo_Schema_JSON_AdditionalProperties.DiscardUnknown(m)
+func (m *Srv) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Schema_JSON_AdditionalProperties proto.InternalMessageInfo
+var xxx_messageInfo_Srv proto.InternalMessageInfo
 
-type isSchema_JSON_AdditionalProperties_AddProps interface {
-	isSchema_JSON_AdditionalProperties_AddProps()
+type isSrv_Msg interface {
+	isSrv_Msg()
 	Equal(interface{}) bool
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type Schema_JSON_AdditionalProperties_AlwaysSucceed struct {
-	AlwaysSucceed bool `protobuf:"varint,1,opt,name=always_succeed,json=alwaysSucceed,proto3,oneof"`
+type Srv_FuzzRep_ struct {
+	FuzzRep *Srv_FuzzRep `protobuf:"bytes,2,opt,name=fuzz_rep,json=fuzzRep,proto3,oneof" json:"fuzz_rep,omitempty"`
 }
-type Schema_JSON_AdditionalProperties_SID struct {
-	SID uint32 `protobuf:"varint,2,opt,name=SID,proto3,oneof"`
+type Srv_Call_ struct {
+	Call *Srv_Call `protobuf:"bytes,3,opt,name=call,proto3,oneof" json:"call,omitempty"`
+}
+type Srv_Reset_ struct {
+	Reset_ *Srv_Reset `protobuf:"bytes,4,opt,name=reset,proto3,oneof" json:"reset,omitempty"`
+}
+type Srv_FuzzingResult_ struct {
+	FuzzingResult *Srv_FuzzingResult `protobuf:"bytes,5,opt,name=fuzzing_result,json=fuzzingResult,proto3,oneof" json:"fuzzing_result,omitempty"`
 }
 
-func (*Schema_JSON_AdditionalProperties_AlwaysSucceed) isSchema_JSON_AdditionalProperties_AddProps() {
+func (*Srv_FuzzRep_) isSrv_Msg()       {}
+func (*Srv_Call_) isSrv_Msg()          {}
+func (*Srv_Reset_) isSrv_Msg()         {}
+func (*Srv_FuzzingResult_) isSrv_Msg() {}
+
+func (m *Srv) GetMsg() isSrv_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
-func (*Schema_JSON_AdditionalProperties_SID) isSchema_JSON_AdditionalProperties_AddProps() {}
 
-func (m *Schema_JSON_AdditionalProperties) GetAddProps() isSchema_JSON_AdditionalProperties_AddProps {
+func (m *Srv) GetFuzzingProgress() *Srv_FuzzingProgress {
 	if m != nil {
-		return m.AddProps
+		return m.FuzzingProgress
 	}
 	return nil
 }
 
-func (m *Schema_JSON_AdditionalProperties) GetAlwaysSucceed() bool {
-	if x, ok := m.GetAddProps().(*Schema_JSON_AdditionalProperties_AlwaysSucceed); ok {
-		return x.AlwaysSucceed
+func (m *Srv) GetFuzzRep() *Srv_FuzzRep {
+	if x, ok := m.GetMsg().(*Srv_FuzzRep_); ok {
+		return x.FuzzRep
 	}
-	return false
+	return nil
 }
 
-func (m *Schema_JSON_AdditionalProperties) GetSID() uint32 {
-	if x, ok := m.GetAddProps().(*Schema_JSON_AdditionalProperties_SID); ok {
-		return x.SID
+func (m *Srv) GetCall() *Srv_Call {
+	if x, ok := m.GetMsg().(*Srv_Call_); ok {
+		return x.Call
 	}
-	return 0
+	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Schema_JSON_AdditionalProperties) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Schema_JSON_AdditionalProperties_OneofMarshaler, _Schema_JSON_AdditionalProperties_OneofUnmarshaler, _Schema_JSON_AdditionalProperties_OneofSizer, []interface{}{
-		(*Schema_JSON_AdditionalProperties_AlwaysSucceed)(nil),
-		(*Schema_JSON_AdditionalProperties_SID)(nil),
+func (m *Srv) GetReset_() *Srv_Reset {
+	if x, ok := m.GetMsg().(*Srv_Reset_); ok {
+		return x.Reset_
 	}
+	return nil
 }
 
-func _Schema_JSON_AdditionalProperties_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Schema_JSON_AdditionalProperties)
-	// add_props
-	switch x := m.AddProps.(type) {
-	case *Schema_JSON_AdditionalProperties_AlwaysSucceed:
-		t := uint64(0)
-		if x.AlwaysSucceed {
-			t = 1
-		}
-		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
-		_ = b.EncodeVarint(t)
-	case *Schema_JSON_AdditionalProperties_SID:
-		_ = b.EncodeVarint(2<<3 | proto.WireVarint)
-		_ = b.EncodeVarint(uint64(x.SID))
-	case nil:
-	default:
-		return fmt.Errorf("Schema_JSON_AdditionalProperties.AddProps has unexpected type %T", x)
+func (m *Srv) GetFuzzingResult() *Srv_FuzzingResult {
+	if x, ok := m.GetMsg().(*Srv_FuzzingResult_); ok {
+		return x.FuzzingResult
 	}
 	return nil
 }
 
-func _Schema_JSON_AdditionalProperties_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Schema_JSON_AdditionalProperties)
-	switch tag {
-	case 1: // add_props.always_succeed
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.AddProps = &Schema_JSON_AdditionalProperties_AlwaysSucceed{x != 0}
-		return true, err
-	case 2: // add_props.SID
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.AddProps = &Schema_JSON_AdditionalProperties_SID{uint32(x)}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Schema_JSON_AdditionalProperties_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Schema_JSON_AdditionalProperties)
-	// add_props
-	switch x := m.AddProps.(type) {
-	case *Schema_JSON_AdditionalProperties_AlwaysSucceed:
-		n += 1 // tag and wire
-		n += 1
-	case *Schema_JSON_AdditionalProperties_SID:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(x.SID))
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Srv) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Srv_FuzzRep_)(nil),
+		(*Srv_Call_)(nil),
+		(*Srv_Reset_)(nil),
+		(*Srv_FuzzingResult_)(nil),
 	}
-	return n
 }
 
-func init() {
-	proto.RegisterType((*Clt)(nil), "fm.Clt")
-	proto.RegisterType((*Clt_Fuzz)(nil), "fm.Clt.Fuzz")
-	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.EnvReadEntry")
-	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.FilesEntry")
-	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.LabelsEntry")
-	proto.RegisterType((*Clt_Fuzz_Resetter)(nil), "fm.Clt.Fuzz.Resetter")
-	proto.RegisterType((*Clt_Fuzz_Resetter_Shell)(nil), "fm.Clt.Fuzz.Resetter.Shell")
-	proto.RegisterType((*Clt_Fuzz_Model)(nil), "fm.Clt.Fuzz.Model")
-	proto.RegisterType((*Clt_Fuzz_Model_OpenAPIv3)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3")
-	proto.RegisterType((*Clt_ResetProgress)(nil), "fm.Clt.ResetProgress")
-	proto.RegisterType((*Clt_CallRequestRaw)(nil), "fm.Clt.CallRequestRaw")
-	proto.RegisterType((*Clt_CallRequestRaw_Input)(nil), "fm.Clt.CallRequestRaw.Input")
-	proto.RegisterType((*Clt_CallRequestRaw_Input_HttpRequest)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest")
-	proto.RegisterMapType((map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest.HeadersEntry")
-	proto.RegisterType((*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest.HeaderValues")
-	proto.RegisterType((*Clt_CallResponseRaw)(nil), "fm.Clt.CallResponseRaw")
-	proto.RegisterType((*Clt_CallResponseRaw_Output)(nil), "fm.Clt.CallResponseRaw.Output")
-	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse")
-	proto.RegisterMapType((map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.HeadersEntry")
-	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.HeaderValues")
-	proto.RegisterType((*Clt_CallVerifProgress)(nil), "fm.Clt.CallVerifProgress")
-	proto.RegisterType((*Srv)(nil), "fm.Srv")
-	proto.RegisterType((*Srv_FuzzingProgress)(nil), "fm.Srv.FuzzingProgress")
-	proto.RegisterType((*Srv_FuzzRep)(nil), "fm.Srv.FuzzRep")
-	proto.RegisterType((*Srv_Call)(nil), "fm.Srv.Call")
-	proto.RegisterType((*Srv_Call_Input)(nil), "fm.Srv.Call.Input")
-	proto.RegisterType((*Srv_Call_Input_HttpRequest)(nil), "fm.Srv.Call.Input.HttpRequest")
-	proto.RegisterMapType((map[string]*Srv_Call_Input_HttpRequest_HeaderValues)(nil), "fm.Srv.Call.Input.HttpRequest.HeadersEntry")
-	proto.RegisterType((*Srv_Call_Input_HttpRequest_HeaderValues)(nil), "fm.Srv.Call.Input.HttpRequest.HeaderValues")
-	proto.RegisterType((*Srv_Reset)(nil), "fm.Srv.Reset")
-	proto.RegisterType((*Srv_FuzzingResult)(nil), "fm.Srv.FuzzingResult")
-	proto.RegisterType((*Srv_FuzzingResult_CounterexampleItem)(nil), "fm.Srv.FuzzingResult.CounterexampleItem")
-	proto.RegisterType((*SpecIR)(nil), "fm.SpecIR")
-	proto.RegisterMapType((map[uint32]*Endpoint)(nil), "fm.SpecIR.EndpointsEntry")
-	proto.RegisterType((*Schemas)(nil), "fm.Schemas")
-	proto.RegisterMapType((map[uint32]*RefOrSchemaJSON)(nil), "fm.Schemas.JsonEntry")
-	proto.RegisterType((*RefOrSchemaJSON)(nil), "fm.RefOrSchemaJSON")
-	proto.RegisterType((*SchemaPtr)(nil), "fm.SchemaPtr")
-	proto.RegisterType((*Endpoint)(nil), "fm.Endpoint")
-	proto.RegisterType((*EndpointJSON)(nil), "fm.EndpointJSON")
-	proto.RegisterMapType((map[uint32]uint32)(nil), "fm.EndpointJSON.OutputsEntry")
-	proto.RegisterType((*ParamJSON)(nil), "fm.ParamJSON")
-	proto.RegisterType((*PathPartial)(nil), "fm.PathPartial")
-	proto.RegisterType((*Schema)(nil), "fm.Schema")
-	proto.RegisterType((*Schema_JSON)(nil), "fm.Schema.JSON")
-	proto.RegisterMapType((map[string]uint32)(nil), "fm.Schema.JSON.PropertiesEntry")
-	proto.RegisterType((*Schema_JSON_AdditionalProperties)(nil), "fm.Schema.JSON.AdditionalProperties")
-	proto.RegisterEnum("fm.Clt_ResetProgress_Status", Clt_ResetProgress_Status_name, Clt_ResetProgress_Status_value)
-	proto.RegisterEnum("fm.Clt_CallVerifProgress_Status", Clt_CallVerifProgress_Status_name, Clt_CallVerifProgress_Status_value)
-	proto.RegisterEnum("fm.Clt_CallVerifProgress_Origin", Clt_CallVerifProgress_Origin_name, Clt_CallVerifProgress_Origin_value)
-	proto.RegisterEnum("fm.EndpointJSON_Method", EndpointJSON_Method_name, EndpointJSON_Method_value)
-	proto.RegisterEnum("fm.ParamJSON_Kind", ParamJSON_Kind_name, ParamJSON_Kind_value)
-	proto.RegisterEnum("fm.Schema_JSON_Type", Schema_JSON_Type_name, Schema_JSON_Type_value)
-	proto.RegisterEnum("fm.Schema_JSON_Format", Schema_JSON_Format_name, Schema_JSON_Format_value)
+type Srv_FuzzingProgress struct {
+	Failure              bool     `protobuf:"varint,1,opt,name=failure,proto3" json:"failure,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	LastCallSuccess      bool     `protobuf:"varint,3,opt,name=last_call_success,json=lastCallSuccess,proto3" json:"last_call_success,omitempty"`
+	LastCheckFailure     bool     `protobuf:"varint,4,opt,name=last_check_failure,json=lastCheckFailure,proto3" json:"last_check_failure,omitempty"`
+	LastCheckSuccess     bool     `protobuf:"varint,5,opt,name=last_check_success,json=lastCheckSuccess,proto3" json:"last_check_success,omitempty"`
+	TotalTestsCount      uint32   `protobuf:"varint,6,opt,name=total_tests_count,json=totalTestsCount,proto3" json:"total_tests_count,omitempty"`
+	TotalCallsCount      uint32   `protobuf:"varint,7,opt,name=total_calls_count,json=totalCallsCount,proto3" json:"total_calls_count,omitempty"`
+	TotalChecksCount     uint32   `protobuf:"varint,8,opt,name=total_checks_count,json=totalChecksCount,proto3" json:"total_checks_count,omitempty"`
+	TestCallsCount       uint32   `protobuf:"varint,9,opt,name=test_calls_count,json=testCallsCount,proto3" json:"test_calls_count,omitempty"`
+	CallChecksCount      uint32   `protobuf:"varint,10,opt,name=call_checks_count,json=callChecksCount,proto3" json:"call_checks_count,omitempty"`
+	CallChecksSkipped    uint32   `protobuf:"varint,11,opt,name=call_checks_skipped,json=callChecksSkipped,proto3" json:"call_checks_skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *Clt) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt)
-	if !ok {
-		that2, ok := that.(Clt)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Msg == nil {
-		if this.Msg != nil {
-			return false
+func (m *Srv_FuzzingProgress) Reset()         { *m = Srv_FuzzingProgress{} }
+func (m *Srv_FuzzingProgress) String() string { return proto.CompactTextString(m) }
+func (*Srv_FuzzingProgress) ProtoMessage()    {}
+func (*Srv_FuzzingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 0}
+}
+func (m *Srv_FuzzingProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_FuzzingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_FuzzingProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Msg == nil {
-		return false
-	} else if !this.Msg.Equal(that1.Msg) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Clt_Fuzz_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Srv_FuzzingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_FuzzingProgress.Merge(m, src)
+}
+func (m *Srv_FuzzingProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_FuzzingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_FuzzingProgress.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Clt_Fuzz_)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+var xxx_messageInfo_Srv_FuzzingProgress proto.InternalMessageInfo
+
+func (m *Srv_FuzzingProgress) GetFailure() bool {
+	if m != nil {
+		return m.Failure
 	}
-	if !this.Fuzz.Equal(that1.Fuzz) {
-		return false
+	return false
+}
+
+func (m *Srv_FuzzingProgress) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return true
+	return false
 }
-func (this *Clt_ResetProgress_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_FuzzingProgress) GetLastCallSuccess() bool {
+	if m != nil {
+		return m.LastCallSuccess
 	}
+	return false
+}
 
-	that1, ok := that.(*Clt_ResetProgress_)
-	if !ok {
-		that2, ok := that.(Clt_ResetProgress_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Srv_FuzzingProgress) GetLastCheckFailure() bool {
+	if m != nil {
+		return m.LastCheckFailure
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *Srv_FuzzingProgress) GetLastCheckSuccess() bool {
+	if m != nil {
+		return m.LastCheckSuccess
 	}
-	if !this.ResetProgress.Equal(that1.ResetProgress) {
-		return false
+	return false
+}
+
+func (m *Srv_FuzzingProgress) GetTotalTestsCount() uint32 {
+	if m != nil {
+		return m.TotalTestsCount
 	}
-	return true
+	return 0
 }
-func (this *Clt_CallRequestRaw_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_FuzzingProgress) GetTotalCallsCount() uint32 {
+	if m != nil {
+		return m.TotalCallsCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*Clt_CallRequestRaw_)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Srv_FuzzingProgress) GetTotalChecksCount() uint32 {
+	if m != nil {
+		return m.TotalChecksCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Srv_FuzzingProgress) GetTestCallsCount() uint32 {
+	if m != nil {
+		return m.TestCallsCount
 	}
-	if !this.CallRequestRaw.Equal(that1.CallRequestRaw) {
-		return false
+	return 0
+}
+
+func (m *Srv_FuzzingProgress) GetCallChecksCount() uint32 {
+	if m != nil {
+		return m.CallChecksCount
 	}
-	return true
+	return 0
 }
-func (this *Clt_CallResponseRaw_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_FuzzingProgress) GetCallChecksSkipped() uint32 {
+	if m != nil {
+		return m.CallChecksSkipped
 	}
+	return 0
+}
 
-	that1, ok := that.(*Clt_CallResponseRaw_)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Srv_FuzzRep struct {
+	MaxTestsCount             uint32   `protobuf:"varint,1,opt,name=max_tests_count,json=maxTestsCount,proto3" json:"max_tests_count,omitempty"`
+	Seed                      []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Token                     string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	MaxExecutionStepsPerCheck uint64   `protobuf:"varint,4,opt,name=max_execution_steps_per_check,json=maxExecutionStepsPerCheck,proto3" json:"max_execution_steps_per_check,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *Srv_FuzzRep) Reset()         { *m = Srv_FuzzRep{} }
+func (m *Srv_FuzzRep) String() string { return proto.CompactTextString(m) }
+func (*Srv_FuzzRep) ProtoMessage()    {}
+func (*Srv_FuzzRep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 1}
+}
+func (m *Srv_FuzzRep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_FuzzRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_FuzzRep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CallResponseRaw.Equal(that1.CallResponseRaw) {
-		return false
-	}
-	return true
 }
-func (this *Clt_CallVerifProgress_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Srv_FuzzRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_FuzzRep.Merge(m, src)
+}
+func (m *Srv_FuzzRep) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_FuzzRep) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_FuzzRep.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Clt_CallVerifProgress_)
-	if !ok {
-		that2, ok := that.(Clt_CallVerifProgress_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_Srv_FuzzRep proto.InternalMessageInfo
+
+func (m *Srv_FuzzRep) GetMaxTestsCount() uint32 {
+	if m != nil {
+		return m.MaxTestsCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Srv_FuzzRep) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
 	}
-	if !this.CallVerifProgress.Equal(that1.CallVerifProgress) {
-		return false
+	return nil
+}
+
+func (m *Srv_FuzzRep) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return true
+	return ""
 }
-func (this *Clt_Fuzz) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_FuzzRep) GetMaxExecutionStepsPerCheck() uint64 {
+	if m != nil {
+		return m.MaxExecutionStepsPerCheck
 	}
+	return 0
+}
 
-	that1, ok := that.(*Clt_Fuzz)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Resetter.Equal(that1.Resetter) {
-		return false
-	}
-	if !this.Model.Equal(that1.Model) {
-		return false
-	}
-	if len(this.Usage) != len(that1.Usage) {
-		return false
-	}
-	for i := range this.Usage {
-		if this.Usage[i] != that1.Usage[i] {
-			return false
-		}
-	}
-	if !bytes.Equal(this.Seed, that1.Seed) {
-		return false
-	}
-	if this.Ntensity != that1.Ntensity {
-		return false
-	}
-	if len(this.EIDs) != len(that1.EIDs) {
-		return false
-	}
-	for i := range this.EIDs {
-		if this.EIDs[i] != that1.EIDs[i] {
-			return false
-		}
-	}
-	if len(this.Labels) != len(that1.Labels) {
-		return false
-	}
-	for i := range this.Labels {
-		if this.Labels[i] != that1.Labels[i] {
-			return false
+type Srv_Call struct {
+	Input                *Srv_Call_Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	EID                  uint32          `protobuf:"varint,2,opt,name=EID,proto3" json:"EID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Srv_Call) Reset()         { *m = Srv_Call{} }
+func (m *Srv_Call) String() string { return proto.CompactTextString(m) }
+func (*Srv_Call) ProtoMessage()    {}
+func (*Srv_Call) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 2}
+}
+func (m *Srv_Call) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_Call) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_Call.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.EnvRead) != len(that1.EnvRead) {
-		return false
-	}
-	for i := range this.EnvRead {
-		if this.EnvRead[i] != that1.EnvRead[i] {
-			return false
-		}
+}
+func (m *Srv_Call) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_Call.Merge(m, src)
+}
+func (m *Srv_Call) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_Call) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_Call.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_Call proto.InternalMessageInfo
+
+func (m *Srv_Call) GetInput() *Srv_Call_Input {
+	if m != nil {
+		return m.Input
 	}
-	if len(this.UUIDs) != len(that1.UUIDs) {
-		return false
+	return nil
+}
+
+func (m *Srv_Call) GetEID() uint32 {
+	if m != nil {
+		return m.EID
 	}
-	for i := range this.UUIDs {
-		if this.UUIDs[i] != that1.UUIDs[i] {
-			return false
+	return 0
+}
+
+type Srv_Call_Input struct {
+	// Types that are valid to be assigned to Input:
+	//	*Srv_Call_Input_HttpRequest_
+	Input                isSrv_Call_Input_Input `protobuf_oneof:"input"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Srv_Call_Input) Reset()         { *m = Srv_Call_Input{} }
+func (m *Srv_Call_Input) String() string { return proto.CompactTextString(m) }
+func (*Srv_Call_Input) ProtoMessage()    {}
+func (*Srv_Call_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 2, 0}
+}
+func (m *Srv_Call_Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_Call_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_Call_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Files) != len(that1.Files) {
-		return false
-	}
-	for i := range this.Files {
-		if this.Files[i] != that1.Files[i] {
-			return false
-		}
+}
+func (m *Srv_Call_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_Call_Input.Merge(m, src)
+}
+func (m *Srv_Call_Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_Call_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_Call_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_Call_Input proto.InternalMessageInfo
+
+type isSrv_Call_Input_Input interface {
+	isSrv_Call_Input_Input()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Srv_Call_Input_HttpRequest_ struct {
+	HttpRequest *Srv_Call_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,proto3,oneof" json:"http_request,omitempty"`
+}
+
+func (*Srv_Call_Input_HttpRequest_) isSrv_Call_Input_Input() {}
+
+func (m *Srv_Call_Input) GetInput() isSrv_Call_Input_Input {
+	if m != nil {
+		return m.Input
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return nil
+}
+
+func (m *Srv_Call_Input) GetHttpRequest() *Srv_Call_Input_HttpRequest {
+	if x, ok := m.GetInput().(*Srv_Call_Input_HttpRequest_); ok {
+		return x.HttpRequest
 	}
-	return true
+	return nil
 }
-func (this *Clt_Fuzz_Resetter) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Srv_Call_Input) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Srv_Call_Input_HttpRequest_)(nil),
 	}
+}
 
-	that1, ok := that.(*Clt_Fuzz_Resetter)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Resetter)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Srv_Call_Input_HttpRequest struct {
+	Method               string                                              `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url                  string                                              `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers              map[string]*Srv_Call_Input_HttpRequest_HeaderValues `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body                 *types.Value                                        `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_unrecognized     []byte                                              `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
+}
+
+func (m *Srv_Call_Input_HttpRequest) Reset()         { *m = Srv_Call_Input_HttpRequest{} }
+func (m *Srv_Call_Input_HttpRequest) String() string { return proto.CompactTextString(m) }
+func (*Srv_Call_Input_HttpRequest) ProtoMessage()    {}
+func (*Srv_Call_Input_HttpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 2, 0, 0}
+}
+func (m *Srv_Call_Input_HttpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_Call_Input_HttpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_Call_Input_HttpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Resetter == nil {
-		if this.Resetter != nil {
-			return false
-		}
-	} else if this.Resetter == nil {
-		return false
-	} else if !this.Resetter.Equal(that1.Resetter) {
-		return false
+}
+func (m *Srv_Call_Input_HttpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_Call_Input_HttpRequest.Merge(m, src)
+}
+func (m *Srv_Call_Input_HttpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_Call_Input_HttpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_Call_Input_HttpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_Call_Input_HttpRequest proto.InternalMessageInfo
+
+func (m *Srv_Call_Input_HttpRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return ""
+}
+
+func (m *Srv_Call_Input_HttpRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return true
+	return ""
 }
-func (this *Clt_Fuzz_Resetter_Shell_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_Call_Input_HttpRequest) GetHeaders() map[string]*Srv_Call_Input_HttpRequest_HeaderValues {
+	if m != nil {
+		return m.Headers
 	}
+	return nil
+}
 
-	that1, ok := that.(*Clt_Fuzz_Resetter_Shell_)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Resetter_Shell_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Shell.Equal(that1.Shell) {
-		return false
+func (m *Srv_Call_Input_HttpRequest) GetBody() *types.Value {
+	if m != nil {
+		return m.Body
 	}
-	return true
+	return nil
 }
-func (this *Clt_Fuzz_Resetter_Shell) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_Fuzz_Resetter_Shell)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Resetter_Shell)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Start != that1.Start {
-		return false
-	}
-	if this.Rst != that1.Rst {
-		return false
-	}
-	if this.Stop != that1.Stop {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type Srv_Call_Input_HttpRequest_HeaderValues struct {
+	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *Clt_Fuzz_Model) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_Fuzz_Model)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Model)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) Reset() {
+	*m = Srv_Call_Input_HttpRequest_HeaderValues{}
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) String() string { return proto.CompactTextString(m) }
+func (*Srv_Call_Input_HttpRequest_HeaderValues) ProtoMessage()    {}
+func (*Srv_Call_Input_HttpRequest_HeaderValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 2, 0, 0, 0}
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.Merge(m, src)
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_Call_Input_HttpRequest_HeaderValues proto.InternalMessageInfo
+
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) GetValues() []string {
+	if m != nil {
+		return m.Values
 	}
-	if that1.Model == nil {
-		if this.Model != nil {
-			return false
+	return nil
+}
+
+type Srv_Reset struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Srv_Reset) Reset()         { *m = Srv_Reset{} }
+func (m *Srv_Reset) String() string { return proto.CompactTextString(m) }
+func (*Srv_Reset) ProtoMessage()    {}
+func (*Srv_Reset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 3}
+}
+func (m *Srv_Reset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_Reset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_Reset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Model == nil {
-		return false
-	} else if !this.Model.Equal(that1.Model) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Clt_Fuzz_Model_Openapiv3) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Srv_Reset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_Reset.Merge(m, src)
+}
+func (m *Srv_Reset) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_Reset) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_Reset.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Clt_Fuzz_Model_Openapiv3)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Model_Openapiv3)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_Srv_Reset proto.InternalMessageInfo
+
+type Srv_FuzzingResult struct {
+	SeedUsed             []byte                                  `protobuf:"bytes,1,opt,name=seed_used,json=seedUsed,proto3" json:"seed_used,omitempty"`
+	WasShrinking         bool                                    `protobuf:"varint,2,opt,name=was_shrinking,json=wasShrinking,proto3" json:"was_shrinking,omitempty"`
+	NextSeed             []byte                                  `protobuf:"bytes,3,opt,name=next_seed,json=nextSeed,proto3" json:"next_seed,omitempty"`
+	WillNowShrink        bool                                    `protobuf:"varint,4,opt,name=will_now_shrink,json=willNowShrink,proto3" json:"will_now_shrink,omitempty"`
+	SuggestedSeed        []byte                                  `protobuf:"bytes,5,opt,name=suggested_seed,json=suggestedSeed,proto3" json:"suggested_seed,omitempty"`
+	Counterexample       []*Srv_FuzzingResult_CounterexampleItem `protobuf:"bytes,6,rep,name=counterexample,proto3" json:"counterexample,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *Srv_FuzzingResult) Reset()         { *m = Srv_FuzzingResult{} }
+func (m *Srv_FuzzingResult) String() string { return proto.CompactTextString(m) }
+func (*Srv_FuzzingResult) ProtoMessage()    {}
+func (*Srv_FuzzingResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 4}
+}
+func (m *Srv_FuzzingResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_FuzzingResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_FuzzingResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Openapiv3.Equal(that1.Openapiv3) {
-		return false
+}
+func (m *Srv_FuzzingResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_FuzzingResult.Merge(m, src)
+}
+func (m *Srv_FuzzingResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_FuzzingResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_FuzzingResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_FuzzingResult proto.InternalMessageInfo
+
+func (m *Srv_FuzzingResult) GetSeedUsed() []byte {
+	if m != nil {
+		return m.SeedUsed
 	}
-	return true
+	return nil
 }
-func (this *Clt_Fuzz_Model_OpenAPIv3) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Srv_FuzzingResult) GetWasShrinking() bool {
+	if m != nil {
+		return m.WasShrinking
 	}
+	return false
+}
 
-	that1, ok := that.(*Clt_Fuzz_Model_OpenAPIv3)
-	if !ok {
-		that2, ok := that.(Clt_Fuzz_Model_OpenAPIv3)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Srv_FuzzingResult) GetNextSeed() []byte {
+	if m != nil {
+		return m.NextSeed
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *Srv_FuzzingResult) GetWillNowShrink() bool {
+	if m != nil {
+		return m.WillNowShrink
 	}
-	if this.File != that1.File {
-		return false
+	return false
+}
+
+func (m *Srv_FuzzingResult) GetSuggestedSeed() []byte {
+	if m != nil {
+		return m.SuggestedSeed
 	}
-	if this.Host != that1.Host {
-		return false
+	return nil
+}
+
+func (m *Srv_FuzzingResult) GetCounterexample() []*Srv_FuzzingResult_CounterexampleItem {
+	if m != nil {
+		return m.Counterexample
 	}
-	if this.HeaderAuthorization != that1.HeaderAuthorization {
-		return false
-	}
-	if !this.Spec.Equal(that1.Spec) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *Clt_ResetProgress) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_ResetProgress)
-	if !ok {
-		that2, ok := that.(Clt_ResetProgress)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if this.ElapsedNs != that1.ElapsedNs {
-		return false
-	}
-	if len(this.Reason) != len(that1.Reason) {
-		return false
-	}
-	for i := range this.Reason {
-		if this.Reason[i] != that1.Reason[i] {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type Srv_FuzzingResult_CounterexampleItem struct {
+	CallRequest          *Clt_CallRequestRaw_Input      `protobuf:"bytes,1,opt,name=call_request,json=callRequest,proto3" json:"call_request,omitempty"`
+	CallResponse         *Clt_CallResponseRaw_Output    `protobuf:"bytes,2,opt,name=call_response,json=callResponse,proto3" json:"call_response,omitempty"`
+	Logs                 []*Clt_CallResponseRaw_LogLine `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
-func (this *Clt_CallRequestRaw) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_CallRequestRaw)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	if len(this.Reason) != len(that1.Reason) {
-		return false
-	}
-	for i := range this.Reason {
-		if this.Reason[i] != that1.Reason[i] {
-			return false
+func (m *Srv_FuzzingResult_CounterexampleItem) Reset()         { *m = Srv_FuzzingResult_CounterexampleItem{} }
+func (m *Srv_FuzzingResult_CounterexampleItem) String() string { return proto.CompactTextString(m) }
+func (*Srv_FuzzingResult_CounterexampleItem) ProtoMessage()    {}
+func (*Srv_FuzzingResult_CounterexampleItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{1, 4, 0}
+}
+func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *Clt_CallRequestRaw_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.Merge(m, src)
+}
+func (m *Srv_FuzzingResult_CounterexampleItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *Srv_FuzzingResult_CounterexampleItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Srv_FuzzingResult_CounterexampleItem proto.InternalMessageInfo
+
+func (m *Srv_FuzzingResult_CounterexampleItem) GetCallRequest() *Clt_CallRequestRaw_Input {
+	if m != nil {
+		return m.CallRequest
 	}
+	return nil
+}
 
-	that1, ok := that.(*Clt_CallRequestRaw_Input)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw_Input)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Srv_FuzzingResult_CounterexampleItem) GetCallResponse() *Clt_CallResponseRaw_Output {
+	if m != nil {
+		return m.CallResponse
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) GetLogs() []*Clt_CallResponseRaw_LogLine {
+	if m != nil {
+		return m.Logs
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
+	return nil
+}
+
+type SpecIR struct {
+	Schemas *Schemas `protobuf:"bytes,1,opt,name=schemas,proto3" json:"schemas,omitempty"`
+	// All endpoints are here.
+	// Start at 1 then increases monotonously. 0 (zero) is reserved for bug
+	// finding.
+	Endpoints            map[uint32]*Endpoint `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SpecIR) Reset()         { *m = SpecIR{} }
+func (m *SpecIR) String() string { return proto.CompactTextString(m) }
+func (*SpecIR) ProtoMessage()    {}
+func (*SpecIR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{2}
+}
+func (m *SpecIR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecIR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecIR.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *SpecIR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecIR.Merge(m, src)
+}
+func (m *SpecIR) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecIR) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecIR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecIR proto.InternalMessageInfo
+
+func (m *SpecIR) GetSchemas() *Schemas {
+	if m != nil {
+		return m.Schemas
 	}
-	return true
+	return nil
 }
-func (this *Clt_CallRequestRaw_Input_HttpRequest_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SpecIR) GetEndpoints() map[uint32]*Endpoint {
+	if m != nil {
+		return m.Endpoints
 	}
+	return nil
+}
 
-	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest_)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Schemas struct {
+	// All schemas are here.
+	// Start at 1. 0 (zero) is reserved for bug finding.
+	Json                 map[uint32]*RefOrSchemaJSON `protobuf:"bytes,1,rep,name=json,proto3" json:"json,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *Schemas) Reset()         { *m = Schemas{} }
+func (m *Schemas) String() string { return proto.CompactTextString(m) }
+func (*Schemas) ProtoMessage()    {}
+func (*Schemas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{3}
+}
+func (m *Schemas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schemas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schemas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.HttpRequest.Equal(that1.HttpRequest) {
-		return false
-	}
-	return true
 }
-func (this *Clt_CallRequestRaw_Input_HttpRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Schemas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schemas.Merge(m, src)
+}
+func (m *Schemas) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schemas) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schemas.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_Schemas proto.InternalMessageInfo
+
+func (m *Schemas) GetJson() map[uint32]*RefOrSchemaJSON {
+	if m != nil {
+		return m.Json
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Method != that1.Method {
-		return false
-	}
-	if this.Url != that1.Url {
-		return false
-	}
-	if len(this.Headers) != len(that1.Headers) {
-		return false
-	}
-	for i := range this.Headers {
-		if !this.Headers[i].Equal(that1.Headers[i]) {
-			return false
-		}
-	}
-	if !bytes.Equal(this.Body, that1.Body) {
-		return false
-	}
-	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
-	if !ok {
-		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Values) != len(that1.Values) {
-		return false
-	}
-	for i := range this.Values {
-		if this.Values[i] != that1.Values[i] {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type RefOrSchemaJSON struct {
+	// Types that are valid to be assigned to PtrOrSchema:
+	//	*RefOrSchemaJSON_Ptr
+	//	*RefOrSchemaJSON_Schema
+	PtrOrSchema          isRefOrSchemaJSON_PtrOrSchema `protobuf_oneof:"ptr_or_schema"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
-func (this *Clt_CallResponseRaw) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Clt_CallResponseRaw)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *RefOrSchemaJSON) Reset()         { *m = RefOrSchemaJSON{} }
+func (m *RefOrSchemaJSON) String() string { return proto.CompactTextString(m) }
+func (*RefOrSchemaJSON) ProtoMessage()    {}
+func (*RefOrSchemaJSON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{4}
+}
+func (m *RefOrSchemaJSON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefOrSchemaJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefOrSchemaJSON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Output.Equal(that1.Output) {
-		return false
-	}
-	if this.OutputId != that1.OutputId {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *Clt_CallResponseRaw_Output) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RefOrSchemaJSON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefOrSchemaJSON.Merge(m, src)
+}
+func (m *RefOrSchemaJSON) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefOrSchemaJSON) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefOrSchemaJSON.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Clt_CallResponseRaw_Output)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw_Output)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+var xxx_messageInfo_RefOrSchemaJSON proto.InternalMessageInfo
+
+type isRefOrSchemaJSON_PtrOrSchema interface {
+	isRefOrSchemaJSON_PtrOrSchema()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type RefOrSchemaJSON_Ptr struct {
+	Ptr *SchemaPtr `protobuf:"bytes,1,opt,name=ptr,proto3,oneof" json:"ptr,omitempty"`
+}
+type RefOrSchemaJSON_Schema struct {
+	Schema *Schema_JSON `protobuf:"bytes,2,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+}
+
+func (*RefOrSchemaJSON_Ptr) isRefOrSchemaJSON_PtrOrSchema()    {}
+func (*RefOrSchemaJSON_Schema) isRefOrSchemaJSON_PtrOrSchema() {}
+
+func (m *RefOrSchemaJSON) GetPtrOrSchema() isRefOrSchemaJSON_PtrOrSchema {
+	if m != nil {
+		return m.PtrOrSchema
 	}
-	if that1.Output == nil {
-		if this.Output != nil {
-			return false
-		}
-	} else if this.Output == nil {
-		return false
-	} else if !this.Output.Equal(that1.Output) {
-		return false
+	return nil
+}
+
+func (m *RefOrSchemaJSON) GetPtr() *SchemaPtr {
+	if x, ok := m.GetPtrOrSchema().(*RefOrSchemaJSON_Ptr); ok {
+		return x.Ptr
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return nil
+}
+
+func (m *RefOrSchemaJSON) GetSchema() *Schema_JSON {
+	if x, ok := m.GetPtrOrSchema().(*RefOrSchemaJSON_Schema); ok {
+		return x.Schema
 	}
-	return true
+	return nil
 }
-func (this *Clt_CallResponseRaw_Output_HttpResponse_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RefOrSchemaJSON) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RefOrSchemaJSON_Ptr)(nil),
+		(*RefOrSchemaJSON_Schema)(nil),
 	}
+}
 
-	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SchemaPtr struct {
+	// Pointer to actual schema. i.e. key in Schemas message.
+	SID uint32 `protobuf:"varint,1,opt,name=SID,proto3" json:"SID,omitempty"`
+	// $ref if was ref'd, in canonical/absolute (#/...-like) format.
+	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaPtr) Reset()         { *m = SchemaPtr{} }
+func (m *SchemaPtr) String() string { return proto.CompactTextString(m) }
+func (*SchemaPtr) ProtoMessage()    {}
+func (*SchemaPtr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{5}
+}
+func (m *SchemaPtr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaPtr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaPtr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.HttpResponse.Equal(that1.HttpResponse) {
-		return false
-	}
-	return true
 }
-func (this *Clt_CallResponseRaw_Output_HttpResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SchemaPtr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaPtr.Merge(m, src)
+}
+func (m *SchemaPtr) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaPtr) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaPtr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaPtr proto.InternalMessageInfo
+
+func (m *SchemaPtr) GetSID() uint32 {
+	if m != nil {
+		return m.SID
 	}
+	return 0
+}
 
-	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SchemaPtr) GetRef() string {
+	if m != nil {
+		return m.Ref
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Error != that1.Error {
-		return false
+	return ""
+}
+
+type Endpoint struct {
+	// Types that are valid to be assigned to Endpoint:
+	//	*Endpoint_Json
+	Endpoint             isEndpoint_Endpoint `protobuf_oneof:"endpoint"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Endpoint) Reset()         { *m = Endpoint{} }
+func (m *Endpoint) String() string { return proto.CompactTextString(m) }
+func (*Endpoint) ProtoMessage()    {}
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{6}
+}
+func (m *Endpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Endpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Endpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.StatusCode != that1.StatusCode {
-		return false
+}
+func (m *Endpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Endpoint.Merge(m, src)
+}
+func (m *Endpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Endpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Endpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Endpoint proto.InternalMessageInfo
+
+type isEndpoint_Endpoint interface {
+	isEndpoint_Endpoint()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Endpoint_Json struct {
+	Json *EndpointJSON `protobuf:"bytes,1,opt,name=json,proto3,oneof" json:"json,omitempty"`
+}
+
+func (*Endpoint_Json) isEndpoint_Endpoint() {}
+
+func (m *Endpoint) GetEndpoint() isEndpoint_Endpoint {
+	if m != nil {
+		return m.Endpoint
 	}
-	if this.Reason != that1.Reason {
-		return false
+	return nil
+}
+
+func (m *Endpoint) GetJson() *EndpointJSON {
+	if x, ok := m.GetEndpoint().(*Endpoint_Json); ok {
+		return x.Json
 	}
-	if len(this.Headers) != len(that1.Headers) {
-		return false
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Endpoint) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Endpoint_Json)(nil),
 	}
-	for i := range this.Headers {
-		if !this.Headers[i].Equal(that1.Headers[i]) {
-			return false
+}
+
+type EndpointJSON struct {
+	Method       EndpointJSON_Method `protobuf:"varint,1,opt,name=method,proto3,enum=fm.EndpointJSON_Method" json:"method,omitempty"`
+	PathPartials []*PathPartial      `protobuf:"bytes,2,rep,name=path_partials,json=pathPartials,proto3" json:"path_partials,omitempty"`
+	Inputs       []*ParamJSON        `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The uint32 key replaces an enum of 1XX,...,201,204,...,5XX,XXX.
+	// The uint32 values are SID
+	Outputs map[uint32]uint32 `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Unique identifier given to the endpoint by the spec (may be empty)
+	OperationId string `protobuf:"bytes,5,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// Tags used to group endpoints in the spec
+	Tags                 []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndpointJSON) Reset()         { *m = EndpointJSON{} }
+func (m *EndpointJSON) String() string { return proto.CompactTextString(m) }
+func (*EndpointJSON) ProtoMessage()    {}
+func (*EndpointJSON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{7}
+}
+func (m *EndpointJSON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointJSON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.Body, that1.Body) {
-		return false
-	}
-	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
-		return false
-	}
-	if this.ElapsedNs != that1.ElapsedNs {
-		return false
+}
+func (m *EndpointJSON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointJSON.Merge(m, src)
+}
+func (m *EndpointJSON) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointJSON) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointJSON.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointJSON proto.InternalMessageInfo
+
+func (m *EndpointJSON) GetMethod() EndpointJSON_Method {
+	if m != nil {
+		return m.Method
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return EndpointJSON_UNKNOWN
+}
+
+func (m *EndpointJSON) GetPathPartials() []*PathPartial {
+	if m != nil {
+		return m.PathPartials
 	}
-	return true
+	return nil
 }
-func (this *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndpointJSON) GetInputs() []*ParamJSON {
+	if m != nil {
+		return m.Inputs
 	}
+	return nil
+}
 
-	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
-	if !ok {
-		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *EndpointJSON) GetOutputs() map[uint32]uint32 {
+	if m != nil {
+		return m.Outputs
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *EndpointJSON) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
 	}
-	if len(this.Values) != len(that1.Values) {
-		return false
+	return ""
+}
+
+func (m *EndpointJSON) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	for i := range this.Values {
-		if this.Values[i] != that1.Values[i] {
-			return false
+	return nil
+}
+
+type ParamJSON struct {
+	IsRequired bool   `protobuf:"varint,1,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	SID        uint32 `protobuf:"varint,2,opt,name=SID,proto3" json:"SID,omitempty"`
+	// Note: bodies have an empty name
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind                 ParamJSON_Kind `protobuf:"varint,4,opt,name=kind,proto3,enum=fm.ParamJSON_Kind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ParamJSON) Reset()         { *m = ParamJSON{} }
+func (m *ParamJSON) String() string { return proto.CompactTextString(m) }
+func (*ParamJSON) ProtoMessage()    {}
+func (*ParamJSON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{8}
+}
+func (m *ParamJSON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamJSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamJSON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *ParamJSON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamJSON.Merge(m, src)
+}
+func (m *ParamJSON) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamJSON) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamJSON.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamJSON proto.InternalMessageInfo
+
+func (m *ParamJSON) GetIsRequired() bool {
+	if m != nil {
+		return m.IsRequired
 	}
-	return true
+	return false
 }
-func (this *Clt_CallVerifProgress) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ParamJSON) GetSID() uint32 {
+	if m != nil {
+		return m.SID
 	}
+	return 0
+}
 
-	that1, ok := that.(*Clt_CallVerifProgress)
-	if !ok {
-		that2, ok := that.(Clt_CallVerifProgress)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ParamJSON) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *ParamJSON) GetKind() ParamJSON_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if this.Origin != that1.Origin {
-		return false
-	}
-	if len(this.Reason) != len(that1.Reason) {
-		return false
-	}
-	for i := range this.Reason {
-		if this.Reason[i] != that1.Reason[i] {
-			return false
-		}
-	}
-	if this.ElapsedNs != that1.ElapsedNs {
-		return false
-	}
-	if this.ExecutionSteps != that1.ExecutionSteps {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+	return ParamJSON_UNKNOWN
 }
-func (this *Srv) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Srv)
-	if !ok {
-		that2, ok := that.(Srv)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FuzzingProgress.Equal(that1.FuzzingProgress) {
-		return false
-	}
-	if that1.Msg == nil {
-		if this.Msg != nil {
-			return false
-		}
-	} else if this.Msg == nil {
-		return false
-	} else if !this.Msg.Equal(that1.Msg) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type PathPartial struct {
+	// Types that are valid to be assigned to Pp:
+	//	*PathPartial_Part
+	//	*PathPartial_Ptr
+	Pp                   isPathPartial_Pp `protobuf_oneof:"pp"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (this *Srv_FuzzRep_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Srv_FuzzRep_)
-	if !ok {
-		that2, ok := that.(Srv_FuzzRep_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *PathPartial) Reset()         { *m = PathPartial{} }
+func (m *PathPartial) String() string { return proto.CompactTextString(m) }
+func (*PathPartial) ProtoMessage()    {}
+func (*PathPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{9}
+}
+func (m *PathPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PathPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PathPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FuzzRep.Equal(that1.FuzzRep) {
-		return false
-	}
-	return true
 }
-func (this *Srv_Call_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PathPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathPartial.Merge(m, src)
+}
+func (m *PathPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *PathPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathPartial.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Srv_Call_)
-	if !ok {
-		that2, ok := that.(Srv_Call_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Call.Equal(that1.Call) {
-		return false
-	}
-	return true
+var xxx_messageInfo_PathPartial proto.InternalMessageInfo
+
+type isPathPartial_Pp interface {
+	isPathPartial_Pp()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (this *Srv_Reset_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Srv_Reset_)
-	if !ok {
-		that2, ok := that.(Srv_Reset_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Reset_.Equal(that1.Reset_) {
-		return false
-	}
-	return true
+type PathPartial_Part struct {
+	Part string `protobuf:"bytes,1,opt,name=part,proto3,oneof" json:"part,omitempty"`
+}
+type PathPartial_Ptr struct {
+	Ptr string `protobuf:"bytes,2,opt,name=ptr,proto3,oneof" json:"ptr,omitempty"`
 }
-func (this *Srv_FuzzingResult_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Srv_FuzzingResult_)
-	if !ok {
-		that2, ok := that.(Srv_FuzzingResult_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (*PathPartial_Part) isPathPartial_Pp() {}
+func (*PathPartial_Ptr) isPathPartial_Pp()  {}
+
+func (m *PathPartial) GetPp() isPathPartial_Pp {
+	if m != nil {
+		return m.Pp
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *PathPartial) GetPart() string {
+	if x, ok := m.GetPp().(*PathPartial_Part); ok {
+		return x.Part
 	}
-	if !this.FuzzingResult.Equal(that1.FuzzingResult) {
-		return false
+	return ""
+}
+
+func (m *PathPartial) GetPtr() string {
+	if x, ok := m.GetPp().(*PathPartial_Ptr); ok {
+		return x.Ptr
 	}
-	return true
+	return ""
 }
-func (this *Srv_FuzzingProgress) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PathPartial) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PathPartial_Part)(nil),
+		(*PathPartial_Ptr)(nil),
 	}
+}
 
-	that1, ok := that.(*Srv_FuzzingProgress)
-	if !ok {
-		that2, ok := that.(Srv_FuzzingProgress)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Schema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Schema) Reset()         { *m = Schema{} }
+func (m *Schema) String() string { return proto.CompactTextString(m) }
+func (*Schema) ProtoMessage()    {}
+func (*Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{10}
+}
+func (m *Schema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Failure != that1.Failure {
-		return false
-	}
-	if this.Success != that1.Success {
-		return false
-	}
-	if this.LastCallSuccess != that1.LastCallSuccess {
-		return false
-	}
-	if this.LastCheckFailure != that1.LastCheckFailure {
-		return false
-	}
-	if this.LastCheckSuccess != that1.LastCheckSuccess {
-		return false
-	}
-	if this.TotalTestsCount != that1.TotalTestsCount {
-		return false
-	}
-	if this.TotalCallsCount != that1.TotalCallsCount {
-		return false
+}
+func (m *Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema.Merge(m, src)
+}
+func (m *Schema) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema proto.InternalMessageInfo
+
+type Schema_JSON struct {
+	Types        []Schema_JSON_Type `protobuf:"varint,1,rep,packed,name=types,proto3,enum=fm.Schema_JSON_Type" json:"types,omitempty"`
+	Enum         []*types.Value     `protobuf:"bytes,2,rep,name=enum,proto3" json:"enum,omitempty"`
+	Format       Schema_JSON_Format `protobuf:"varint,3,opt,name=format,proto3,enum=fm.Schema_JSON_Format" json:"format,omitempty"`
+	MinLength    uint64             `protobuf:"varint,4,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength    uint64             `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	HasMaxLength bool               `protobuf:"varint,6,opt,name=has_max_length,json=hasMaxLength,proto3" json:"has_max_length,omitempty"`
+	Pattern      string             `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// type: number | integer
+	Minimum              float64 `protobuf:"fixed64,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Maximum              float64 `protobuf:"fixed64,9,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	HasMinimum           bool    `protobuf:"varint,10,opt,name=has_minimum,json=hasMinimum,proto3" json:"has_minimum,omitempty"`
+	HasMaximum           bool    `protobuf:"varint,11,opt,name=has_maximum,json=hasMaximum,proto3" json:"has_maximum,omitempty"`
+	TranslatedMultipleOf float64 `protobuf:"fixed64,12,opt,name=translated_multiple_of,json=translatedMultipleOf,proto3" json:"translated_multiple_of,omitempty"`
+	ExclusiveMinimum     bool    `protobuf:"varint,13,opt,name=exclusive_minimum,json=exclusiveMinimum,proto3" json:"exclusive_minimum,omitempty"`
+	ExclusiveMaximum     bool    `protobuf:"varint,14,opt,name=exclusive_maximum,json=exclusiveMaximum,proto3" json:"exclusive_maximum,omitempty"`
+	// type: array
+	Items       []uint32 `protobuf:"varint,15,rep,packed,name=items,proto3" json:"items,omitempty"`
+	UniqueItems bool     `protobuf:"varint,16,opt,name=unique_items,json=uniqueItems,proto3" json:"unique_items,omitempty"`
+	MinItems    uint64   `protobuf:"varint,17,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems    uint64   `protobuf:"varint,18,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	HasMaxItems bool     `protobuf:"varint,19,opt,name=has_max_items,json=hasMaxItems,proto3" json:"has_max_items,omitempty"`
+	// type: object
+	Properties              map[string]uint32                 `protobuf:"bytes,20,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Required                []string                          `protobuf:"bytes,21,rep,name=required,proto3" json:"required,omitempty"`
+	MinProperties           uint64                            `protobuf:"varint,22,opt,name=min_properties,json=minProperties,proto3" json:"min_properties,omitempty"`
+	MaxProperties           uint64                            `protobuf:"varint,23,opt,name=max_properties,json=maxProperties,proto3" json:"max_properties,omitempty"`
+	HasMaxProperties        bool                              `protobuf:"varint,24,opt,name=has_max_properties,json=hasMaxProperties,proto3" json:"has_max_properties,omitempty"`
+	AdditionalProperties    *Schema_JSON_AdditionalProperties `protobuf:"bytes,25,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+	HasAdditionalProperties bool                              `protobuf:"varint,26,opt,name=has_additional_properties,json=hasAdditionalProperties,proto3" json:"has_additional_properties,omitempty"`
+	AllOf                   []uint32                          `protobuf:"varint,27,rep,packed,name=all_of,json=allOf,proto3" json:"all_of,omitempty"`
+	AnyOf                   []uint32                          `protobuf:"varint,28,rep,packed,name=any_of,json=anyOf,proto3" json:"any_of,omitempty"`
+	OneOf                   []uint32                          `protobuf:"varint,29,rep,packed,name=one_of,json=oneOf,proto3" json:"one_of,omitempty"`
+	Not                     uint32                            `protobuf:"varint,30,opt,name=not,proto3" json:"not,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                          `json:"-"`
+	XXX_unrecognized        []byte                            `json:"-"`
+	XXX_sizecache           int32                             `json:"-"`
+}
+
+func (m *Schema_JSON) Reset()         { *m = Schema_JSON{} }
+func (m *Schema_JSON) String() string { return proto.CompactTextString(m) }
+func (*Schema_JSON) ProtoMessage()    {}
+func (*Schema_JSON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{10, 0}
+}
+func (m *Schema_JSON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schema_JSON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schema_JSON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.TotalChecksCount != that1.TotalChecksCount {
-		return false
+}
+func (m *Schema_JSON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_JSON.Merge(m, src)
+}
+func (m *Schema_JSON) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schema_JSON) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_JSON.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_JSON proto.InternalMessageInfo
+
+func (m *Schema_JSON) GetTypes() []Schema_JSON_Type {
+	if m != nil {
+		return m.Types
 	}
-	if this.TestCallsCount != that1.TestCallsCount {
-		return false
+	return nil
+}
+
+func (m *Schema_JSON) GetEnum() []*types.Value {
+	if m != nil {
+		return m.Enum
 	}
-	if this.CallChecksCount != that1.CallChecksCount {
-		return false
+	return nil
+}
+
+func (m *Schema_JSON) GetFormat() Schema_JSON_Format {
+	if m != nil {
+		return m.Format
 	}
-	if this.CallChecksSkipped != that1.CallChecksSkipped {
-		return false
+	return Schema_JSON_NONE
+}
+
+func (m *Schema_JSON) GetMinLength() uint64 {
+	if m != nil {
+		return m.MinLength
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetMaxLength() uint64 {
+	if m != nil {
+		return m.MaxLength
 	}
-	return true
+	return 0
 }
-func (this *Srv_FuzzRep) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Schema_JSON) GetHasMaxLength() bool {
+	if m != nil {
+		return m.HasMaxLength
 	}
+	return false
+}
 
-	that1, ok := that.(*Srv_FuzzRep)
-	if !ok {
-		that2, ok := that.(Srv_FuzzRep)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Schema_JSON) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Schema_JSON) GetMinimum() float64 {
+	if m != nil {
+		return m.Minimum
 	}
-	if this.MaxTestsCount != that1.MaxTestsCount {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetMaximum() float64 {
+	if m != nil {
+		return m.Maximum
 	}
-	if !bytes.Equal(this.Seed, that1.Seed) {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetHasMinimum() bool {
+	if m != nil {
+		return m.HasMinimum
 	}
-	if this.Token != that1.Token {
-		return false
+	return false
+}
+
+func (m *Schema_JSON) GetHasMaximum() bool {
+	if m != nil {
+		return m.HasMaximum
 	}
-	if this.MaxExecutionStepsPerCheck != that1.MaxExecutionStepsPerCheck {
-		return false
+	return false
+}
+
+func (m *Schema_JSON) GetTranslatedMultipleOf() float64 {
+	if m != nil {
+		return m.TranslatedMultipleOf
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetExclusiveMinimum() bool {
+	if m != nil {
+		return m.ExclusiveMinimum
 	}
-	return true
+	return false
 }
-func (this *Srv_Call) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Schema_JSON) GetExclusiveMaximum() bool {
+	if m != nil {
+		return m.ExclusiveMaximum
 	}
+	return false
+}
 
-	that1, ok := that.(*Srv_Call)
-	if !ok {
-		that2, ok := that.(Srv_Call)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Schema_JSON) GetItems() []uint32 {
+	if m != nil {
+		return m.Items
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *Schema_JSON) GetUniqueItems() bool {
+	if m != nil {
+		return m.UniqueItems
 	}
-	if !this.Input.Equal(that1.Input) {
-		return false
+	return false
+}
+
+func (m *Schema_JSON) GetMinItems() uint64 {
+	if m != nil {
+		return m.MinItems
 	}
-	if this.EID != that1.EID {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetMaxItems() uint64 {
+	if m != nil {
+		return m.MaxItems
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *Schema_JSON) GetHasMaxItems() bool {
+	if m != nil {
+		return m.HasMaxItems
 	}
-	return true
+	return false
 }
-func (this *Srv_Call_Input) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Schema_JSON) GetProperties() map[string]uint32 {
+	if m != nil {
+		return m.Properties
 	}
+	return nil
+}
 
-	that1, ok := that.(*Srv_Call_Input)
-	if !ok {
-		that2, ok := that.(Srv_Call_Input)
+func (m *Schema_JSON) GetRequired() []string {
+	if m != nil {
+		return m.Required
+	}
+	return nil
+}
+
+func (m *Schema_JSON) GetMinProperties() uint64 {
+	if m != nil {
+		return m.MinProperties
+	}
+	return 0
+}
+
+func (m *Schema_JSON) GetMaxProperties() uint64 {
+	if m != nil {
+		return m.MaxProperties
+	}
+	return 0
+}
+
+func (m *Schema_JSON) GetHasMaxProperties() bool {
+	if m != nil {
+		return m.HasMaxProperties
+	}
+	return false
+}
+
+func (m *Schema_JSON) GetAdditionalProperties() *Schema_JSON_AdditionalProperties {
+	if m != nil {
+		return m.AdditionalProperties
+	}
+	return nil
+}
+
+func (m *Schema_JSON) GetHasAdditionalProperties() bool {
+	if m != nil {
+		return m.HasAdditionalProperties
+	}
+	return false
+}
+
+func (m *Schema_JSON) GetAllOf() []uint32 {
+	if m != nil {
+		return m.AllOf
+	}
+	return nil
+}
+
+func (m *Schema_JSON) GetAnyOf() []uint32 {
+	if m != nil {
+		return m.AnyOf
+	}
+	return nil
+}
+
+func (m *Schema_JSON) GetOneOf() []uint32 {
+	if m != nil {
+		return m.OneOf
+	}
+	return nil
+}
+
+func (m *Schema_JSON) GetNot() uint32 {
+	if m != nil {
+		return m.Not
+	}
+	return 0
+}
+
+type Schema_JSON_AdditionalProperties struct {
+	// Types that are valid to be assigned to AddProps:
+	//	*Schema_JSON_AdditionalProperties_AlwaysSucceed
+	//	*Schema_JSON_AdditionalProperties_SID
+	AddProps             isSchema_JSON_AdditionalProperties_AddProps `protobuf_oneof:"add_props"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *Schema_JSON_AdditionalProperties) Reset()         { *m = Schema_JSON_AdditionalProperties{} }
+func (m *Schema_JSON_AdditionalProperties) String() string { return proto.CompactTextString(m) }
+func (*Schema_JSON_AdditionalProperties) ProtoMessage()    {}
+func (*Schema_JSON_AdditionalProperties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8efc8eb0723ab5b, []int{10, 0, 1}
+}
+func (m *Schema_JSON_AdditionalProperties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schema_JSON_AdditionalProperties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schema_JSON_AdditionalProperties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schema_JSON_AdditionalProperties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_JSON_AdditionalProperties.Merge(m, src)
+}
+func (m *Schema_JSON_AdditionalProperties) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schema_JSON_AdditionalProperties) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_JSON_AdditionalProperties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_JSON_AdditionalProperties proto.InternalMessageInfo
+
+type isSchema_JSON_AdditionalProperties_AddProps interface {
+	isSchema_JSON_AdditionalProperties_AddProps()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Schema_JSON_AdditionalProperties_AlwaysSucceed struct {
+	AlwaysSucceed bool `protobuf:"varint,1,opt,name=always_succeed,json=alwaysSucceed,proto3,oneof" json:"always_succeed,omitempty"`
+}
+type Schema_JSON_AdditionalProperties_SID struct {
+	SID uint32 `protobuf:"varint,2,opt,name=SID,proto3,oneof" json:"SID,omitempty"`
+}
+
+func (*Schema_JSON_AdditionalProperties_AlwaysSucceed) isSchema_JSON_AdditionalProperties_AddProps() {
+}
+func (*Schema_JSON_AdditionalProperties_SID) isSchema_JSON_AdditionalProperties_AddProps() {}
+
+func (m *Schema_JSON_AdditionalProperties) GetAddProps() isSchema_JSON_AdditionalProperties_AddProps {
+	if m != nil {
+		return m.AddProps
+	}
+	return nil
+}
+
+func (m *Schema_JSON_AdditionalProperties) GetAlwaysSucceed() bool {
+	if x, ok := m.GetAddProps().(*Schema_JSON_AdditionalProperties_AlwaysSucceed); ok {
+		return x.AlwaysSucceed
+	}
+	return false
+}
+
+func (m *Schema_JSON_AdditionalProperties) GetSID() uint32 {
+	if x, ok := m.GetAddProps().(*Schema_JSON_AdditionalProperties_SID); ok {
+		return x.SID
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Schema_JSON_AdditionalProperties) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Schema_JSON_AdditionalProperties_AlwaysSucceed)(nil),
+		(*Schema_JSON_AdditionalProperties_SID)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("fm.Clt_ResetProgress_Status", Clt_ResetProgress_Status_name, Clt_ResetProgress_Status_value)
+	proto.RegisterEnum("fm.Clt_CallVerifProgress_Status", Clt_CallVerifProgress_Status_name, Clt_CallVerifProgress_Status_value)
+	proto.RegisterEnum("fm.Clt_CallVerifProgress_Origin", Clt_CallVerifProgress_Origin_name, Clt_CallVerifProgress_Origin_value)
+	proto.RegisterEnum("fm.EndpointJSON_Method", EndpointJSON_Method_name, EndpointJSON_Method_value)
+	proto.RegisterEnum("fm.ParamJSON_Kind", ParamJSON_Kind_name, ParamJSON_Kind_value)
+	proto.RegisterEnum("fm.Schema_JSON_Type", Schema_JSON_Type_name, Schema_JSON_Type_value)
+	proto.RegisterEnum("fm.Schema_JSON_Format", Schema_JSON_Format_name, Schema_JSON_Format_value)
+	proto.RegisterType((*Clt)(nil), "fm.Clt")
+	proto.RegisterType((*Clt_Fuzz)(nil), "fm.Clt.Fuzz")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.EnvReadEntry")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.FilesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.LabelsEntry")
+	proto.RegisterType((*Clt_Fuzz_Resetter)(nil), "fm.Clt.Fuzz.Resetter")
+	proto.RegisterType((*Clt_Fuzz_Resetter_Shell)(nil), "fm.Clt.Fuzz.Resetter.Shell")
+	proto.RegisterType((*Clt_Fuzz_Resetter_Process)(nil), "fm.Clt.Fuzz.Resetter.Process")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Resetter.Process.EnvEntry")
+	proto.RegisterType((*Clt_Fuzz_Resetter_HTTP)(nil), "fm.Clt.Fuzz.Resetter.HTTP")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Resetter.HTTP.HeadersEntry")
+	proto.RegisterType((*Clt_Fuzz_Resetter_Starlark)(nil), "fm.Clt.Fuzz.Resetter.Starlark")
+	proto.RegisterType((*Clt_Fuzz_Resetter_Snapshot)(nil), "fm.Clt.Fuzz.Resetter.Snapshot")
+	proto.RegisterType((*Clt_Fuzz_Resetter_Sequence)(nil), "fm.Clt.Fuzz.Resetter.Sequence")
+	proto.RegisterType((*Clt_Fuzz_Model)(nil), "fm.Clt.Fuzz.Model")
+	proto.RegisterType((*Clt_Fuzz_Model_OpenAPIv3)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.HeadersEntry")
+	proto.RegisterMapType((map[string]*Clt_Fuzz_Model_OpenAPIv3_Override)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.OverridesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.QueryEntry")
+	proto.RegisterType((*Clt_Fuzz_Model_OpenAPIv3_Override)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.Override")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.Override.HeadersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.OpenAPIv3.Override.QueryEntry")
+	proto.RegisterType((*Clt_Fuzz_Model_GraphQL)(nil), "fm.Clt.Fuzz.Model.GraphQL")
+	proto.RegisterType((*Clt_Fuzz_Model_GRPC)(nil), "fm.Clt.Fuzz.Model.GRPC")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.GRPC.MetadataEntry")
+	proto.RegisterType((*Clt_Fuzz_Model_AsyncAPI)(nil), "fm.Clt.Fuzz.Model.AsyncAPI")
+	proto.RegisterMapType((map[string]string)(nil), "fm.Clt.Fuzz.Model.AsyncAPI.HeadersEntry")
+	proto.RegisterType((*Clt_Fuzz_Model_OpenRPC)(nil), "fm.Clt.Fuzz.Model.OpenRPC")
+	proto.RegisterType((*Clt_ResetProgress)(nil), "fm.Clt.ResetProgress")
+	proto.RegisterType((*Clt_CallRequestRaw)(nil), "fm.Clt.CallRequestRaw")
+	proto.RegisterType((*Clt_CallRequestRaw_Input)(nil), "fm.Clt.CallRequestRaw.Input")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_HttpRequest)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest")
+	proto.RegisterMapType((map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest.HeadersEntry")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest.HeaderValues")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_HttpRequest_GraphQL)(nil), "fm.Clt.CallRequestRaw.Input.HttpRequest.GraphQL")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_GrpcRequest)(nil), "fm.Clt.CallRequestRaw.Input.GrpcRequest")
+	proto.RegisterMapType((map[string]*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues)(nil), "fm.Clt.CallRequestRaw.Input.GrpcRequest.MetadataEntry")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues)(nil), "fm.Clt.CallRequestRaw.Input.GrpcRequest.MetadataValues")
+	proto.RegisterType((*Clt_CallRequestRaw_Input_WebSocketRequest)(nil), "fm.Clt.CallRequestRaw.Input.WebSocketRequest")
+	proto.RegisterType((*Clt_CallResponseRaw)(nil), "fm.Clt.CallResponseRaw")
+	proto.RegisterType((*Clt_CallResponseRaw_Output)(nil), "fm.Clt.CallResponseRaw.Output")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse")
+	proto.RegisterMapType((map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.HeadersEntry")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.HeaderValues")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_Timings)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Timings")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_Redirect)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Redirect")
+	proto.RegisterMapType((map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Redirect.HeadersEntry")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_Connection)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Connection")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Connection.TLS")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_HttpResponse_Event)(nil), "fm.Clt.CallResponseRaw.Output.HttpResponse.Event")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_GrpcResponse)(nil), "fm.Clt.CallResponseRaw.Output.GrpcResponse")
+	proto.RegisterMapType((map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)(nil), "fm.Clt.CallResponseRaw.Output.GrpcResponse.HeadersEntry")
+	proto.RegisterMapType((map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)(nil), "fm.Clt.CallResponseRaw.Output.GrpcResponse.TrailersEntry")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)(nil), "fm.Clt.CallResponseRaw.Output.GrpcResponse.MetadataValues")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_WebSocketResponse)(nil), "fm.Clt.CallResponseRaw.Output.WebSocketResponse")
+	proto.RegisterType((*Clt_CallResponseRaw_Output_WebSocketResponse_Message)(nil), "fm.Clt.CallResponseRaw.Output.WebSocketResponse.Message")
+	proto.RegisterType((*Clt_CallResponseRaw_LogLine)(nil), "fm.Clt.CallResponseRaw.LogLine")
+	proto.RegisterType((*Clt_CallVerifProgress)(nil), "fm.Clt.CallVerifProgress")
+	proto.RegisterType((*Srv)(nil), "fm.Srv")
+	proto.RegisterType((*Srv_FuzzingProgress)(nil), "fm.Srv.FuzzingProgress")
+	proto.RegisterType((*Srv_FuzzRep)(nil), "fm.Srv.FuzzRep")
+	proto.RegisterType((*Srv_Call)(nil), "fm.Srv.Call")
+	proto.RegisterType((*Srv_Call_Input)(nil), "fm.Srv.Call.Input")
+	proto.RegisterType((*Srv_Call_Input_HttpRequest)(nil), "fm.Srv.Call.Input.HttpRequest")
+	proto.RegisterMapType((map[string]*Srv_Call_Input_HttpRequest_HeaderValues)(nil), "fm.Srv.Call.Input.HttpRequest.HeadersEntry")
+	proto.RegisterType((*Srv_Call_Input_HttpRequest_HeaderValues)(nil), "fm.Srv.Call.Input.HttpRequest.HeaderValues")
+	proto.RegisterType((*Srv_Reset)(nil), "fm.Srv.Reset")
+	proto.RegisterType((*Srv_FuzzingResult)(nil), "fm.Srv.FuzzingResult")
+	proto.RegisterType((*Srv_FuzzingResult_CounterexampleItem)(nil), "fm.Srv.FuzzingResult.CounterexampleItem")
+	proto.RegisterType((*SpecIR)(nil), "fm.SpecIR")
+	proto.RegisterMapType((map[uint32]*Endpoint)(nil), "fm.SpecIR.EndpointsEntry")
+	proto.RegisterType((*Schemas)(nil), "fm.Schemas")
+	proto.RegisterMapType((map[uint32]*RefOrSchemaJSON)(nil), "fm.Schemas.JsonEntry")
+	proto.RegisterType((*RefOrSchemaJSON)(nil), "fm.RefOrSchemaJSON")
+	proto.RegisterType((*SchemaPtr)(nil), "fm.SchemaPtr")
+	proto.RegisterType((*Endpoint)(nil), "fm.Endpoint")
+	proto.RegisterType((*EndpointJSON)(nil), "fm.EndpointJSON")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "fm.EndpointJSON.OutputsEntry")
+	proto.RegisterType((*ParamJSON)(nil), "fm.ParamJSON")
+	proto.RegisterType((*PathPartial)(nil), "fm.PathPartial")
+	proto.RegisterType((*Schema)(nil), "fm.Schema")
+	proto.RegisterType((*Schema_JSON)(nil), "fm.Schema.JSON")
+	proto.RegisterMapType((map[string]uint32)(nil), "fm.Schema.JSON.PropertiesEntry")
+	proto.RegisterType((*Schema_JSON_AdditionalProperties)(nil), "fm.Schema.JSON.AdditionalProperties")
+}
+
+func init() { proto.RegisterFile("pkg/internal/fm/fuzzymonkey.proto", fileDescriptor_e8efc8eb0723ab5b) }
+
+var fileDescriptor_e8efc8eb0723ab5b = []byte{
+	// 5244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7b, 0xcb, 0x6f, 0x24, 0xc9,
+	0x71, 0x37, 0xfb, 0xdd, 0x1d, 0xcd, 0x26, 0x8b, 0x39, 0x9c, 0x99, 0x9e, 0xda, 0xdd, 0xd1, 0x88,
+	0xd2, 0xce, 0xce, 0xbe, 0x9a, 0x2b, 0xce, 0x68, 0xf6, 0xa1, 0xd7, 0xc7, 0xe1, 0x70, 0x97, 0x9c,
+	0x9d, 0x21, 0xa9, 0x6a, 0x8e, 0x16, 0xfa, 0x6c, 0xa0, 0x5d, 0xec, 0xce, 0xee, 0x2e, 0xb1, 0xba,
+	0xaa, 0x36, 0x2b, 0x9b, 0x64, 0x2f, 0x7c, 0x30, 0x7c, 0x15, 0x74, 0x32, 0xa0, 0x9b, 0x7c, 0xf1,
+	0xc5, 0x07, 0x03, 0xbe, 0x08, 0xb6, 0x01, 0x9f, 0x0c, 0x18, 0x86, 0x2f, 0x82, 0x05, 0x18, 0x06,
+	0x64, 0x5f, 0x2c, 0xef, 0xcd, 0x07, 0xfd, 0x01, 0x06, 0x7c, 0x30, 0x22, 0x32, 0xb3, 0xaa, 0xba,
+	0xf9, 0x18, 0x72, 0x64, 0x58, 0xa7, 0xae, 0x8c, 0xf8, 0x65, 0x64, 0x66, 0x64, 0x64, 0x44, 0x66,
+	0x64, 0x36, 0x7c, 0x35, 0x3a, 0x1c, 0xac, 0x7a, 0x81, 0xe4, 0x22, 0x70, 0xfd, 0xd5, 0xfe, 0x68,
+	0xb5, 0x3f, 0xfe, 0xe2, 0x8b, 0xc9, 0x28, 0x0c, 0x0e, 0xf9, 0xa4, 0x15, 0x89, 0x50, 0x86, 0x2c,
+	0xdf, 0x1f, 0xd9, 0xaf, 0x0e, 0xc2, 0x70, 0xe0, 0xf3, 0x55, 0xa2, 0x1c, 0x8c, 0xfb, 0xab, 0xb1,
+	0x14, 0xe3, 0xae, 0x54, 0x08, 0xfb, 0xdd, 0x81, 0x27, 0x87, 0xe3, 0x83, 0x56, 0x37, 0x1c, 0xad,
+	0x0e, 0xc2, 0x41, 0x98, 0xc2, 0xb0, 0x44, 0x05, 0xfa, 0x52, 0xf0, 0x95, 0x9f, 0xee, 0x41, 0x61,
+	0xc3, 0x97, 0x6c, 0x05, 0x8a, 0xd8, 0x5a, 0x33, 0x77, 0x27, 0x77, 0xaf, 0xbe, 0x36, 0xdf, 0xea,
+	0x8f, 0x5a, 0x1b, 0xbe, 0x6c, 0x7d, 0x3c, 0xfe, 0xe2, 0x8b, 0xad, 0x39, 0x87, 0x78, 0xec, 0xbb,
+	0xb0, 0x20, 0x78, 0xcc, 0x65, 0x27, 0x12, 0xe1, 0x40, 0xf0, 0x38, 0x6e, 0xe6, 0x09, 0x7d, 0xdd,
+	0xa0, 0x1d, 0xe4, 0xee, 0x69, 0xe6, 0xd6, 0x9c, 0xd3, 0x10, 0x59, 0x02, 0x7b, 0x04, 0x56, 0xd7,
+	0xf5, 0xfd, 0x8e, 0xe0, 0x9f, 0x8f, 0x79, 0x2c, 0x3b, 0xc2, 0x3d, 0x6e, 0x16, 0x48, 0xc2, 0x0d,
+	0x23, 0x61, 0xc3, 0xf5, 0x7d, 0x47, 0xb1, 0x1d, 0xf7, 0x78, 0x6b, 0xce, 0x59, 0xe8, 0x4e, 0x51,
+	0xd8, 0x26, 0x2c, 0x69, 0x19, 0x71, 0x14, 0x06, 0x31, 0x27, 0x21, 0x45, 0x12, 0x72, 0x73, 0x5a,
+	0x88, 0xe2, 0x2b, 0x29, 0x8b, 0xdd, 0x69, 0x12, 0xfb, 0x14, 0xae, 0x91, 0x98, 0x23, 0x2e, 0xbc,
+	0x7e, 0x3a, 0x9e, 0x12, 0x09, 0xba, 0x95, 0x15, 0xf4, 0x03, 0x44, 0x64, 0xc6, 0xb4, 0xd4, 0x9d,
+	0x25, 0xda, 0xbf, 0xf9, 0x1a, 0x14, 0x51, 0x51, 0xec, 0x1b, 0x50, 0xa5, 0x11, 0x4b, 0x2e, 0x9a,
+	0xb9, 0x69, 0xd5, 0x20, 0x5f, 0xe9, 0x47, 0x72, 0xe1, 0x24, 0x30, 0x76, 0x0f, 0x4a, 0xa3, 0xb0,
+	0xc7, 0x7d, 0xad, 0x4a, 0x36, 0x85, 0x7f, 0x86, 0x1c, 0x47, 0x01, 0xd8, 0x32, 0x94, 0xc6, 0xb1,
+	0x3b, 0xe0, 0xcd, 0xc2, 0x9d, 0xc2, 0xbd, 0x9a, 0xa3, 0x0a, 0x8c, 0x41, 0x31, 0xe6, 0xbc, 0x47,
+	0x2a, 0x98, 0x77, 0xe8, 0x9b, 0xd9, 0x50, 0x0d, 0x24, 0x0f, 0x62, 0x4f, 0x4e, 0x68, 0x44, 0x0d,
+	0x27, 0x29, 0x23, 0x7e, 0x73, 0xfb, 0x71, 0xdc, 0x2c, 0xdf, 0x29, 0xdc, 0x6b, 0x38, 0xf4, 0xcd,
+	0xde, 0x83, 0xb2, 0xef, 0x1e, 0x70, 0x3f, 0x6e, 0x56, 0xee, 0x14, 0xee, 0xd5, 0xd7, 0x9a, 0x53,
+	0x9d, 0x78, 0x4a, 0xac, 0xcd, 0x40, 0x8a, 0x89, 0xa3, 0x71, 0xec, 0x01, 0x54, 0x79, 0x70, 0xd4,
+	0x11, 0xdc, 0xed, 0x35, 0xab, 0x77, 0x0a, 0x59, 0x9d, 0x51, 0x9d, 0xcd, 0xe0, 0xc8, 0xe1, 0x6e,
+	0x4f, 0x55, 0xaa, 0x70, 0x55, 0xc2, 0x11, 0x3c, 0x7f, 0x8e, 0x8d, 0xd7, 0xd4, 0x08, 0xa8, 0xc0,
+	0xde, 0x85, 0x52, 0xdf, 0xf3, 0x79, 0xdc, 0x84, 0x3b, 0x85, 0xec, 0x2c, 0x92, 0xa0, 0x8f, 0x91,
+	0xa3, 0xc4, 0x28, 0x94, 0xfd, 0xb3, 0x3a, 0x54, 0x8d, 0x1e, 0xd9, 0x7d, 0x28, 0xc5, 0x43, 0xee,
+	0xfb, 0x5a, 0xdb, 0xaf, 0x9c, 0xa9, 0xed, 0x56, 0x1b, 0x21, 0x5b, 0x73, 0x8e, 0xc2, 0xb2, 0x0f,
+	0xa1, 0x12, 0x89, 0xb0, 0x9b, 0xda, 0xef, 0x6b, 0x67, 0x57, 0xdb, 0x53, 0xa0, 0xad, 0x39, 0xc7,
+	0xe0, 0xd9, 0x7b, 0x50, 0x1c, 0x4a, 0x19, 0x69, 0xab, 0xb5, 0xcf, 0xae, 0xb7, 0xb5, 0xbf, 0xbf,
+	0x87, 0x6b, 0x06, 0x91, 0xec, 0xdb, 0x50, 0x8d, 0xa5, 0x2b, 0x7c, 0x57, 0x1c, 0x6a, 0x33, 0xbd,
+	0x7d, 0x4e, 0x27, 0x35, 0x6a, 0x6b, 0xce, 0x49, 0x6a, 0x50, 0xed, 0xc0, 0x8d, 0xe2, 0x61, 0x28,
+	0x9b, 0xa5, 0x0b, 0x6b, 0x6b, 0x14, 0xd5, 0xd6, 0xdf, 0x54, 0x1b, 0x57, 0x4e, 0xd0, 0xe5, 0xcd,
+	0xf2, 0x85, 0xb5, 0x35, 0x8a, 0x6a, 0xeb, 0x6f, 0xfb, 0x9f, 0x73, 0x50, 0x22, 0xcd, 0xe1, 0xbc,
+	0x61, 0x8f, 0x24, 0x69, 0xb9, 0xe6, 0xa8, 0x02, 0xb3, 0xa0, 0x20, 0x62, 0x49, 0x2a, 0xac, 0x39,
+	0xf8, 0x49, 0xb6, 0x28, 0x43, 0xa5, 0x9d, 0x9a, 0x43, 0xdf, 0x54, 0x97, 0x66, 0xa8, 0xa8, 0xeb,
+	0x92, 0xc4, 0xbb, 0xb0, 0xc8, 0x4f, 0x78, 0xb7, 0x23, 0xbd, 0x11, 0x0f, 0xc7, 0xb2, 0x13, 0xa8,
+	0xa5, 0x57, 0x70, 0x1a, 0x48, 0xde, 0x57, 0xd4, 0x9d, 0x98, 0x7d, 0x13, 0x6e, 0xa2, 0x9d, 0x99,
+	0x11, 0x65, 0xf1, 0x65, 0xc2, 0x2f, 0xf3, 0xe0, 0xc8, 0x8c, 0x3d, 0xad, 0x76, 0x0b, 0xaa, 0x7e,
+	0x38, 0xe8, 0xa0, 0xc1, 0x34, 0x2b, 0xd4, 0x6e, 0xc5, 0x0f, 0x07, 0x68, 0x4b, 0xf6, 0xdf, 0xe4,
+	0xa1, 0xa2, 0x27, 0x16, 0x47, 0xd0, 0x1d, 0xf5, 0x9a, 0x39, 0xb2, 0x46, 0xfc, 0x64, 0x1f, 0x40,
+	0x81, 0x07, 0x47, 0xcd, 0x3c, 0x59, 0xe2, 0xdd, 0x0b, 0xcd, 0x02, 0x6d, 0x5c, 0x19, 0x26, 0x56,
+	0x61, 0xaf, 0x01, 0xe0, 0x6a, 0x98, 0x74, 0x12, 0xfb, 0xa8, 0x39, 0x35, 0xa2, 0x6c, 0xa1, 0x19,
+	0xbc, 0x02, 0xaa, 0xd0, 0x91, 0xdd, 0x48, 0xab, 0xa2, 0x4a, 0x84, 0xfd, 0x6e, 0xc4, 0xee, 0x81,
+	0xa5, 0x99, 0xb3, 0xea, 0x58, 0x50, 0x98, 0x64, 0x60, 0x84, 0x24, 0xf5, 0x77, 0xc2, 0xa0, 0x43,
+	0x4e, 0x84, 0x14, 0x51, 0x75, 0x16, 0x34, 0x7d, 0x37, 0xa0, 0x8e, 0x5e, 0xa4, 0x82, 0x87, 0x50,
+	0x35, 0x7d, 0x47, 0x15, 0x1c, 0xf2, 0x89, 0x9e, 0x58, 0xfc, 0xc4, 0x09, 0x3b, 0x72, 0xfd, 0x31,
+	0xd7, 0x13, 0xab, 0x0a, 0x1f, 0xe5, 0x3f, 0xc8, 0xd9, 0x3f, 0xce, 0x43, 0x11, 0x6d, 0x9b, 0xdd,
+	0x80, 0xf2, 0x88, 0xcb, 0x61, 0xd8, 0xd3, 0xf5, 0x74, 0x09, 0x85, 0x8d, 0x85, 0x6f, 0x2c, 0x62,
+	0x2c, 0x7c, 0xb6, 0x0e, 0x95, 0x21, 0x77, 0x7b, 0x5c, 0xc4, 0xe4, 0xb5, 0xea, 0x6b, 0x6f, 0x9c,
+	0xbf, 0x64, 0x5a, 0x5b, 0x0a, 0xa9, 0x9d, 0x86, 0xae, 0x87, 0x46, 0x75, 0x10, 0xf6, 0x26, 0x5a,
+	0x69, 0xf4, 0xcd, 0xde, 0x40, 0xf3, 0x89, 0x78, 0x57, 0xf2, 0x5e, 0x27, 0x96, 0xae, 0x1c, 0xc7,
+	0xda, 0xcf, 0x2d, 0x18, 0x72, 0x9b, 0xa8, 0x38, 0x2b, 0xa7, 0x4c, 0xa6, 0x26, 0x8d, 0x3a, 0xed,
+	0x8f, 0x60, 0x3e, 0xdb, 0xe8, 0x95, 0xb4, 0x11, 0x40, 0xd5, 0x2c, 0xd9, 0xdf, 0x6a, 0x81, 0x9c,
+	0xb1, 0x14, 0x8a, 0x67, 0x2c, 0x05, 0xfb, 0x87, 0x50, 0x35, 0x86, 0x8e, 0xed, 0x45, 0xae, 0x1c,
+	0xc6, 0xda, 0x74, 0x55, 0x81, 0xbd, 0x7f, 0x35, 0xbf, 0x96, 0x78, 0x35, 0xfb, 0x7b, 0x50, 0x35,
+	0x1e, 0x80, 0xdd, 0x47, 0x43, 0x55, 0x40, 0x25, 0xfe, 0xdc, 0x18, 0x96, 0xe2, 0x1e, 0x41, 0x1a,
+	0xf7, 0xec, 0xbf, 0x5c, 0x86, 0x12, 0xc5, 0x2d, 0xf6, 0x6d, 0xa8, 0x85, 0x11, 0x0f, 0xdc, 0xc8,
+	0x3b, 0xba, 0xaf, 0x1d, 0xf4, 0xab, 0xa7, 0xc3, 0x5b, 0x6b, 0x37, 0xe2, 0xc1, 0xfa, 0xde, 0xf6,
+	0xd1, 0xfd, 0xad, 0x39, 0x27, 0xad, 0xc0, 0x1e, 0x42, 0x65, 0x20, 0xdc, 0x68, 0xf8, 0xb9, 0x09,
+	0x8d, 0xf6, 0x19, 0x75, 0x3f, 0x41, 0xc4, 0xf7, 0x9f, 0xa2, 0x8b, 0xd6, 0x60, 0xf6, 0x2e, 0x14,
+	0x07, 0x22, 0xea, 0x6a, 0x17, 0x7d, 0xf3, 0xac, 0x4a, 0xce, 0xde, 0x06, 0xfa, 0x67, 0x84, 0xb1,
+	0x0f, 0xa1, 0xea, 0xc6, 0x93, 0xa0, 0xeb, 0x46, 0x5e, 0xb3, 0x78, 0x46, 0x10, 0x51, 0x55, 0xd6,
+	0x11, 0xb2, 0xbe, 0xb7, 0x8d, 0x0e, 0xd2, 0xc0, 0xb1, 0x87, 0xd8, 0x5d, 0x6c, 0xac, 0x74, 0x6e,
+	0x0f, 0x71, 0x74, 0xaa, 0x3d, 0x03, 0xb6, 0x7f, 0x31, 0x0f, 0xb5, 0x64, 0xd0, 0x68, 0x13, 0xb4,
+	0x48, 0x95, 0xe9, 0xd0, 0x37, 0xd2, 0x86, 0x61, 0x62, 0x3a, 0xf4, 0xcd, 0xbe, 0x01, 0xcb, 0x6a,
+	0x49, 0x74, 0xdc, 0xb1, 0x1c, 0x86, 0xc2, 0xfb, 0xc2, 0x95, 0x5e, 0x18, 0x68, 0x5b, 0xba, 0xa6,
+	0x78, 0xeb, 0x59, 0x16, 0xbb, 0x0d, 0xc5, 0x38, 0xe2, 0x5d, 0x3d, 0x2e, 0xc0, 0xde, 0xb5, 0x23,
+	0xde, 0xdd, 0x76, 0x1c, 0xa2, 0x93, 0x19, 0x89, 0xf0, 0x44, 0x6d, 0x12, 0x6a, 0x8e, 0x2a, 0xb0,
+	0xdb, 0x50, 0x97, 0x7e, 0xdc, 0xe9, 0xba, 0xca, 0x79, 0x94, 0x95, 0x2b, 0x93, 0x7e, 0xbc, 0xe1,
+	0xa2, 0xfb, 0x60, 0x2b, 0xd0, 0x20, 0x3e, 0x17, 0x32, 0xeb, 0x5e, 0xb0, 0xd2, 0x06, 0x17, 0x92,
+	0x30, 0x77, 0x60, 0x1e, 0x31, 0x87, 0x7c, 0xa2, 0x20, 0x55, 0x82, 0x80, 0xf4, 0xe3, 0x4f, 0xf9,
+	0x84, 0x10, 0xef, 0x43, 0x13, 0x11, 0x5e, 0x10, 0xf3, 0xee, 0x58, 0xf0, 0x4e, 0x7c, 0xe8, 0x45,
+	0x6a, 0x37, 0x36, 0x69, 0xd6, 0xc8, 0xa3, 0x5d, 0x97, 0x7e, 0xbc, 0xad, 0xd9, 0xed, 0x43, 0x2f,
+	0xa2, 0x3d, 0xd7, 0x04, 0xd7, 0x0b, 0x56, 0x8c, 0xb9, 0x38, 0xe2, 0xa2, 0x13, 0xb8, 0x23, 0xde,
+	0x04, 0x92, 0x8e, 0xbd, 0x6a, 0x13, 0x75, 0xc7, 0x1d, 0x71, 0x1c, 0x1c, 0xba, 0xe2, 0xb5, 0x66,
+	0x9d, 0xa4, 0xa9, 0x02, 0x7b, 0x1b, 0xd8, 0xc8, 0x3d, 0xe9, 0x74, 0xc3, 0x20, 0x88, 0x3b, 0x11,
+	0x17, 0x1d, 0xd2, 0xf3, 0x3c, 0x39, 0x8f, 0xc5, 0x91, 0x7b, 0xb2, 0x81, 0x8c, 0x3d, 0x2e, 0xb6,
+	0x50, 0xe5, 0x0f, 0xe0, 0x26, 0x82, 0xbd, 0x9e, 0xcf, 0x67, 0x6b, 0x34, 0xa8, 0xc6, 0xb5, 0x91,
+	0x7b, 0xb2, 0xdd, 0xf3, 0xf9, 0x54, 0xad, 0x6f, 0x81, 0xdd, 0x17, 0x3c, 0x1e, 0x52, 0x15, 0xde,
+	0xc5, 0x99, 0x50, 0x15, 0x25, 0x8f, 0x65, 0x73, 0x81, 0x7a, 0x73, 0x93, 0x10, 0x1b, 0x29, 0x60,
+	0x8f, 0x8b, 0x7d, 0x1e, 0x4b, 0xf6, 0x0e, 0x30, 0xb3, 0x3b, 0xce, 0x38, 0x84, 0x45, 0x72, 0x08,
+	0x96, 0xe6, 0xa4, 0xe1, 0xe0, 0x2e, 0x2c, 0x62, 0x80, 0xc8, 0x42, 0x2d, 0xe5, 0x3b, 0x90, 0x9c,
+	0xe2, 0xde, 0x51, 0xa3, 0x4e, 0xf6, 0xcc, 0x07, 0x13, 0xc9, 0xe3, 0xe6, 0xd2, 0x9d, 0xdc, 0xbd,
+	0xa2, 0x63, 0x8d, 0xdc, 0x13, 0xb3, 0x33, 0x7e, 0x84, 0x74, 0x74, 0x9a, 0xdd, 0x30, 0x3c, 0xf4,
+	0x78, 0xe7, 0x47, 0xae, 0x68, 0x32, 0xea, 0x70, 0x4d, 0x51, 0x9e, 0xb8, 0x02, 0x85, 0xc5, 0x52,
+	0x70, 0x77, 0xd4, 0xe9, 0x8d, 0x05, 0x19, 0x1a, 0xb6, 0x7b, 0x4d, 0x75, 0x51, 0x71, 0x1e, 0x6b,
+	0xc6, 0x4e, 0xcc, 0x56, 0x61, 0x99, 0x14, 0xee, 0xfa, 0xbe, 0xd2, 0x42, 0xcc, 0xbb, 0x61, 0xd0,
+	0x6b, 0x2e, 0x93, 0x02, 0x97, 0x50, 0xe5, 0xc8, 0xda, 0xe3, 0xa2, 0x4d, 0x0c, 0xf6, 0x16, 0x2c,
+	0x0d, 0xc3, 0x20, 0x14, 0x1d, 0xc1, 0xa5, 0x98, 0x74, 0xdc, 0x3e, 0x6e, 0xa6, 0xaf, 0x53, 0x27,
+	0x16, 0x89, 0xe1, 0x20, 0x7d, 0x1d, 0xc9, 0xec, 0x4d, 0x58, 0x52, 0xe3, 0x42, 0xe4, 0xb1, 0xeb,
+	0x91, 0x06, 0x6e, 0xa8, 0xc8, 0x49, 0xc3, 0x92, 0x62, 0xf2, 0x99, 0xeb, 0xa1, 0x0a, 0x6e, 0x40,
+	0x39, 0xf6, 0x06, 0x01, 0x17, 0xcd, 0x9b, 0x2a, 0x66, 0xa9, 0x12, 0xdb, 0x48, 0x23, 0x54, 0x93,
+	0xbc, 0xdd, 0x9b, 0x17, 0xb9, 0xa8, 0x73, 0x62, 0xd4, 0x77, 0xa0, 0xf4, 0xf9, 0x98, 0x8b, 0x49,
+	0xf3, 0xd6, 0x19, 0x41, 0x6e, 0x56, 0xc4, 0xf7, 0x11, 0xa9, 0xb7, 0xb4, 0x54, 0x8b, 0x6d, 0x43,
+	0x2d, 0x3c, 0xe2, 0x42, 0x78, 0x3d, 0x1e, 0x37, 0x6d, 0x12, 0xf1, 0xf6, 0x85, 0x22, 0x76, 0x0d,
+	0x5a, 0x89, 0x49, 0x6b, 0xff, 0x56, 0x11, 0xed, 0x03, 0x80, 0xb4, 0x6f, 0x57, 0xaa, 0xf9, 0xd3,
+	0x3c, 0x54, 0x4d, 0x9f, 0xd8, 0xd3, 0x54, 0xa3, 0x2a, 0x7e, 0xac, 0x5d, 0x6a, 0x2c, 0xe7, 0xa8,
+	0xf6, 0x63, 0xa3, 0x5a, 0xb5, 0x27, 0x7b, 0xef, 0x72, 0xb2, 0x4e, 0xe9, 0xf8, 0x77, 0xa4, 0x98,
+	0x2e, 0x2c, 0x4c, 0xcf, 0xd5, 0x19, 0xb5, 0xbf, 0x95, 0xad, 0x5d, 0x5f, 0x7b, 0xfd, 0x52, 0x23,
+	0xcc, 0x36, 0x12, 0x42, 0x45, 0xc7, 0x41, 0xb2, 0xf2, 0xee, 0x90, 0x8f, 0x5c, 0xb3, 0x33, 0x53,
+	0x25, 0x3c, 0x11, 0xf2, 0xa0, 0x17, 0x85, 0x5e, 0x60, 0x82, 0x4a, 0x52, 0x9e, 0x39, 0xd3, 0x5c,
+	0x18, 0xa1, 0xd5, 0x99, 0xc6, 0xfe, 0xf7, 0x1c, 0x14, 0x31, 0x88, 0xb2, 0xaf, 0x40, 0x9d, 0xb2,
+	0x08, 0x1d, 0x75, 0x80, 0x53, 0xbb, 0x11, 0x20, 0x12, 0x1d, 0xdc, 0xb0, 0x3f, 0xd2, 0x15, 0x03,
+	0x6e, 0x5a, 0xd5, 0x25, 0xb6, 0x0e, 0xd5, 0x11, 0x97, 0x6e, 0xcf, 0x95, 0xae, 0xde, 0x18, 0xbe,
+	0x7e, 0x4e, 0xa0, 0x6e, 0x3d, 0xd3, 0x38, 0x35, 0x9b, 0x49, 0xb5, 0x17, 0x05, 0x37, 0xfb, 0x5b,
+	0xd0, 0x98, 0xaa, 0x7a, 0xa5, 0x79, 0xfb, 0xef, 0x1c, 0x54, 0x4d, 0xcc, 0x3f, 0x33, 0x42, 0x9f,
+	0xde, 0xea, 0xbe, 0x05, 0x4b, 0x82, 0x77, 0xb9, 0x77, 0xc4, 0x3b, 0xc7, 0x5e, 0xd0, 0x0b, 0x8f,
+	0xd1, 0x17, 0x15, 0xc8, 0x17, 0x2d, 0x6a, 0xc6, 0x67, 0x44, 0xdf, 0xc1, 0x44, 0x48, 0xb2, 0x44,
+	0x8a, 0x34, 0xfa, 0x7b, 0x17, 0xec, 0x39, 0xce, 0x59, 0x18, 0x66, 0xfc, 0xa5, 0x73, 0xc6, 0xff,
+	0xdb, 0x18, 0x7c, 0x17, 0x2a, 0x7a, 0xdf, 0x72, 0xe9, 0xed, 0xc9, 0x95, 0xad, 0xe8, 0x51, 0x45,
+	0x67, 0x3e, 0xec, 0x0f, 0xa1, 0x9e, 0xc9, 0x31, 0x5c, 0xa9, 0xa3, 0x1f, 0xc1, 0x7c, 0x36, 0xd5,
+	0x70, 0xd5, 0x55, 0x9d, 0x66, 0x17, 0xae, 0x54, 0xf3, 0xe7, 0x39, 0x68, 0x4c, 0xa5, 0xba, 0xd8,
+	0x03, 0x28, 0xeb, 0x73, 0x08, 0x0a, 0x58, 0x48, 0xc7, 0x3f, 0x05, 0x6b, 0xa9, 0x53, 0x89, 0xa3,
+	0xb1, 0x18, 0x68, 0xb9, 0xef, 0x46, 0x31, 0xef, 0xa1, 0xad, 0xe4, 0xd5, 0xe9, 0x44, 0x53, 0x54,
+	0xc8, 0x12, 0xdc, 0x8d, 0x69, 0x8f, 0x87, 0x0b, 0x4b, 0x97, 0x56, 0x1e, 0x42, 0x59, 0x09, 0x62,
+	0x55, 0x28, 0xee, 0xec, 0xee, 0xee, 0x59, 0x73, 0xac, 0x0e, 0x15, 0x3a, 0x74, 0xf0, 0x9e, 0x95,
+	0x63, 0x35, 0x28, 0xf1, 0xa0, 0xc7, 0x7b, 0x56, 0x9e, 0x01, 0x94, 0xfb, 0xae, 0xe7, 0xf3, 0x9e,
+	0x55, 0xb0, 0xff, 0xba, 0x0e, 0x0b, 0xd3, 0xf9, 0x35, 0xb6, 0x06, 0x25, 0x2f, 0x88, 0xc6, 0x72,
+	0x76, 0x7b, 0x3e, 0x0d, 0x6b, 0x6d, 0x23, 0xc6, 0x51, 0xd0, 0x4c, 0xb7, 0xf2, 0xd9, 0x6e, 0xd9,
+	0xbf, 0x02, 0x28, 0x11, 0x90, 0x3d, 0x83, 0x79, 0x9c, 0x61, 0x93, 0xe7, 0xd3, 0xc2, 0xef, 0x5d,
+	0x24, 0xbc, 0x85, 0xa7, 0x64, 0x4d, 0xdc, 0x9a, 0x73, 0xea, 0xc3, 0xb4, 0x88, 0xe2, 0x70, 0xab,
+	0x9e, 0x88, 0xcb, 0x5f, 0x42, 0xdc, 0x27, 0x22, 0xea, 0x66, 0xc4, 0x0d, 0xd2, 0x22, 0xfb, 0x7d,
+	0x58, 0x3a, 0xe6, 0x07, 0x71, 0xd8, 0x3d, 0xe4, 0x32, 0x91, 0xa9, 0xcc, 0xf6, 0xdd, 0x0b, 0x65,
+	0x7e, 0xc6, 0x0f, 0xda, 0x54, 0x2b, 0x15, 0x6c, 0x25, 0x92, 0x34, 0xcd, 0xfe, 0xab, 0x22, 0xd4,
+	0x33, 0x63, 0xb9, 0xc2, 0x59, 0x79, 0x77, 0xf6, 0xac, 0xfc, 0xcd, 0xcb, 0x2a, 0xec, 0x12, 0x27,
+	0xe7, 0x79, 0x7d, 0x72, 0xfe, 0x10, 0xe6, 0xf1, 0xb7, 0xd3, 0xe3, 0xdd, 0xb0, 0xc7, 0x7b, 0xda,
+	0x7b, 0xdc, 0x68, 0xa9, 0x94, 0x72, 0xcb, 0xe4, 0x8a, 0x5b, 0x3f, 0x40, 0x63, 0x77, 0xea, 0x88,
+	0x7d, 0xac, 0xa0, 0xe8, 0xec, 0xc7, 0x81, 0x77, 0xd2, 0x51, 0xe3, 0xd5, 0xe7, 0x02, 0x40, 0x92,
+	0xd2, 0x0a, 0x7b, 0x92, 0x9e, 0xd8, 0x2a, 0x77, 0x72, 0xd9, 0x60, 0xfd, 0xc2, 0x01, 0xe8, 0xf8,
+	0x95, 0x9c, 0xe2, 0xec, 0xbb, 0xc6, 0x7b, 0x51, 0x47, 0x68, 0x2d, 0xd0, 0xfa, 0x33, 0x41, 0x46,
+	0x97, 0xec, 0xcf, 0x5f, 0xe8, 0xe5, 0x3e, 0x9d, 0x0e, 0xaf, 0x57, 0x55, 0xaa, 0x6a, 0x3f, 0xbb,
+	0xfa, 0x9f, 0xa7, 0xe1, 0x76, 0xd9, 0x6c, 0x4e, 0xf4, 0xb9, 0x9f, 0x0a, 0xec, 0x01, 0xd4, 0x8e,
+	0x5c, 0xe1, 0xb9, 0x07, 0x18, 0x13, 0xf3, 0x17, 0x2a, 0x38, 0x05, 0xda, 0x7f, 0x54, 0x80, 0x7a,
+	0xc6, 0x6a, 0x33, 0xa1, 0x33, 0x37, 0x15, 0x3a, 0x53, 0x83, 0xca, 0x4f, 0x19, 0x94, 0x73, 0x2a,
+	0xa4, 0x3e, 0xbc, 0xec, 0x0a, 0x39, 0x37, 0xc6, 0xfe, 0xef, 0x5a, 0x90, 0x7d, 0x0f, 0x16, 0x4c,
+	0x4b, 0x2f, 0x98, 0x56, 0xf9, 0xe2, 0xe0, 0xfd, 0x6c, 0x7a, 0x5e, 0xdf, 0xbf, 0xf2, 0x60, 0x4f,
+	0xcf, 0xec, 0x4f, 0x72, 0x60, 0xcd, 0x2e, 0x72, 0xb3, 0x50, 0x73, 0xe9, 0x42, 0x6d, 0x42, 0xa5,
+	0x3b, 0x74, 0x83, 0x80, 0x9b, 0xe5, 0x6b, 0x8a, 0x89, 0xbe, 0x0a, 0x17, 0xe8, 0xab, 0x78, 0x69,
+	0x7d, 0x61, 0x84, 0x24, 0x97, 0x6b, 0xff, 0xdb, 0x2d, 0x58, 0x9c, 0xb9, 0xd4, 0x60, 0x0f, 0xa1,
+	0x1c, 0x8e, 0x65, 0xea, 0xbb, 0x6f, 0x9f, 0x73, 0xfb, 0xd1, 0xda, 0x25, 0x94, 0xa3, 0xd1, 0xb8,
+	0x15, 0x54, 0x5f, 0xdb, 0xca, 0x82, 0x1a, 0x4e, 0x52, 0x66, 0xf7, 0xa1, 0xe8, 0x87, 0x03, 0xe3,
+	0x7f, 0xbe, 0x72, 0x9e, 0xc4, 0xa7, 0xe1, 0xe0, 0xa9, 0x17, 0x70, 0x87, 0xc0, 0xf6, 0x7f, 0xde,
+	0x84, 0xb2, 0x6a, 0x83, 0x39, 0xd0, 0xd0, 0x8e, 0x5f, 0x81, 0x75, 0xd7, 0xde, 0xbe, 0xb8, 0x6b,
+	0x7a, 0xd5, 0x29, 0xf2, 0xd6, 0x9c, 0x33, 0x3f, 0xcc, 0x94, 0x51, 0xa6, 0xf6, 0xfe, 0x5a, 0x66,
+	0xfe, 0x52, 0x32, 0xd5, 0x8c, 0xa7, 0x32, 0x07, 0x99, 0x32, 0x73, 0x81, 0x65, 0x43, 0x80, 0x16,
+	0x5c, 0x38, 0xcb, 0x69, 0x9d, 0x12, 0x9c, 0x31, 0x90, 0x44, 0xfa, 0x52, 0x26, 0x0c, 0x28, 0xa2,
+	0xfd, 0x5f, 0x0d, 0x98, 0xcf, 0x8e, 0x0b, 0x7d, 0x05, 0x17, 0x22, 0x14, 0xc6, 0x57, 0x50, 0x01,
+	0x9d, 0xaa, 0xda, 0x0c, 0x74, 0x70, 0xca, 0xf5, 0x84, 0x80, 0x22, 0x6d, 0x84, 0x3d, 0x3e, 0xb5,
+	0x09, 0xc8, 0xa5, 0xd1, 0x96, 0x39, 0xb3, 0x5b, 0xc8, 0x0f, 0xae, 0xa0, 0xe4, 0x17, 0x04, 0x8c,
+	0xd2, 0x05, 0xe6, 0x5b, 0xbe, 0x7c, 0xc0, 0x98, 0xde, 0xde, 0x54, 0x66, 0xb7, 0x37, 0xcf, 0xa0,
+	0x22, 0xbd, 0x91, 0x17, 0x0c, 0x62, 0x4a, 0x0f, 0xd5, 0xd7, 0xee, 0x5f, 0x65, 0x04, 0xfb, 0xaa,
+	0xaa, 0x63, 0x64, 0xb0, 0x1d, 0xa8, 0x0c, 0xbd, 0x58, 0x86, 0x62, 0x42, 0xd7, 0x4b, 0xf5, 0xb5,
+	0x07, 0x57, 0x11, 0xe7, 0xf0, 0x9e, 0x27, 0x78, 0x57, 0x3a, 0x46, 0x08, 0xfb, 0x01, 0x66, 0x41,
+	0x4c, 0x7e, 0x86, 0x52, 0x4c, 0xa7, 0x3c, 0xea, 0xc5, 0x22, 0xd3, 0xec, 0x8e, 0x93, 0x91, 0xc4,
+	0xb6, 0xa1, 0xcc, 0x8f, 0x78, 0x20, 0xe3, 0x66, 0x9d, 0xba, 0xf9, 0x8d, 0xab, 0xc8, 0xdc, 0xc4,
+	0x9a, 0x8e, 0x16, 0x80, 0x0a, 0xd6, 0x99, 0x98, 0xee, 0x58, 0x25, 0xb1, 0xaa, 0x4e, 0x4d, 0x51,
+	0x36, 0xc6, 0xf2, 0xd2, 0x31, 0x54, 0xbe, 0x30, 0x86, 0xee, 0x4c, 0xfb, 0xda, 0x97, 0x30, 0xb5,
+	0xd3, 0xce, 0xf6, 0xcf, 0x72, 0x50, 0xd1, 0x93, 0xc8, 0xae, 0x43, 0xb9, 0x17, 0xc4, 0x68, 0x25,
+	0x39, 0xb2, 0x92, 0x52, 0x2f, 0x88, 0x77, 0x74, 0x22, 0x8a, 0x14, 0x97, 0xd9, 0x1f, 0x6b, 0x8a,
+	0xba, 0x0c, 0xc1, 0x4c, 0xe0, 0xd0, 0x0d, 0x7a, 0xf1, 0xd0, 0x3d, 0xe4, 0xe9, 0x81, 0x6b, 0x41,
+	0xfa, 0xf1, 0x96, 0x21, 0xef, 0xc4, 0xec, 0x26, 0x54, 0xa4, 0xec, 0x1f, 0xa4, 0xb9, 0xf5, 0x32,
+	0x16, 0x77, 0x62, 0x5c, 0x7e, 0x52, 0xb8, 0x41, 0xdc, 0xc7, 0x54, 0xa2, 0xb9, 0x74, 0x01, 0x43,
+	0xda, 0x89, 0xed, 0xbf, 0xcf, 0x43, 0xd5, 0xd8, 0xc6, 0x19, 0xa1, 0xe0, 0xa5, 0x97, 0xaf, 0x8d,
+	0xd7, 0x33, 0x5d, 0x95, 0xc1, 0xd5, 0xd7, 0x41, 0xa6, 0xcc, 0x7e, 0x2f, 0x5d, 0xda, 0x25, 0x32,
+	0x91, 0xf5, 0x97, 0xb1, 0xe4, 0xb3, 0xd7, 0xf8, 0xef, 0x68, 0xb2, 0x7f, 0x93, 0x07, 0x48, 0xd7,
+	0x83, 0xba, 0x0d, 0x1b, 0x85, 0x92, 0x77, 0xbc, 0x48, 0x37, 0x5d, 0x55, 0x84, 0xed, 0x08, 0x75,
+	0xaa, 0x99, 0x51, 0x28, 0xa4, 0xd1, 0xa9, 0x22, 0xed, 0x85, 0x42, 0x5f, 0x6d, 0x75, 0x5d, 0x1f,
+	0x2b, 0x17, 0xcc, 0xd5, 0x56, 0xd7, 0xf5, 0xb7, 0x23, 0xb4, 0x18, 0xc5, 0xa2, 0xaa, 0x45, 0xaa,
+	0x5a, 0x23, 0x0a, 0xd5, 0xb4, 0xa1, 0x4a, 0x0e, 0xab, 0x1b, 0xfa, 0x3a, 0xe7, 0x9d, 0x94, 0xd5,
+	0x4c, 0x8d, 0x63, 0xed, 0xe2, 0xaa, 0x8e, 0x2e, 0xb1, 0xa7, 0x50, 0x90, 0x7e, 0xac, 0x77, 0xb4,
+	0x1f, 0xbd, 0x9c, 0x03, 0x68, 0xed, 0x3f, 0x6d, 0x3b, 0x28, 0xc6, 0xe6, 0x50, 0xd8, 0x7f, 0xda,
+	0xc6, 0x2d, 0xc4, 0x11, 0x17, 0x31, 0xce, 0xbe, 0x1a, 0xbe, 0x29, 0xb2, 0xaf, 0xc2, 0x7c, 0xd7,
+	0x8b, 0x86, 0x98, 0x28, 0x1d, 0x7b, 0xd2, 0x1c, 0x3e, 0xeb, 0x8a, 0xd6, 0x46, 0x12, 0x42, 0x22,
+	0x4e, 0x80, 0x83, 0x1f, 0xf1, 0xae, 0xd4, 0x3a, 0xa8, 0x23, 0xad, 0xad, 0x48, 0xf6, 0x1f, 0x42,
+	0x89, 0x5c, 0x05, 0x5b, 0x80, 0xbc, 0x67, 0x8e, 0x1e, 0x79, 0x8f, 0xae, 0xe0, 0xc9, 0x79, 0x98,
+	0x43, 0x2d, 0x15, 0xd0, 0xf1, 0xeb, 0x7d, 0x23, 0x12, 0xe9, 0x1b, 0x1d, 0x3f, 0xfe, 0x5e, 0x76,
+	0xdf, 0x82, 0x58, 0xb3, 0xcf, 0xfb, 0xd3, 0x12, 0xcc, 0x67, 0xe3, 0xef, 0x39, 0xb1, 0x8f, 0x41,
+	0x31, 0xb3, 0x6a, 0xe8, 0x1b, 0x67, 0x41, 0x1f, 0xa4, 0xf5, 0x7a, 0x51, 0x25, 0x54, 0xd8, 0x88,
+	0xc7, 0xf4, 0xfc, 0x41, 0x2d, 0x17, 0x53, 0xcc, 0x06, 0xc2, 0xd2, 0xa5, 0x02, 0x61, 0xb6, 0x67,
+	0xe7, 0x04, 0xc2, 0xe7, 0x50, 0x95, 0x02, 0x8f, 0xcd, 0x42, 0x3d, 0x94, 0xa8, 0xaf, 0x7d, 0x78,
+	0x15, 0xa1, 0xfb, 0xba, 0xae, 0xde, 0x4e, 0x1b, 0x51, 0x49, 0x7c, 0xad, 0x5c, 0x10, 0x5f, 0xab,
+	0x2f, 0x1b, 0x5f, 0x6b, 0x33, 0xf1, 0xf5, 0x0a, 0xbb, 0xed, 0xa3, 0x17, 0xfa, 0x84, 0xbd, 0x69,
+	0x9f, 0xf0, 0xd1, 0x55, 0xb4, 0x71, 0xfe, 0x7e, 0xfb, 0x18, 0x1a, 0x53, 0xaa, 0xfa, 0x3f, 0x6b,
+	0xf8, 0x2f, 0xf2, 0xb0, 0x74, 0x6a, 0x1f, 0x77, 0x8e, 0x95, 0xee, 0x43, 0x55, 0x9b, 0x5a, 0xdc,
+	0xcc, 0x5f, 0xca, 0xc0, 0x4e, 0x49, 0x6e, 0x3d, 0x53, 0x02, 0x9c, 0x44, 0xd2, 0xcc, 0xdc, 0x15,
+	0x66, 0xe7, 0xee, 0xc7, 0x39, 0xa8, 0xe8, 0x4a, 0xea, 0x85, 0x4f, 0xa0, 0xb6, 0xf9, 0x55, 0x87,
+	0xbe, 0x93, 0x05, 0x9b, 0x57, 0x96, 0x74, 0xe6, 0x82, 0x2d, 0x5c, 0x7a, 0xc1, 0xce, 0xf4, 0xa6,
+	0x38, 0xd3, 0x9b, 0x47, 0x55, 0x73, 0xd4, 0xb0, 0x1d, 0xa8, 0xe8, 0xcd, 0x3f, 0xad, 0xd4, 0x70,
+	0x2c, 0xba, 0x3c, 0x49, 0x35, 0x53, 0x09, 0xbb, 0xe6, 0x7b, 0x81, 0x71, 0x5c, 0xf4, 0x8d, 0xfe,
+	0x3e, 0xec, 0xf7, 0x63, 0x2e, 0xd3, 0xc1, 0x56, 0x15, 0x61, 0x27, 0xb6, 0x7f, 0x52, 0x80, 0xa5,
+	0x53, 0x0f, 0xad, 0x50, 0x0c, 0xdd, 0xed, 0xe9, 0xbc, 0x23, 0x7e, 0xb3, 0x0f, 0x12, 0xe7, 0x90,
+	0xa7, 0x2c, 0xdb, 0x9d, 0x73, 0xdf, 0x69, 0xcd, 0x66, 0xda, 0x3e, 0x80, 0x72, 0x28, 0xbc, 0x81,
+	0xa7, 0xc2, 0xf0, 0x85, 0x35, 0x77, 0x09, 0xe7, 0x68, 0x7c, 0x26, 0x80, 0x17, 0xb3, 0xd9, 0xae,
+	0x19, 0x95, 0x95, 0x66, 0x37, 0xb7, 0x6f, 0xa8, 0x5b, 0xfd, 0x31, 0x5d, 0x8f, 0xc5, 0x92, 0x47,
+	0xea, 0xf5, 0x41, 0xd1, 0x59, 0x48, 0xc8, 0x6d, 0xa4, 0xae, 0x3c, 0x4f, 0x92, 0x79, 0x0d, 0xa8,
+	0xed, 0xec, 0x76, 0xda, 0xfb, 0xeb, 0xfb, 0xcf, 0xdb, 0x3a, 0xa3, 0x37, 0xee, 0x76, 0x79, 0x1c,
+	0x5b, 0x39, 0x2a, 0x1c, 0x7a, 0x51, 0x44, 0x39, 0xbd, 0x3a, 0x54, 0x30, 0xa7, 0x37, 0x16, 0xdc,
+	0x2a, 0x60, 0x0a, 0xb0, 0x17, 0x06, 0xdc, 0x2a, 0x22, 0x59, 0x70, 0x29, 0x3c, 0xde, 0xb3, 0x4a,
+	0x2b, 0x1f, 0x42, 0x59, 0x0d, 0x44, 0x8b, 0xdd, 0x75, 0xb6, 0x3f, 0xd9, 0xde, 0xb1, 0xe6, 0xd8,
+	0x3c, 0x54, 0x0f, 0xc6, 0x9e, 0x2f, 0x3b, 0x5e, 0x60, 0xe5, 0x18, 0x83, 0x05, 0xba, 0x60, 0x4b,
+	0x0e, 0x41, 0x56, 0xfe, 0x51, 0x09, 0x0a, 0xa3, 0x78, 0xb0, 0xf2, 0x8b, 0x05, 0x28, 0xb4, 0xc5,
+	0x11, 0x3e, 0xda, 0xc3, 0xc7, 0x7f, 0x5e, 0x30, 0x48, 0x9f, 0xc9, 0xe5, 0xd2, 0xbb, 0xf5, 0xb6,
+	0x38, 0xa2, 0x24, 0xaf, 0x17, 0x0c, 0x8c, 0x0a, 0x9d, 0xc5, 0xfe, 0x34, 0x81, 0xbd, 0x03, 0x55,
+	0x24, 0x75, 0x04, 0x8f, 0xf4, 0x42, 0x5e, 0xcc, 0xd6, 0x75, 0x78, 0x84, 0xf7, 0xe3, 0x7d, 0xf5,
+	0x89, 0x4f, 0x11, 0xf1, 0xba, 0xb0, 0x59, 0x48, 0x9f, 0x22, 0x22, 0x12, 0xa7, 0x0a, 0xaf, 0xed,
+	0x91, 0xc7, 0x5e, 0x87, 0x92, 0x7a, 0xfd, 0xa2, 0xc2, 0x52, 0xc3, 0x80, 0x28, 0xdf, 0x8a, 0x4f,
+	0xbd, 0x88, 0x8b, 0x2f, 0x16, 0x4d, 0xe7, 0x05, 0x8f, 0xc7, 0xbe, 0x79, 0x45, 0x75, 0x7d, 0xa6,
+	0xeb, 0x0e, 0x31, 0xf1, 0xc5, 0x62, 0x3f, 0x4b, 0xb0, 0xff, 0xb5, 0x00, 0x8b, 0x33, 0xa3, 0x63,
+	0xcd, 0x44, 0xfd, 0x7a, 0x49, 0x9a, 0x22, 0x6b, 0x26, 0x53, 0x46, 0xa3, 0xac, 0x3a, 0xa6, 0x88,
+	0x97, 0x03, 0xbe, 0x1b, 0x4b, 0xba, 0x06, 0xed, 0x18, 0x4c, 0x41, 0x5d, 0x6a, 0x22, 0x03, 0xc7,
+	0xd6, 0xd6, 0xd8, 0x77, 0x80, 0x29, 0xec, 0x90, 0x77, 0x0f, 0x3b, 0xa6, 0xa9, 0x22, 0x81, 0x2d,
+	0x02, 0x23, 0xe3, 0x63, 0xdd, 0xe6, 0x34, 0xda, 0x88, 0x2e, 0xcd, 0xa0, 0xdb, 0x69, 0x3f, 0x64,
+	0x28, 0x5d, 0x9f, 0xee, 0xa2, 0x71, 0xd3, 0x3a, 0x0e, 0x54, 0x26, 0xaf, 0xe1, 0x2c, 0x12, 0x03,
+	0x2f, 0xa1, 0xe3, 0x0d, 0x24, 0xa7, 0x58, 0x75, 0x77, 0xab, 0xb0, 0x95, 0x0c, 0x16, 0x3b, 0xad,
+	0xb1, 0xef, 0x00, 0xd3, 0x58, 0x6c, 0xcd, 0x80, 0xab, 0x04, 0xb6, 0x14, 0x98, 0x18, 0x0a, 0x8d,
+	0x1b, 0x77, 0xae, 0xb5, 0x61, 0xb0, 0x35, 0xf5, 0x7e, 0x07, 0xe9, 0x19, 0xb9, 0x6f, 0xe9, 0xd7,
+	0x9e, 0x53, 0x62, 0x41, 0xf5, 0x01, 0x19, 0x59, 0xa9, 0x2d, 0xb8, 0x96, 0xc5, 0xea, 0xf5, 0x42,
+	0xd7, 0xff, 0x0d, 0x67, 0x29, 0x45, 0xb7, 0x15, 0xc3, 0xfe, 0x59, 0x0e, 0x2a, 0xda, 0xfa, 0xf0,
+	0x22, 0x1d, 0x2f, 0x92, 0xb3, 0x5a, 0xc9, 0x51, 0xbd, 0xc6, 0xc8, 0x3d, 0xc9, 0xe8, 0xc4, 0xbc,
+	0xb6, 0xcc, 0x67, 0x5e, 0x5b, 0x2e, 0x43, 0x49, 0x86, 0x87, 0xdc, 0xec, 0xf0, 0x55, 0x81, 0xfd,
+	0x3f, 0x78, 0x0d, 0x25, 0xce, 0x38, 0x01, 0xba, 0x01, 0xa7, 0x0e, 0xd2, 0x84, 0x16, 0x9d, 0x5b,
+	0x23, 0xf7, 0x64, 0x73, 0xca, 0x23, 0xec, 0x71, 0x41, 0xfd, 0xb4, 0x7f, 0x55, 0x80, 0x22, 0xaa,
+	0x82, 0xdd, 0xd3, 0x69, 0xa0, 0x66, 0x2e, 0x7d, 0x22, 0x6a, 0x16, 0xc4, 0x74, 0x6a, 0xde, 0x82,
+	0xc2, 0xe6, 0xf6, 0x63, 0xbd, 0xa1, 0xc2, 0x4f, 0xfb, 0x4f, 0x0a, 0x26, 0x29, 0xbf, 0x71, 0x66,
+	0x52, 0xfe, 0xf6, 0x69, 0x61, 0x17, 0xa4, 0xe2, 0xed, 0xbf, 0xcd, 0xbf, 0x6c, 0x76, 0x7b, 0x73,
+	0x36, 0xbb, 0xfd, 0xf6, 0xc5, 0x2d, 0x9f, 0xb3, 0x33, 0x7b, 0x2b, 0x93, 0x91, 0x3c, 0x3f, 0xb8,
+	0x11, 0xe6, 0xd2, 0xe7, 0xdf, 0xc1, 0x0b, 0xb7, 0x3f, 0xeb, 0xd3, 0xbb, 0x90, 0xcb, 0x75, 0xfd,
+	0xd4, 0xb6, 0x23, 0xcd, 0xe7, 0x55, 0xa0, 0x44, 0x8e, 0xca, 0xfe, 0x8f, 0x02, 0x34, 0xa6, 0x5c,
+	0x10, 0x86, 0x4a, 0xb4, 0xaa, 0x0e, 0x9d, 0x44, 0x72, 0x64, 0x66, 0x55, 0x24, 0x3c, 0xc7, 0xb3,
+	0xc8, 0xd7, 0xa0, 0x71, 0xec, 0xc6, 0x9d, 0x78, 0x28, 0xbc, 0xe0, 0xd0, 0x0b, 0x06, 0xda, 0xcd,
+	0xcc, 0x1f, 0xbb, 0x71, 0xdb, 0xd0, 0x50, 0x42, 0xc0, 0x4f, 0x64, 0x87, 0x0c, 0x55, 0x65, 0x22,
+	0xab, 0x48, 0x68, 0xa3, 0xb1, 0xde, 0x85, 0xc5, 0x63, 0xcf, 0xf7, 0x3b, 0x41, 0x78, 0xac, 0xc5,
+	0x68, 0xcf, 0xd2, 0x40, 0xf2, 0x4e, 0x78, 0xac, 0xe4, 0xb0, 0xd7, 0x61, 0x21, 0x1e, 0x0f, 0x06,
+	0x3c, 0xa6, 0x27, 0x76, 0x5c, 0xe7, 0x79, 0xe7, 0x9d, 0x46, 0x42, 0x25, 0x71, 0x7b, 0xb0, 0x40,
+	0xab, 0x85, 0x0b, 0x7e, 0xe2, 0x8e, 0x22, 0x7a, 0x2e, 0x94, 0xdc, 0x67, 0x9e, 0xf2, 0xaf, 0xad,
+	0x8d, 0x29, 0xec, 0xb6, 0xe4, 0x23, 0x67, 0xa6, 0xbe, 0xfd, 0x4f, 0x39, 0x60, 0xa7, 0x61, 0xec,
+	0x7b, 0x30, 0x9f, 0x7d, 0x3a, 0x7e, 0xa9, 0xfb, 0xaa, 0x7a, 0xe6, 0xe9, 0x38, 0xdb, 0x80, 0xc6,
+	0xd4, 0xbb, 0xf1, 0x66, 0x3e, 0xb5, 0xff, 0x0b, 0xb2, 0xa6, 0xf3, 0xd9, 0x87, 0xe3, 0x2f, 0x95,
+	0x1f, 0x35, 0xf1, 0xf4, 0xe7, 0x39, 0x28, 0xab, 0x0b, 0x5a, 0xf6, 0x3a, 0x54, 0xd4, 0xbd, 0xbc,
+	0x89, 0xa4, 0x75, 0x52, 0x97, 0x22, 0x39, 0x86, 0xc7, 0xde, 0x87, 0x9a, 0xb9, 0xa4, 0x37, 0x5b,
+	0xcf, 0x5b, 0xe9, 0x35, 0x6f, 0x6b, 0xd3, 0xf0, 0xf4, 0x23, 0x90, 0x04, 0x6b, 0x3f, 0x81, 0x85,
+	0x69, 0x66, 0xd6, 0xa4, 0x1b, 0xca, 0xa4, 0x57, 0xa6, 0x4d, 0x9a, 0xa2, 0xac, 0xa9, 0x94, 0xb1,
+	0xd9, 0x95, 0x3f, 0xce, 0x41, 0x45, 0xf7, 0x8c, 0xbd, 0x09, 0xc5, 0x1f, 0xc5, 0x74, 0x64, 0x4d,
+	0x9e, 0x05, 0x6a, 0x56, 0xeb, 0x49, 0x1c, 0x06, 0xaa, 0x1f, 0x04, 0xb1, 0x9f, 0x42, 0x2d, 0x21,
+	0x9d, 0xd1, 0xfa, 0x9b, 0xd3, 0xad, 0x5f, 0x43, 0x51, 0x0e, 0xef, 0xef, 0x0a, 0x25, 0xef, 0x49,
+	0x7b, 0x77, 0x27, 0xdb, 0x89, 0x08, 0x16, 0x67, 0xb8, 0xec, 0xab, 0x50, 0x88, 0xa4, 0x79, 0x65,
+	0xdf, 0x48, 0xbb, 0xb2, 0x27, 0xc5, 0xd6, 0x9c, 0x83, 0x3c, 0xf6, 0x66, 0xf2, 0x18, 0x22, 0xbb,
+	0xe7, 0x20, 0x4a, 0x0b, 0x65, 0x6c, 0xcd, 0x99, 0xf7, 0x11, 0x8f, 0x16, 0xa1, 0x11, 0x49, 0xd1,
+	0x09, 0x45, 0x47, 0x11, 0x56, 0x56, 0xa1, 0x96, 0xc8, 0xc3, 0xfe, 0xb7, 0xb7, 0x1f, 0x9b, 0xfe,
+	0xb7, 0xb7, 0x1f, 0x23, 0x45, 0xf0, 0x7e, 0xf2, 0xb4, 0x93, 0xf7, 0x57, 0xbe, 0x0b, 0x55, 0xa3,
+	0x3e, 0x76, 0x37, 0xd1, 0x13, 0x36, 0x6b, 0x65, 0x55, 0xab, 0xdb, 0x25, 0x3e, 0x3e, 0x9b, 0x34,
+	0x93, 0xb6, 0xf2, 0x77, 0x05, 0xbc, 0xca, 0x4e, 0x41, 0x6c, 0x75, 0xca, 0xb5, 0x2e, 0xa8, 0xdd,
+	0x56, 0x16, 0x81, 0xe7, 0x9b, 0x61, 0xd8, 0x4b, 0x7c, 0xee, 0x03, 0x68, 0xe0, 0x3b, 0xd0, 0x4e,
+	0xe4, 0x0a, 0xe9, 0xb9, 0xbe, 0x31, 0x19, 0x1a, 0xf5, 0x9e, 0x2b, 0x87, 0x7b, 0x8a, 0xee, 0xcc,
+	0x47, 0x69, 0x21, 0x66, 0xaf, 0x43, 0x99, 0x7c, 0x92, 0x31, 0xea, 0x86, 0x82, 0x0b, 0x77, 0x44,
+	0x93, 0xa0, 0x99, 0xf8, 0xb6, 0x54, 0x1d, 0x01, 0x4c, 0xba, 0xf9, 0xb5, 0x53, 0xdd, 0x51, 0x2b,
+	0xc6, 0x38, 0x6c, 0x8d, 0xc6, 0x64, 0x45, 0x18, 0x71, 0xfd, 0x4e, 0xcc, 0xeb, 0xe9, 0xb4, 0x4b,
+	0x3d, 0xa1, 0x6d, 0xf7, 0x30, 0xa8, 0x4a, 0x77, 0xa0, 0x4e, 0xda, 0x35, 0x87, 0xbe, 0xf1, 0x62,
+	0x3f, 0x2b, 0xef, 0x0c, 0x13, 0x9a, 0xba, 0x9e, 0x6f, 0x64, 0xad, 0xe5, 0x18, 0xca, 0x4a, 0x35,
+	0xb8, 0x25, 0x7e, 0xbe, 0xf3, 0xe9, 0xce, 0xee, 0x67, 0xb8, 0xf3, 0xad, 0x40, 0xe1, 0x93, 0xcd,
+	0x7d, 0x2b, 0x87, 0x5b, 0xe6, 0xad, 0xcd, 0xf5, 0xc7, 0x56, 0x1e, 0xbf, 0xf6, 0x76, 0xdb, 0xfb,
+	0x56, 0x01, 0x99, 0x7b, 0xcf, 0xf7, 0xad, 0x22, 0xde, 0x9d, 0xef, 0xad, 0xef, 0x6f, 0x6c, 0x59,
+	0x25, 0xbc, 0x3b, 0x7f, 0xbc, 0xf9, 0x74, 0x73, 0x7f, 0xd3, 0x2a, 0xa3, 0xa4, 0x8d, 0xdd, 0x9d,
+	0x9d, 0xcd, 0x8d, 0x7d, 0xab, 0x82, 0x85, 0xdd, 0xbd, 0xfd, 0xed, 0xdd, 0x9d, 0xb6, 0x55, 0xc5,
+	0x0a, 0xfb, 0xce, 0xfa, 0xc6, 0xa6, 0x55, 0x5b, 0xf9, 0x87, 0x1c, 0xd4, 0x12, 0xd5, 0x61, 0x1e,
+	0xcb, 0x8b, 0xc9, 0x61, 0x79, 0x42, 0xfb, 0xf2, 0xaa, 0x03, 0x5e, 0xec, 0x68, 0x8a, 0x31, 0xab,
+	0x7c, 0x6a, 0x56, 0xe6, 0xd0, 0x53, 0xc8, 0x1c, 0x7a, 0xee, 0x42, 0xf1, 0xd0, 0x0b, 0x54, 0xfe,
+	0x65, 0x41, 0x05, 0xff, 0xa4, 0x8d, 0xd6, 0xa7, 0x5e, 0xd0, 0x73, 0x88, 0xbf, 0xf2, 0x04, 0x8a,
+	0x58, 0x9a, 0x1e, 0x73, 0x55, 0x85, 0x4b, 0x35, 0x68, 0x9c, 0x77, 0x2b, 0x8f, 0x1d, 0xa6, 0x7b,
+	0x4a, 0xab, 0x80, 0x23, 0x54, 0x81, 0xd5, 0x2a, 0xe2, 0xb7, 0x7a, 0xe3, 0x67, 0x95, 0x56, 0xbe,
+	0x03, 0xf5, 0x8c, 0xc5, 0xb0, 0x65, 0xac, 0x6b, 0x5e, 0x37, 0xa3, 0xf5, 0x62, 0x89, 0x31, 0xb5,
+	0x02, 0xf3, 0x9a, 0x88, 0x85, 0x47, 0x45, 0xc8, 0x47, 0xd1, 0xca, 0xaf, 0xe7, 0xa1, 0xac, 0x56,
+	0x8f, 0xfd, 0x2f, 0xf3, 0x50, 0x24, 0x6d, 0xbc, 0x05, 0x25, 0x39, 0x89, 0x74, 0xec, 0x5d, 0x58,
+	0x5b, 0x9e, 0x59, 0x8b, 0xad, 0xfd, 0x49, 0xc4, 0x1d, 0x05, 0xc1, 0x20, 0xcf, 0x83, 0xf1, 0x48,
+	0x1b, 0xf0, 0xb9, 0x41, 0x1e, 0x31, 0xac, 0x05, 0xe5, 0x7e, 0x28, 0x46, 0xae, 0xd4, 0x27, 0xbb,
+	0x1b, 0xb3, 0x82, 0x3f, 0x26, 0xae, 0xa3, 0x51, 0x78, 0x6e, 0x1b, 0x79, 0x41, 0xc7, 0xe7, 0xc1,
+	0x40, 0x0e, 0xf5, 0x26, 0xac, 0x36, 0xf2, 0x82, 0xa7, 0x44, 0x20, 0xb6, 0x7b, 0x62, 0xd8, 0x25,
+	0xcd, 0x76, 0x4f, 0x34, 0xfb, 0xeb, 0xb0, 0x30, 0x74, 0xe3, 0x4e, 0x06, 0xa2, 0x92, 0x85, 0xf3,
+	0x43, 0x37, 0x7e, 0x96, 0xa0, 0x9a, 0x50, 0x89, 0x5c, 0x29, 0xb9, 0x08, 0xcc, 0xd3, 0x7b, 0x5d,
+	0x44, 0xce, 0xc8, 0x0b, 0xbc, 0xd1, 0x78, 0x44, 0x9b, 0xe3, 0x9c, 0x63, 0x8a, 0xc4, 0x71, 0x4f,
+	0x88, 0x53, 0xd3, 0x1c, 0x55, 0x44, 0x3b, 0xa2, 0x36, 0x75, 0x3d, 0x50, 0x76, 0x84, 0x0d, 0x7a,
+	0xc1, 0x14, 0x40, 0x57, 0xaf, 0xa7, 0x00, 0x2d, 0xe1, 0x01, 0xdc, 0xa0, 0x94, 0xb6, 0xef, 0x62,
+	0x34, 0x1f, 0x8d, 0x7d, 0xe9, 0x45, 0x3e, 0xef, 0x84, 0x7d, 0xba, 0x33, 0xc8, 0x39, 0xcb, 0x29,
+	0xf7, 0x99, 0x66, 0xee, 0xf6, 0xd9, 0xdb, 0xb0, 0xc4, 0x4f, 0xba, 0xfe, 0x38, 0xc6, 0x27, 0x4d,
+	0xa6, 0xf5, 0x86, 0x3a, 0x58, 0x24, 0x0c, 0xd3, 0x87, 0x69, 0xb0, 0xee, 0xc9, 0xc2, 0x2c, 0x58,
+	0xf7, 0x67, 0x19, 0x4a, 0x9e, 0xe4, 0x23, 0x7c, 0xd7, 0x8a, 0x7f, 0x42, 0x52, 0x05, 0xf4, 0x14,
+	0xe3, 0xc0, 0xfb, 0x7c, 0xcc, 0x3b, 0x8a, 0x69, 0x51, 0xed, 0xba, 0xa2, 0x6d, 0x13, 0xe4, 0x15,
+	0xc0, 0xa9, 0xd2, 0x7c, 0xf5, 0x7c, 0xb5, 0x3a, 0xf2, 0x82, 0x94, 0x89, 0xaf, 0x75, 0x89, 0xc9,
+	0x34, 0xd3, 0x3d, 0x51, 0xcc, 0x15, 0x68, 0x98, 0x89, 0x53, 0x80, 0x6b, 0x4a, 0xba, 0xd2, 0x92,
+	0xc2, 0x7c, 0x0f, 0xf0, 0xe9, 0x5a, 0xc4, 0x85, 0xf4, 0x78, 0xdc, 0x5c, 0x4e, 0x63, 0x7c, 0xd6,
+	0x9c, 0xf6, 0x12, 0x84, 0x72, 0x74, 0x99, 0x2a, 0x98, 0x5e, 0x4e, 0x96, 0xfb, 0x75, 0x72, 0x66,
+	0x49, 0x19, 0x37, 0x54, 0xd8, 0xf5, 0x4c, 0x03, 0x37, 0xa8, 0x8b, 0x8d, 0x91, 0x17, 0xa4, 0x32,
+	0x09, 0xe6, 0x9e, 0x64, 0x61, 0x37, 0x35, 0xcc, 0x3d, 0xc9, 0xc0, 0xde, 0x01, 0x66, 0x86, 0x93,
+	0x81, 0x36, 0x95, 0xbe, 0xd5, 0x98, 0x32, 0xe8, 0x1f, 0xc2, 0x75, 0xb7, 0xd7, 0xf3, 0xd0, 0xdd,
+	0x62, 0x6a, 0x3c, 0xad, 0x70, 0x8b, 0x02, 0xd4, 0xd7, 0x67, 0xc7, 0xb8, 0x9e, 0x80, 0x53, 0x21,
+	0xce, 0xb2, 0x7b, 0x06, 0x95, 0x7d, 0x04, 0xb7, 0xb0, 0x23, 0x67, 0x8b, 0xb7, 0xd5, 0x5b, 0xe7,
+	0xa1, 0x1b, 0x9f, 0x25, 0x11, 0x6f, 0x7d, 0x70, 0x47, 0x16, 0xf6, 0x9b, 0xaf, 0x28, 0x3b, 0x70,
+	0x7d, 0x7f, 0xb7, 0x4f, 0xe4, 0x60, 0x82, 0xe4, 0x57, 0x35, 0x39, 0x98, 0x28, 0x72, 0x18, 0x90,
+	0xd1, 0xbe, 0xa6, 0xc8, 0x61, 0x80, 0x56, 0x6a, 0x41, 0x21, 0x08, 0x65, 0xf3, 0xb6, 0x72, 0xa2,
+	0x41, 0x28, 0xed, 0xef, 0xc0, 0xe2, 0xcc, 0x24, 0xbd, 0xe8, 0x71, 0x57, 0x36, 0x7a, 0xd8, 0x7f,
+	0x00, 0xcb, 0x67, 0xf6, 0xf6, 0x0d, 0x58, 0x70, 0xfd, 0x63, 0x77, 0x12, 0xab, 0x43, 0xb6, 0xf1,
+	0xe8, 0x98, 0x33, 0x50, 0xf4, 0xb6, 0x22, 0x33, 0x96, 0x71, 0xeb, 0xe8, 0x17, 0xdb, 0xdb, 0x8f,
+	0x1f, 0xd5, 0xa1, 0xe6, 0xf6, 0x7a, 0xa4, 0x9b, 0x78, 0x25, 0x84, 0x22, 0x7a, 0xbb, 0x53, 0xd1,
+	0xc9, 0x0d, 0xb4, 0xa3, 0x0e, 0xc6, 0xbe, 0xaf, 0xf2, 0x3c, 0x07, 0x61, 0xe8, 0x73, 0x37, 0xb0,
+	0x0a, 0x58, 0xf0, 0x02, 0xc9, 0x07, 0xc6, 0x57, 0x07, 0xe3, 0xd1, 0x01, 0x17, 0x56, 0x09, 0xdd,
+	0xb9, 0x2b, 0x84, 0x3b, 0xb1, 0xca, 0x48, 0x8e, 0xa5, 0xf0, 0x82, 0x81, 0x55, 0xc1, 0xef, 0x90,
+	0xee, 0x02, 0xac, 0xea, 0xca, 0x2f, 0x73, 0x50, 0x56, 0x6e, 0x50, 0xbd, 0x18, 0xdb, 0xd9, 0xb4,
+	0xe6, 0x30, 0x2f, 0xd4, 0x73, 0x25, 0xa7, 0xb7, 0xe3, 0xaa, 0x59, 0x2c, 0xaa, 0xf8, 0xc0, 0x47,
+	0xae, 0xe7, 0x5b, 0x45, 0x4c, 0x16, 0xe1, 0xe3, 0x3e, 0x8c, 0x43, 0x56, 0x19, 0x21, 0x5e, 0x74,
+	0xf4, 0xc0, 0xaa, 0xea, 0xaf, 0x87, 0x56, 0x0d, 0xbb, 0x3d, 0x16, 0x9e, 0x05, 0x6c, 0x09, 0x1a,
+	0x63, 0xe1, 0x75, 0x04, 0xef, 0x73, 0xc1, 0x83, 0x2e, 0xb7, 0xea, 0x28, 0x48, 0xf0, 0x01, 0x3f,
+	0xb1, 0x96, 0xf0, 0xd3, 0x0b, 0xe4, 0xfd, 0x35, 0x8b, 0xe9, 0xcf, 0x87, 0x0f, 0xac, 0x6b, 0xf8,
+	0xd9, 0xf7, 0x43, 0x57, 0x5a, 0xcb, 0xd8, 0xdd, 0x5e, 0x38, 0x3e, 0xf0, 0xb9, 0x75, 0x9d, 0x82,
+	0xd6, 0x44, 0x72, 0xeb, 0x06, 0x52, 0x0f, 0xbc, 0xc0, 0x15, 0x13, 0xeb, 0x26, 0xf6, 0x25, 0x72,
+	0xe3, 0xf8, 0x38, 0x14, 0x3d, 0xab, 0xb9, 0xf6, 0x36, 0xd4, 0xf1, 0x68, 0x31, 0x79, 0x46, 0x7f,
+	0x8e, 0x65, 0xaf, 0x42, 0xfe, 0x71, 0xc8, 0x2a, 0x7a, 0x43, 0x6e, 0x57, 0xf4, 0xf1, 0x63, 0x65,
+	0xee, 0x5e, 0xee, 0xbd, 0xdc, 0xa3, 0xf5, 0x3f, 0xff, 0xf2, 0x76, 0xee, 0x1f, 0xbf, 0xbc, 0x9d,
+	0xfb, 0xe5, 0x97, 0xb7, 0x73, 0xbf, 0xfe, 0xf2, 0x76, 0xee, 0xff, 0xaf, 0x66, 0xfe, 0x24, 0x9b,
+	0x91, 0xb3, 0x11, 0xae, 0xaa, 0x7f, 0xdb, 0xae, 0xce, 0xfc, 0x13, 0xf7, 0xa0, 0x4c, 0xc1, 0xe7,
+	0xfe, 0xff, 0x0c, 0x00, 0x0a, 0x86, 0xe0, 0x08, 0xa3, 0x3b, 0x00, 0x00,
+}
+
+func (this *Clt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt)
+	if !ok {
+		that2, ok := that.(Clt)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5141,13 +6004,13 @@ func (this *Srv_Call_Input) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
+	if that1.Msg == nil {
+		if this.Msg != nil {
 			return false
 		}
-	} else if this.Input == nil {
+	} else if this.Msg == nil {
 		return false
-	} else if !this.Input.Equal(that1.Input) {
+	} else if !this.Msg.Equal(that1.Msg) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -5155,14 +6018,14 @@ func (this *Srv_Call_Input) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Srv_Call_Input_HttpRequest_) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Srv_Call_Input_HttpRequest_)
+	that1, ok := that.(*Clt_Fuzz_)
 	if !ok {
-		that2, ok := that.(Srv_Call_Input_HttpRequest_)
+		that2, ok := that.(Clt_Fuzz_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5174,19 +6037,19 @@ func (this *Srv_Call_Input_HttpRequest_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.HttpRequest.Equal(that1.HttpRequest) {
+	if !this.Fuzz.Equal(that1.Fuzz) {
 		return false
 	}
 	return true
 }
-func (this *Srv_Call_Input_HttpRequest) Equal(that interface{}) bool {
+func (this *Clt_ResetProgress_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Srv_Call_Input_HttpRequest)
+	that1, ok := that.(*Clt_ResetProgress_)
 	if !ok {
-		that2, ok := that.(Srv_Call_Input_HttpRequest)
+		that2, ok := that.(Clt_ResetProgress_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5198,36 +6061,43 @@ func (this *Srv_Call_Input_HttpRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Method != that1.Method {
-		return false
-	}
-	if this.Url != that1.Url {
+	if !this.ResetProgress.Equal(that1.ResetProgress) {
 		return false
 	}
-	if len(this.Headers) != len(that1.Headers) {
-		return false
+	return true
+}
+func (this *Clt_CallRequestRaw_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Headers {
-		if !this.Headers[i].Equal(that1.Headers[i]) {
-			return false
+
+	that1, ok := that.(*Clt_CallRequestRaw_)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !this.Body.Equal(that1.Body) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.CallRequestRaw.Equal(that1.CallRequestRaw) {
 		return false
 	}
 	return true
 }
-func (this *Srv_Call_Input_HttpRequest_HeaderValues) Equal(that interface{}) bool {
+func (this *Clt_CallResponseRaw_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Srv_Call_Input_HttpRequest_HeaderValues)
+	that1, ok := that.(*Clt_CallResponseRaw_)
 	if !ok {
-		that2, ok := that.(Srv_Call_Input_HttpRequest_HeaderValues)
+		that2, ok := that.(Clt_CallResponseRaw_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5239,27 +6109,19 @@ func (this *Srv_Call_Input_HttpRequest_HeaderValues) Equal(that interface{}) boo
 	} else if this == nil {
 		return false
 	}
-	if len(this.Values) != len(that1.Values) {
-		return false
-	}
-	for i := range this.Values {
-		if this.Values[i] != that1.Values[i] {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.CallResponseRaw.Equal(that1.CallResponseRaw) {
 		return false
 	}
 	return true
 }
-func (this *Srv_Reset) Equal(that interface{}) bool {
+func (this *Clt_CallVerifProgress_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Srv_Reset)
+	that1, ok := that.(*Clt_CallVerifProgress_)
 	if !ok {
-		that2, ok := that.(Srv_Reset)
+		that2, ok := that.(Clt_CallVerifProgress_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5271,19 +6133,19 @@ func (this *Srv_Reset) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.CallVerifProgress.Equal(that1.CallVerifProgress) {
 		return false
 	}
 	return true
 }
-func (this *Srv_FuzzingResult) Equal(that interface{}) bool {
+func (this *Clt_Fuzz) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Srv_FuzzingResult)
+	that1, ok := that.(*Clt_Fuzz)
 	if !ok {
-		that2, ok := that.(Srv_FuzzingResult)
+		that2, ok := that.(Clt_Fuzz)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5295,72 +6157,79 @@ func (this *Srv_FuzzingResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.SeedUsed, that1.SeedUsed) {
+	if !this.Resetter.Equal(that1.Resetter) {
 		return false
 	}
-	if this.WasShrinking != that1.WasShrinking {
+	if !this.Model.Equal(that1.Model) {
 		return false
 	}
-	if !bytes.Equal(this.NextSeed, that1.NextSeed) {
+	if len(this.Usage) != len(that1.Usage) {
 		return false
 	}
-	if this.WillNowShrink != that1.WillNowShrink {
+	for i := range this.Usage {
+		if this.Usage[i] != that1.Usage[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Seed, that1.Seed) {
 		return false
 	}
-	if !bytes.Equal(this.SuggestedSeed, that1.SuggestedSeed) {
+	if this.Ntensity != that1.Ntensity {
 		return false
 	}
-	if len(this.Counterexample) != len(that1.Counterexample) {
+	if len(this.EIDs) != len(that1.EIDs) {
 		return false
 	}
-	for i := range this.Counterexample {
-		if !this.Counterexample[i].Equal(that1.Counterexample[i]) {
+	for i := range this.EIDs {
+		if this.EIDs[i] != that1.EIDs[i] {
 			return false
 		}
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if len(this.Labels) != len(that1.Labels) {
 		return false
 	}
-	return true
-}
-func (this *Srv_FuzzingResult_CounterexampleItem) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Srv_FuzzingResult_CounterexampleItem)
-	if !ok {
-		that2, ok := that.(Srv_FuzzingResult_CounterexampleItem)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if len(this.EnvRead) != len(that1.EnvRead) {
 		return false
 	}
-	if !this.CallRequest.Equal(that1.CallRequest) {
+	for i := range this.EnvRead {
+		if this.EnvRead[i] != that1.EnvRead[i] {
+			return false
+		}
+	}
+	if len(this.UUIDs) != len(that1.UUIDs) {
 		return false
 	}
-	if !this.CallResponse.Equal(that1.CallResponse) {
+	for i := range this.UUIDs {
+		if this.UUIDs[i] != that1.UUIDs[i] {
+			return false
+		}
+	}
+	if len(this.Files) != len(that1.Files) {
 		return false
 	}
+	for i := range this.Files {
+		if this.Files[i] != that1.Files[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *SpecIR) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SpecIR)
+	that1, ok := that.(*Clt_Fuzz_Resetter)
 	if !ok {
-		that2, ok := that.(SpecIR)
+		that2, ok := that.(Clt_Fuzz_Resetter)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5372,30 +6241,28 @@ func (this *SpecIR) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Schemas.Equal(that1.Schemas) {
-		return false
-	}
-	if len(this.Endpoints) != len(that1.Endpoints) {
-		return false
-	}
-	for i := range this.Endpoints {
-		if !this.Endpoints[i].Equal(that1.Endpoints[i]) {
+	if that1.Resetter == nil {
+		if this.Resetter != nil {
 			return false
 		}
+	} else if this.Resetter == nil {
+		return false
+	} else if !this.Resetter.Equal(that1.Resetter) {
+		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Schemas) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Shell_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schemas)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Shell_)
 	if !ok {
-		that2, ok := that.(Schemas)
+		that2, ok := that.(Clt_Fuzz_Resetter_Shell_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5407,27 +6274,19 @@ func (this *Schemas) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Json) != len(that1.Json) {
-		return false
-	}
-	for i := range this.Json {
-		if !this.Json[i].Equal(that1.Json[i]) {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Shell.Equal(that1.Shell) {
 		return false
 	}
 	return true
 }
-func (this *RefOrSchemaJSON) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Process_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefOrSchemaJSON)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Process_)
 	if !ok {
-		that2, ok := that.(RefOrSchemaJSON)
+		that2, ok := that.(Clt_Fuzz_Resetter_Process_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5439,28 +6298,19 @@ func (this *RefOrSchemaJSON) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.PtrOrSchema == nil {
-		if this.PtrOrSchema != nil {
-			return false
-		}
-	} else if this.PtrOrSchema == nil {
-		return false
-	} else if !this.PtrOrSchema.Equal(that1.PtrOrSchema) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Process.Equal(that1.Process) {
 		return false
 	}
 	return true
 }
-func (this *RefOrSchemaJSON_Ptr) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Http) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefOrSchemaJSON_Ptr)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Http)
 	if !ok {
-		that2, ok := that.(RefOrSchemaJSON_Ptr)
+		that2, ok := that.(Clt_Fuzz_Resetter_Http)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5472,19 +6322,19 @@ func (this *RefOrSchemaJSON_Ptr) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Ptr.Equal(that1.Ptr) {
+	if !this.Http.Equal(that1.Http) {
 		return false
 	}
 	return true
 }
-func (this *RefOrSchemaJSON_Schema) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Starlark_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefOrSchemaJSON_Schema)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Starlark_)
 	if !ok {
-		that2, ok := that.(RefOrSchemaJSON_Schema)
+		that2, ok := that.(Clt_Fuzz_Resetter_Starlark_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5496,19 +6346,19 @@ func (this *RefOrSchemaJSON_Schema) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Schema.Equal(that1.Schema) {
+	if !this.Starlark.Equal(that1.Starlark) {
 		return false
 	}
 	return true
 }
-func (this *SchemaPtr) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Snapshot_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SchemaPtr)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Snapshot_)
 	if !ok {
-		that2, ok := that.(SchemaPtr)
+		that2, ok := that.(Clt_Fuzz_Resetter_Snapshot_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5520,25 +6370,19 @@ func (this *SchemaPtr) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SID != that1.SID {
-		return false
-	}
-	if this.Ref != that1.Ref {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Snapshot.Equal(that1.Snapshot) {
 		return false
 	}
 	return true
 }
-func (this *Endpoint) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Sequence_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Endpoint)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Sequence_)
 	if !ok {
-		that2, ok := that.(Endpoint)
+		that2, ok := that.(Clt_Fuzz_Resetter_Sequence_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5550,28 +6394,19 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Endpoint == nil {
-		if this.Endpoint != nil {
-			return false
-		}
-	} else if this.Endpoint == nil {
-		return false
-	} else if !this.Endpoint.Equal(that1.Endpoint) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Sequence.Equal(that1.Sequence) {
 		return false
 	}
 	return true
 }
-func (this *Endpoint_Json) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Shell) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Endpoint_Json)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Shell)
 	if !ok {
-		that2, ok := that.(Endpoint_Json)
+		that2, ok := that.(Clt_Fuzz_Resetter_Shell)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5583,19 +6418,40 @@ func (this *Endpoint_Json) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Json.Equal(that1.Json) {
+	if this.Start != that1.Start {
+		return false
+	}
+	if this.Rst != that1.Rst {
+		return false
+	}
+	if this.Stop != that1.Stop {
+		return false
+	}
+	if this.Shell != that1.Shell {
+		return false
+	}
+	if this.ExecTimeoutNs != that1.ExecTimeoutNs {
+		return false
+	}
+	if this.EnvSnapshotTimeoutNs != that1.EnvSnapshotTimeoutNs {
+		return false
+	}
+	if this.LogFile != that1.LogFile {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *EndpointJSON) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Process) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndpointJSON)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Process)
 	if !ok {
-		that2, ok := that.(EndpointJSON)
+		that2, ok := that.(Clt_Fuzz_Resetter_Process)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5607,46 +6463,50 @@ func (this *EndpointJSON) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Method != that1.Method {
-		return false
-	}
-	if len(this.PathPartials) != len(that1.PathPartials) {
+	if len(this.Cmd) != len(that1.Cmd) {
 		return false
 	}
-	for i := range this.PathPartials {
-		if !this.PathPartials[i].Equal(that1.PathPartials[i]) {
+	for i := range this.Cmd {
+		if this.Cmd[i] != that1.Cmd[i] {
 			return false
 		}
 	}
-	if len(this.Inputs) != len(that1.Inputs) {
+	if len(this.Env) != len(that1.Env) {
 		return false
 	}
-	for i := range this.Inputs {
-		if !this.Inputs[i].Equal(that1.Inputs[i]) {
+	for i := range this.Env {
+		if this.Env[i] != that1.Env[i] {
 			return false
 		}
 	}
-	if len(this.Outputs) != len(that1.Outputs) {
+	if this.ReadyHttp != that1.ReadyHttp {
 		return false
 	}
-	for i := range this.Outputs {
-		if this.Outputs[i] != that1.Outputs[i] {
-			return false
-		}
+	if this.ReadyTcp != that1.ReadyTcp {
+		return false
+	}
+	if this.ReadyTimeoutNs != that1.ReadyTimeoutNs {
+		return false
+	}
+	if this.RestartOnReset != that1.RestartOnReset {
+		return false
+	}
+	if this.LogFile != that1.LogFile {
+		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *ParamJSON) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_HTTP) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ParamJSON)
+	that1, ok := that.(*Clt_Fuzz_Resetter_HTTP)
 	if !ok {
-		that2, ok := that.(ParamJSON)
+		that2, ok := that.(Clt_Fuzz_Resetter_HTTP)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5658,16 +6518,27 @@ func (this *ParamJSON) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.IsRequired != that1.IsRequired {
+	if this.Method != that1.Method {
 		return false
 	}
-	if this.SID != that1.SID {
+	if this.Url != that1.Url {
 		return false
 	}
-	if this.Name != that1.Name {
+	if len(this.Headers) != len(that1.Headers) {
 		return false
 	}
-	if this.Kind != that1.Kind {
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if this.Body != that1.Body {
+		return false
+	}
+	if this.ExpectedStatus != that1.ExpectedStatus {
+		return false
+	}
+	if this.TimeoutNs != that1.TimeoutNs {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -5675,14 +6546,14 @@ func (this *ParamJSON) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PathPartial) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Starlark) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PathPartial)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Starlark)
 	if !ok {
-		that2, ok := that.(PathPartial)
+		that2, ok := that.(Clt_Fuzz_Resetter_Starlark)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5694,13 +6565,51 @@ func (this *PathPartial) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Pp == nil {
-		if this.Pp != nil {
+	if this.Start != that1.Start {
+		return false
+	}
+	if this.Rst != that1.Rst {
+		return false
+	}
+	if this.Stop != that1.Stop {
+		return false
+	}
+	if this.ExecTimeoutNs != that1.ExecTimeoutNs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Clt_Fuzz_Resetter_Snapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_Fuzz_Resetter_Snapshot)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Resetter_Snapshot)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if this.Pp == nil {
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
-	} else if !this.Pp.Equal(that1.Pp) {
+	}
+	if len(this.Paths) != len(that1.Paths) {
+		return false
+	}
+	for i := range this.Paths {
+		if this.Paths[i] != that1.Paths[i] {
+			return false
+		}
+	}
+	if !this.Process.Equal(that1.Process) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -5708,14 +6617,14 @@ func (this *PathPartial) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PathPartial_Part) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Resetter_Sequence) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PathPartial_Part)
+	that1, ok := that.(*Clt_Fuzz_Resetter_Sequence)
 	if !ok {
-		that2, ok := that.(PathPartial_Part)
+		that2, ok := that.(Clt_Fuzz_Resetter_Sequence)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5727,19 +6636,27 @@ func (this *PathPartial_Part) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Part != that1.Part {
+	if len(this.Resetters) != len(that1.Resetters) {
+		return false
+	}
+	for i := range this.Resetters {
+		if !this.Resetters[i].Equal(that1.Resetters[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *PathPartial_Ptr) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PathPartial_Ptr)
+	that1, ok := that.(*Clt_Fuzz_Model)
 	if !ok {
-		that2, ok := that.(PathPartial_Ptr)
+		that2, ok := that.(Clt_Fuzz_Model)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5751,19 +6668,28 @@ func (this *PathPartial_Ptr) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Ptr != that1.Ptr {
+	if that1.Model == nil {
+		if this.Model != nil {
+			return false
+		}
+	} else if this.Model == nil {
+		return false
+	} else if !this.Model.Equal(that1.Model) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Schema) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model_Openapiv3) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schema)
+	that1, ok := that.(*Clt_Fuzz_Model_Openapiv3)
 	if !ok {
-		that2, ok := that.(Schema)
+		that2, ok := that.(Clt_Fuzz_Model_Openapiv3)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5775,19 +6701,19 @@ func (this *Schema) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Openapiv3.Equal(that1.Openapiv3) {
 		return false
 	}
 	return true
 }
-func (this *Schema_JSON) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model_Graphql) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schema_JSON)
+	that1, ok := that.(*Clt_Fuzz_Model_Graphql)
 	if !ok {
-		that2, ok := that.(Schema_JSON)
+		that2, ok := that.(Clt_Fuzz_Model_Graphql)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5799,149 +6725,208 @@ func (this *Schema_JSON) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Types) != len(that1.Types) {
+	if !this.Graphql.Equal(that1.Graphql) {
 		return false
 	}
-	for i := range this.Types {
-		if this.Types[i] != that1.Types[i] {
+	return true
+}
+func (this *Clt_Fuzz_Model_Grpc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_Fuzz_Model_Grpc)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_Grpc)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.Enum) != len(that1.Enum) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.Enum {
-		if !this.Enum[i].Equal(that1.Enum[i]) {
+	if !this.Grpc.Equal(that1.Grpc) {
+		return false
+	}
+	return true
+}
+func (this *Clt_Fuzz_Model_Asyncapi) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_Fuzz_Model_Asyncapi)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_Asyncapi)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.Format != that1.Format {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.MinLength != that1.MinLength {
+	if !this.Asyncapi.Equal(that1.Asyncapi) {
 		return false
 	}
-	if this.MaxLength != that1.MaxLength {
+	return true
+}
+func (this *Clt_Fuzz_Model_Openrpc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_Fuzz_Model_Openrpc)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_Openrpc)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.HasMaxLength != that1.HasMaxLength {
+	if !this.Openrpc.Equal(that1.Openrpc) {
 		return false
 	}
-	if this.Pattern != that1.Pattern {
+	return true
+}
+func (this *Clt_Fuzz_Model_OpenAPIv3) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_Fuzz_Model_OpenAPIv3)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_OpenAPIv3)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Minimum != that1.Minimum {
+	if this.File != that1.File {
 		return false
 	}
-	if this.Maximum != that1.Maximum {
+	if this.Host != that1.Host {
 		return false
 	}
-	if this.HasMinimum != that1.HasMinimum {
+	if this.HeaderAuthorization != that1.HeaderAuthorization {
 		return false
 	}
-	if this.HasMaximum != that1.HasMaximum {
+	if !this.Spec.Equal(that1.Spec) {
 		return false
 	}
-	if this.TranslatedMultipleOf != that1.TranslatedMultipleOf {
+	if this.Proxy != that1.Proxy {
 		return false
 	}
-	if this.ExclusiveMinimum != that1.ExclusiveMinimum {
+	if this.TlsCaFile != that1.TlsCaFile {
 		return false
 	}
-	if this.ExclusiveMaximum != that1.ExclusiveMaximum {
+	if this.TlsCertFile != that1.TlsCertFile {
 		return false
 	}
-	if len(this.Items) != len(that1.Items) {
+	if this.TlsKeyFile != that1.TlsKeyFile {
 		return false
 	}
-	for i := range this.Items {
-		if this.Items[i] != that1.Items[i] {
-			return false
-		}
+	if this.TlsInsecureSkipVerify != that1.TlsInsecureSkipVerify {
+		return false
 	}
-	if this.UniqueItems != that1.UniqueItems {
+	if this.TlsServerName != that1.TlsServerName {
 		return false
 	}
-	if this.MinItems != that1.MinItems {
+	if this.Http2 != that1.Http2 {
 		return false
 	}
-	if this.MaxItems != that1.MaxItems {
+	if this.MaxConnsPerHost != that1.MaxConnsPerHost {
 		return false
 	}
-	if this.HasMaxItems != that1.HasMaxItems {
+	if this.MaxIdleConnsPerHost != that1.MaxIdleConnsPerHost {
 		return false
 	}
-	if len(this.Properties) != len(that1.Properties) {
+	if this.FreshConnectionsPerTest != that1.FreshConnectionsPerTest {
 		return false
 	}
-	for i := range this.Properties {
-		if this.Properties[i] != that1.Properties[i] {
-			return false
-		}
+	if this.RequestTimeoutNs != that1.RequestTimeoutNs {
+		return false
 	}
-	if len(this.Required) != len(that1.Required) {
+	if this.ReadTimeoutNs != that1.ReadTimeoutNs {
 		return false
 	}
-	for i := range this.Required {
-		if this.Required[i] != that1.Required[i] {
-			return false
-		}
+	if this.MaxResponseBytes != that1.MaxResponseBytes {
+		return false
 	}
-	if this.MinProperties != that1.MinProperties {
+	if this.CookieJar != that1.CookieJar {
 		return false
 	}
-	if this.MaxProperties != that1.MaxProperties {
+	if this.StreamDurationNs != that1.StreamDurationNs {
 		return false
 	}
-	if this.HasMaxProperties != that1.HasMaxProperties {
+	if this.MaxCallsPerSecond != that1.MaxCallsPerSecond {
 		return false
 	}
-	if !this.AdditionalProperties.Equal(that1.AdditionalProperties) {
+	if this.HonorRetryAfter != that1.HonorRetryAfter {
 		return false
 	}
-	if this.HasAdditionalProperties != that1.HasAdditionalProperties {
+	if this.MaxRetryWaitNs != that1.MaxRetryWaitNs {
 		return false
 	}
-	if len(this.AllOf) != len(that1.AllOf) {
+	if this.Signer != that1.Signer {
 		return false
 	}
-	for i := range this.AllOf {
-		if this.AllOf[i] != that1.AllOf[i] {
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
 			return false
 		}
 	}
-	if len(this.AnyOf) != len(that1.AnyOf) {
+	if len(this.Query) != len(that1.Query) {
 		return false
 	}
-	for i := range this.AnyOf {
-		if this.AnyOf[i] != that1.AnyOf[i] {
+	for i := range this.Query {
+		if this.Query[i] != that1.Query[i] {
 			return false
 		}
 	}
-	if len(this.OneOf) != len(that1.OneOf) {
+	if len(this.Overrides) != len(that1.Overrides) {
 		return false
 	}
-	for i := range this.OneOf {
-		if this.OneOf[i] != that1.OneOf[i] {
+	for i := range this.Overrides {
+		if !this.Overrides[i].Equal(that1.Overrides[i]) {
 			return false
 		}
 	}
-	if this.Not != that1.Not {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Schema_JSON_AdditionalProperties) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model_OpenAPIv3_Override) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schema_JSON_AdditionalProperties)
+	that1, ok := that.(*Clt_Fuzz_Model_OpenAPIv3_Override)
 	if !ok {
-		that2, ok := that.(Schema_JSON_AdditionalProperties)
+		that2, ok := that.(Clt_Fuzz_Model_OpenAPIv3_Override)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5953,28 +6938,35 @@ func (this *Schema_JSON_AdditionalProperties) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.AddProps == nil {
-		if this.AddProps != nil {
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
 			return false
 		}
-	} else if this.AddProps == nil {
-		return false
-	} else if !this.AddProps.Equal(that1.AddProps) {
+	}
+	if len(this.Query) != len(that1.Query) {
 		return false
 	}
+	for i := range this.Query {
+		if this.Query[i] != that1.Query[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Schema_JSON_AdditionalProperties_AlwaysSucceed) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model_GraphQL) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schema_JSON_AdditionalProperties_AlwaysSucceed)
+	that1, ok := that.(*Clt_Fuzz_Model_GraphQL)
 	if !ok {
-		that2, ok := that.(Schema_JSON_AdditionalProperties_AlwaysSucceed)
+		that2, ok := that.(Clt_Fuzz_Model_GraphQL)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5986,19 +6978,28 @@ func (this *Schema_JSON_AdditionalProperties_AlwaysSucceed) Equal(that interface
 	} else if this == nil {
 		return false
 	}
-	if this.AlwaysSucceed != that1.AlwaysSucceed {
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if !this.Http.Equal(that1.Http) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Schema_JSON_AdditionalProperties_SID) Equal(that interface{}) bool {
+func (this *Clt_Fuzz_Model_GRPC) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Schema_JSON_AdditionalProperties_SID)
+	that1, ok := that.(*Clt_Fuzz_Model_GRPC)
 	if !ok {
-		that2, ok := that.(Schema_JSON_AdditionalProperties_SID)
+		that2, ok := that.(Clt_Fuzz_Model_GRPC)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6010,3678 +7011,15862 @@ func (this *Schema_JSON_AdditionalProperties_SID) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SID != that1.SID {
+	if len(this.ProtoFiles) != len(that1.ProtoFiles) {
+		return false
+	}
+	for i := range this.ProtoFiles {
+		if this.ProtoFiles[i] != that1.ProtoFiles[i] {
+			return false
+		}
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if this.Metadata[i] != that1.Metadata[i] {
+			return false
+		}
+	}
+	if !this.Spec.Equal(that1.Spec) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
+func (this *Clt_Fuzz_Model_AsyncAPI) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// FuzzyMonkeyClient is the client API for FuzzyMonkey service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type FuzzyMonkeyClient interface {
-	Do(ctx context.Context, opts ...grpc.CallOption) (FuzzyMonkey_DoClient, error)
-}
-
-type fuzzyMonkeyClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewFuzzyMonkeyClient(cc *grpc.ClientConn) FuzzyMonkeyClient {
-	return &fuzzyMonkeyClient{cc}
-}
-
-func (c *fuzzyMonkeyClient) Do(ctx context.Context, opts ...grpc.CallOption) (FuzzyMonkey_DoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_FuzzyMonkey_serviceDesc.Streams[0], "/fm.FuzzyMonkey/Do", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Clt_Fuzz_Model_AsyncAPI)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_AsyncAPI)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &fuzzyMonkeyDoClient{stream}
-	return x, nil
-}
-
-type FuzzyMonkey_DoClient interface {
-	Send(*Clt) error
-	Recv() (*Srv, error)
-	grpc.ClientStream
-}
-
-type fuzzyMonkeyDoClient struct {
-	grpc.ClientStream
-}
-
-func (x *fuzzyMonkeyDoClient) Send(m *Clt) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *fuzzyMonkeyDoClient) Recv() (*Srv, error) {
-	m := new(Srv)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return m, nil
-}
-
-// FuzzyMonkeyServer is the server API for FuzzyMonkey service.
-type FuzzyMonkeyServer interface {
-	Do(FuzzyMonkey_DoServer) error
-}
-
-func RegisterFuzzyMonkeyServer(s *grpc.Server, srv FuzzyMonkeyServer) {
-	s.RegisterService(&_FuzzyMonkey_serviceDesc, srv)
-}
-
-func _FuzzyMonkey_Do_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(FuzzyMonkeyServer).Do(&fuzzyMonkeyDoServer{stream})
-}
-
-type FuzzyMonkey_DoServer interface {
-	Send(*Srv) error
-	Recv() (*Clt, error)
-	grpc.ServerStream
-}
-
-type fuzzyMonkeyDoServer struct {
-	grpc.ServerStream
-}
-
-func (x *fuzzyMonkeyDoServer) Send(m *Srv) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *fuzzyMonkeyDoServer) Recv() (*Clt, error) {
-	m := new(Clt)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	if this.File != that1.File {
+		return false
 	}
-	return m, nil
-}
-
-var _FuzzyMonkey_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fm.FuzzyMonkey",
-	HandlerType: (*FuzzyMonkeyServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Do",
-			Handler:       _FuzzyMonkey_Do_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pkg/internal/fm/fuzzymonkey.proto",
-}
-
-func (m *Clt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Url != that1.Url {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Msg != nil {
-		nn1, err := m.Msg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if this.ReceiveWindowNs != that1.ReceiveWindowNs {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
 		}
-		i += nn1
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.Spec.Equal(that1.Spec) {
+		return false
 	}
-	return i, nil
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Clt_Fuzz_Model_OpenRPC) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Fuzz != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Fuzz.Size()))
-		n2, err := m.Fuzz.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_Fuzz_Model_OpenRPC)
+	if !ok {
+		that2, ok := that.(Clt_Fuzz_Model_OpenRPC)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n2
 	}
-	return i, nil
-}
-func (m *Clt_ResetProgress_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ResetProgress != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ResetProgress.Size()))
-		n3, err := m.ResetProgress.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-func (m *Clt_CallRequestRaw_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CallRequestRaw != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallRequestRaw.Size()))
-		n4, err := m.CallRequestRaw.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	if this.File != that1.File {
+		return false
 	}
-	return i, nil
-}
-func (m *Clt_CallResponseRaw_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CallResponseRaw != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallResponseRaw.Size()))
-		n5, err := m.CallResponseRaw.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	if this.Host != that1.Host {
+		return false
 	}
-	return i, nil
-}
-func (m *Clt_CallVerifProgress_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CallVerifProgress != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallVerifProgress.Size()))
-		n6, err := m.CallVerifProgress.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if !this.Http.Equal(that1.Http) {
+		return false
 	}
-	return i, nil
-}
-func (m *Clt_Fuzz) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *Clt_ResetProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Resetter != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Resetter.Size()))
-		n7, err := m.Resetter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_ResetProgress)
+	if !ok {
+		that2, ok := that.(Clt_ResetProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n7
 	}
-	if m.Model != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Model.Size()))
-		n8, err := m.Model.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Usage) > 0 {
-		for _, s := range m.Usage {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if this.Status != that1.Status {
+		return false
 	}
-	if len(m.Seed) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Seed)))
-		i += copy(dAtA[i:], m.Seed)
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
 	}
-	if m.Ntensity != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Ntensity))
+	if len(this.Reason) != len(that1.Reason) {
+		return false
 	}
-	if len(m.EIDs) > 0 {
-		dAtA10 := make([]byte, len(m.EIDs)*10)
-		var j9 int
-		for _, num := range m.EIDs {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
+	for i := range this.Reason {
+		if this.Reason[i] != that1.Reason[i] {
+			return false
 		}
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j9))
-		i += copy(dAtA[i:], dAtA10[:j9])
 	}
-	if len(m.Labels) > 0 {
-		for k, _ := range m.Labels {
-			dAtA[i] = 0x3a
-			i++
-			v := m.Labels[k]
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if len(m.EnvRead) > 0 {
-		for k, _ := range m.EnvRead {
-			dAtA[i] = 0x42
-			i++
-			v := m.EnvRead[k]
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	return true
+}
+func (this *Clt_CallRequestRaw) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.UUIDs) > 0 {
-		for _, s := range m.UUIDs {
-			dAtA[i] = 0x4a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+
+	that1, ok := that.(*Clt_CallRequestRaw)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Files) > 0 {
-		for k, _ := range m.Files {
-			dAtA[i] = 0x52
-			i++
-			v := m.Files[k]
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if len(this.Reason) != len(that1.Reason) {
+		return false
+	}
+	for i := range this.Reason {
+		if this.Reason[i] != that1.Reason[i] {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_Fuzz_Resetter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallRequestRaw_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_Fuzz_Resetter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Resetter != nil {
-		nn11, err := m.Resetter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn11
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Clt_Fuzz_Resetter_Shell_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Shell != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Shell.Size()))
-		n12, err := m.Shell.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
 		}
-		i += n12
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	return i, nil
-}
-func (m *Clt_Fuzz_Resetter_Shell) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
-
-func (m *Clt_Fuzz_Resetter_Shell) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Start) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Start)))
-		i += copy(dAtA[i:], m.Start)
+func (this *Clt_CallRequestRaw_Input_HttpRequest_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Rst) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Rst)))
-		i += copy(dAtA[i:], m.Rst)
+
+	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest_)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Stop) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Stop)))
-		i += copy(dAtA[i:], m.Stop)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.HttpRequest.Equal(that1.HttpRequest) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_Fuzz_Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallRequestRaw_Input_GrpcRequest_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_Fuzz_Model) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Model != nil {
-		nn13, err := m.Model.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input_GrpcRequest_)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_GrpcRequest_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn13
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GrpcRequest.Equal(that1.GrpcRequest) {
+		return false
 	}
-	return i, nil
+	return true
 }
+func (this *Clt_CallRequestRaw_Input_WebsocketRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_Model_Openapiv3) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Openapiv3 != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Openapiv3.Size()))
-		n14, err := m.Openapiv3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input_WebsocketRequest)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_WebsocketRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n14
 	}
-	return i, nil
-}
-func (m *Clt_Fuzz_Model_OpenAPIv3) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if !this.WebsocketRequest.Equal(that1.WebsocketRequest) {
+		return false
+	}
+	return true
 }
+func (this *Clt_CallRequestRaw_Input_HttpRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.File) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.File)))
-		i += copy(dAtA[i:], m.File)
+	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Host) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Host)))
-		i += copy(dAtA[i:], m.Host)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.HeaderAuthorization) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.HeaderAuthorization)))
-		i += copy(dAtA[i:], m.HeaderAuthorization)
+	if this.Method != that1.Method {
+		return false
 	}
-	if m.Spec != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Spec.Size()))
-		n15, err := m.Spec.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if this.Url != that1.Url {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
 		}
-		i += n15
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	return i, nil
+	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
+		return false
+	}
+	if this.UnixSocket != that1.UnixSocket {
+		return false
+	}
+	if !this.Graphql.Equal(that1.Graphql) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_ResetProgress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_ResetProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Status))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ElapsedNs != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_CallRequestRaw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_CallRequestRaw) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Input.Size()))
-		n16, err := m.Input.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
-	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	that1, ok := that.(*Clt_CallRequestRaw_Input_HttpRequest_GraphQL)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_HttpRequest_GraphQL)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Clt_CallRequestRaw_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Query != that1.Query {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_CallRequestRaw_Input) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		nn17, err := m.Input.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn17
+	if !this.Variables.Equal(that1.Variables) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
+func (this *Clt_CallRequestRaw_Input_GrpcRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HttpRequest != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.HttpRequest.Size()))
-		n18, err := m.HttpRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input_GrpcRequest)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_GrpcRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n18
 	}
-	return i, nil
-}
-func (m *Clt_CallRequestRaw_Input_HttpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Method) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
-		i += copy(dAtA[i:], m.Method)
+	if this.Target != that1.Target {
+		return false
 	}
-	if len(m.Url) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
-		i += copy(dAtA[i:], m.Url)
+	if this.Method != that1.Method {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, _ := range m.Headers {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Headers[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFuzzymonkey(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + msgSize
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFuzzymonkey(dAtA, i, uint64(v.Size()))
-				n19, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n19
-			}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
 		}
 	}
-	if len(m.Body) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	if m.BodyDecoded != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.BodyDecoded.Size()))
-		n20, err := m.BodyDecoded.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	that1, ok := that.(*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Clt_CallResponseRaw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_CallResponseRaw) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Output.Size()))
-		n21, err := m.Output.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
 		}
-		i += n21
-	}
-	if m.OutputId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.OutputId))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_CallResponseRaw_Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallRequestRaw_Input_WebSocketRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_CallResponseRaw_Output) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		nn22, err := m.Output.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallRequestRaw_Input_WebSocketRequest)
+	if !ok {
+		that2, ok := that.(Clt_CallRequestRaw_Input_WebSocketRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn22
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HttpResponse != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.HttpResponse.Size()))
-		n23, err := m.HttpResponse.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
-	}
-	return i, nil
-}
-func (m *Clt_CallResponseRaw_Output_HttpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Url != that1.Url {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+	if this.Channel != that1.Channel {
+		return false
 	}
-	if m.StatusCode != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.StatusCode))
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, _ := range m.Headers {
-			dAtA[i] = 0x22
-			i++
-			v := m.Headers[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFuzzymonkey(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + msgSize
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFuzzymonkey(dAtA, i, uint64(v.Size()))
-				n24, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n24
-			}
-		}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if len(m.Body) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
+	return true
+}
+func (this *Clt_CallResponseRaw) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.BodyDecoded != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.BodyDecoded.Size()))
-		n25, err := m.BodyDecoded.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*Clt_CallResponseRaw)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n25
 	}
-	if m.ElapsedNs != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.OutputId != that1.OutputId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Clt_CallVerifProgress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Clt_CallVerifProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.Status != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Status))
-	}
-	if m.Origin != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Origin))
-	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	that1, ok := that.(*Clt_CallResponseRaw_Output)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.ElapsedNs != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ExecutionSteps != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ExecutionSteps))
+	if that1.Output == nil {
+		if this.Output != nil {
+			return false
+		}
+	} else if this.Output == nil {
+		return false
+	} else if !this.Output.Equal(that1.Output) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Srv) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_HttpResponse_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Srv) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.FuzzingProgress != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.FuzzingProgress.Size()))
-		n26, err := m.FuzzingProgress.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n26
 	}
-	if m.Msg != nil {
-		nn27, err := m.Msg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn27
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.HttpResponse.Equal(that1.HttpResponse) {
+		return false
 	}
-	return i, nil
+	return true
 }
+func (this *Clt_CallResponseRaw_Output_GrpcResponse_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_FuzzRep_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.FuzzRep != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.FuzzRep.Size()))
-		n28, err := m.FuzzRep.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_GrpcResponse_)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_GrpcResponse_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n28
 	}
-	return i, nil
-}
-func (m *Srv_Call_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Call != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Call.Size()))
-		n29, err := m.Call.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n29
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-func (m *Srv_Reset_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Reset_ != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Reset_.Size()))
-		n30, err := m.Reset_.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
+	if !this.GrpcResponse.Equal(that1.GrpcResponse) {
+		return false
 	}
-	return i, nil
+	return true
 }
-func (m *Srv_FuzzingResult_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.FuzzingResult != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.FuzzingResult.Size()))
-		n31, err := m.FuzzingResult.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+func (this *Clt_CallResponseRaw_Output_WebsocketResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_CallResponseRaw_Output_WebsocketResponse)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_WebsocketResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n31
 	}
-	return i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WebsocketResponse.Equal(that1.WebsocketResponse) {
+		return false
+	}
+	return true
 }
-func (m *Srv_FuzzingProgress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_HttpResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Srv_FuzzingProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Failure {
-		dAtA[i] = 0x8
-		i++
-		if m.Failure {
-			dAtA[i] = 1
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.Success {
-		dAtA[i] = 0x10
-		i++
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LastCallSuccess {
-		dAtA[i] = 0x18
-		i++
-		if m.LastCallSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Error != that1.Error {
+		return false
 	}
-	if m.LastCheckFailure {
-		dAtA[i] = 0x20
-		i++
-		if m.LastCheckFailure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.StatusCode != that1.StatusCode {
+		return false
 	}
-	if m.LastCheckSuccess {
-		dAtA[i] = 0x28
-		i++
-		if m.LastCheckSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Reason != that1.Reason {
+		return false
 	}
-	if m.TotalTestsCount != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalTestsCount))
+	if len(this.Headers) != len(that1.Headers) {
+		return false
 	}
-	if m.TotalCallsCount != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalCallsCount))
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
+		}
 	}
-	if m.TotalChecksCount != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalChecksCount))
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	if m.TestCallsCount != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TestCallsCount))
+	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
+		return false
 	}
-	if m.CallChecksCount != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallChecksCount))
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
 	}
-	if m.CallChecksSkipped != 0 {
-		dAtA[i] = 0x58
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallChecksSkipped))
+	if !this.Timings.Equal(that1.Timings) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.History) != len(that1.History) {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Srv_FuzzRep) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	for i := range this.History {
+		if !this.History[i].Equal(that1.History[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_FuzzRep) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.MaxTestsCount != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxTestsCount))
+	if !this.Connection.Equal(that1.Connection) {
+		return false
 	}
-	if len(m.Seed) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Seed)))
-		i += copy(dAtA[i:], m.Seed)
+	if len(this.Events) != len(that1.Events) {
+		return false
 	}
-	if len(m.Token) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Token)))
-		i += copy(dAtA[i:], m.Token)
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
 	}
-	if m.MaxExecutionStepsPerCheck != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxExecutionStepsPerCheck))
+	if this.StreamCut != that1.StreamCut {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Srv_Call) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Srv_Call) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Input.Size()))
-		n32, err := m.Input.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n32
-	}
-	if m.EID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.EID))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Srv_Call_Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_Call_Input) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		nn33, err := m.Input.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
 		}
-		i += nn33
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Timings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_Call_Input_HttpRequest_) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HttpRequest != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.HttpRequest.Size()))
-		n34, err := m.HttpRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_Timings)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_Timings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n34
 	}
-	return i, nil
-}
-func (m *Srv_Call_Input_HttpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_Call_Input_HttpRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Method) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
-		i += copy(dAtA[i:], m.Method)
+	if this.DnsNs != that1.DnsNs {
+		return false
 	}
-	if len(m.Url) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
-		i += copy(dAtA[i:], m.Url)
+	if this.ConnectNs != that1.ConnectNs {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, _ := range m.Headers {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Headers[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFuzzymonkey(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + msgSize
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFuzzymonkey(dAtA, i, uint64(v.Size()))
-				n35, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n35
-			}
-		}
+	if this.TlsHandshakeNs != that1.TlsHandshakeNs {
+		return false
 	}
-	if m.Body != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Body.Size()))
-		n36, err := m.Body.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
+	if this.TtfbNs != that1.TtfbNs {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if this.TransferNs != that1.TransferNs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_Redirect)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_Redirect)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Srv_Reset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Url != that1.Url {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_Reset) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if this.StatusCode != that1.StatusCode {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Srv_FuzzingResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_FuzzingResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.SeedUsed) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.SeedUsed)))
-		i += copy(dAtA[i:], m.SeedUsed)
+	if this.Location != that1.Location {
+		return false
 	}
-	if m.WasShrinking {
-		dAtA[i] = 0x10
-		i++
-		if m.WasShrinking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
 		}
-		i++
 	}
-	if len(m.NextSeed) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.NextSeed)))
-		i += copy(dAtA[i:], m.NextSeed)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if m.WillNowShrink {
-		dAtA[i] = 0x20
-		i++
-		if m.WillNowShrink {
-			dAtA[i] = 1
+	return true
+}
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Connection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_Connection)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_Connection)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if len(m.SuggestedSeed) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.SuggestedSeed)))
-		i += copy(dAtA[i:], m.SuggestedSeed)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Counterexample) > 0 {
-		for _, msg := range m.Counterexample {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.RemoteIp != that1.RemoteIp {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if this.RemotePort != that1.RemotePort {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Srv_FuzzingResult_CounterexampleItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.LocalIp != that1.LocalIp {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Srv_FuzzingResult_CounterexampleItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CallRequest != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallRequest.Size()))
-		n37, err := m.CallRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
+	if this.LocalPort != that1.LocalPort {
+		return false
 	}
-	if m.CallResponse != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallResponse.Size()))
-		n38, err := m.CallResponse.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
+	if this.Protocol != that1.Protocol {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if this.Reused != that1.Reused {
+		return false
 	}
-	return i, nil
-}
-
-func (m *SpecIR) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.Tls.Equal(that1.Tls) {
+		return false
 	}
-	return dAtA[:n], nil
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SpecIR) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Schemas != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Schemas.Size()))
-		n39, err := m.Schemas.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n39
 	}
-	if len(m.Endpoints) > 0 {
-		for k, _ := range m.Endpoints {
-			dAtA[i] = 0x12
-			i++
-			v := m.Endpoints[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFuzzymonkey(uint64(msgSize))
-			}
-			mapSize := 1 + sovFuzzymonkey(uint64(k)) + msgSize
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFuzzymonkey(dAtA, i, uint64(v.Size()))
-				n40, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n40
-			}
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if this.Version != that1.Version {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Schemas) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.CipherSuite != that1.CipherSuite {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Schemas) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Json) > 0 {
-		for k, _ := range m.Json {
-			dAtA[i] = 0xa
-			i++
-			v := m.Json[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovFuzzymonkey(uint64(msgSize))
-			}
-			mapSize := 1 + sovFuzzymonkey(uint64(k)) + msgSize
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintFuzzymonkey(dAtA, i, uint64(v.Size()))
-				n41, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n41
-			}
-		}
+	if this.PeerSubject != that1.PeerSubject {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *RefOrSchemaJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *RefOrSchemaJSON) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PtrOrSchema != nil {
-		nn42, err := m.PtrOrSchema.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_HttpResponse_Event)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_HttpResponse_Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn42
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *RefOrSchemaJSON_Ptr) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Ptr != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Ptr.Size()))
-		n43, err := m.Ptr.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
-	}
-	return i, nil
-}
-func (m *RefOrSchemaJSON_Schema) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Schema != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Schema.Size()))
-		n44, err := m.Schema.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
+	if this.Id != that1.Id {
+		return false
 	}
-	return i, nil
-}
-func (m *SchemaPtr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Event != that1.Event {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SchemaPtr) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
+	if this.Data != that1.Data {
+		return false
 	}
-	if len(m.Ref) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Ref)))
-		i += copy(dAtA[i:], m.Ref)
+	if !this.DataDecoded.Equal(that1.DataDecoded) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Endpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_GrpcResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Endpoint) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Endpoint != nil {
-		nn45, err := m.Endpoint.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_GrpcResponse)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_GrpcResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn45
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Endpoint_Json) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Json != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Json.Size()))
-		n46, err := m.Json.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n46
+	if this.Error != that1.Error {
+		return false
 	}
-	return i, nil
-}
-func (m *EndpointJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Code != that1.Code {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndpointJSON) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Method != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Method))
+	if this.Status != that1.Status {
+		return false
 	}
-	if len(m.PathPartials) > 0 {
-		for _, msg := range m.PathPartials {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.Message != that1.Message {
+		return false
 	}
-	if len(m.Inputs) > 0 {
-		for _, msg := range m.Inputs {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
 		}
 	}
-	if len(m.Outputs) > 0 {
-		for k, _ := range m.Outputs {
-			dAtA[i] = 0x22
-			i++
-			v := m.Outputs[k]
-			mapSize := 1 + sovFuzzymonkey(uint64(k)) + 1 + sovFuzzymonkey(uint64(v))
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(v))
+	if len(this.Trailers) != len(that1.Trailers) {
+		return false
+	}
+	for i := range this.Trailers {
+		if !this.Trailers[i].Equal(that1.Trailers[i]) {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	return i, nil
-}
-
-func (m *ParamJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.BodyDecoded.Equal(that1.BodyDecoded) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ParamJSON) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.IsRequired {
-		dAtA[i] = 0x8
-		i++
-		if m.IsRequired {
-			dAtA[i] = 1
+	that1, ok := that.(*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.SID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	if m.Kind != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Kind))
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *PathPartial) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Clt_CallResponseRaw_Output_WebSocketResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *PathPartial) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pp != nil {
-		nn47, err := m.Pp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Clt_CallResponseRaw_Output_WebSocketResponse)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_WebSocketResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn47
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *PathPartial_Part) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Part)))
-	i += copy(dAtA[i:], m.Part)
-	return i, nil
-}
-func (m *PathPartial_Ptr) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Ptr)))
-	i += copy(dAtA[i:], m.Ptr)
-	return i, nil
-}
-func (m *Schema) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Error != that1.Error {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Schema) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.Messages) != len(that1.Messages) {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Schema_JSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Clt_CallResponseRaw_Output_WebSocketResponse_Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Schema_JSON) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Types) > 0 {
-		dAtA49 := make([]byte, len(m.Types)*10)
-		var j48 int
-		for _, num := range m.Types {
-			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j48++
-			}
-			dAtA49[j48] = uint8(num)
-			j48++
+	that1, ok := that.(*Clt_CallResponseRaw_Output_WebSocketResponse_Message)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_Output_WebSocketResponse_Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j48))
-		i += copy(dAtA[i:], dAtA49[:j48])
 	}
-	if len(m.Enum) > 0 {
-		for _, msg := range m.Enum {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Format != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Format))
+	if this.Sent != that1.Sent {
+		return false
 	}
-	if m.MinLength != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinLength))
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	if m.MaxLength != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxLength))
+	if !this.DataDecoded.Equal(that1.DataDecoded) {
+		return false
 	}
-	if m.HasMaxLength {
-		dAtA[i] = 0x30
-		i++
-		if m.HasMaxLength {
-			dAtA[i] = 1
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Clt_CallResponseRaw_LogLine) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Clt_CallResponseRaw_LogLine)
+	if !ok {
+		that2, ok := that.(Clt_CallResponseRaw_LogLine)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if len(m.Pattern) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Pattern)))
-		i += copy(dAtA[i:], m.Pattern)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Minimum != 0 {
-		dAtA[i] = 0x41
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Minimum))))
-		i += 8
+	if this.Source != that1.Source {
+		return false
 	}
-	if m.Maximum != 0 {
-		dAtA[i] = 0x49
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Maximum))))
-		i += 8
+	if this.Line != that1.Line {
+		return false
 	}
-	if m.HasMinimum {
-		dAtA[i] = 0x50
-		i++
-		if m.HasMinimum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.OffsetNs != that1.OffsetNs {
+		return false
 	}
-	if m.HasMaximum {
-		dAtA[i] = 0x58
-		i++
-		if m.HasMaximum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if m.TranslatedMultipleOf != 0 {
-		dAtA[i] = 0x61
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TranslatedMultipleOf))))
-		i += 8
+	return true
+}
+func (this *Clt_CallVerifProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ExclusiveMinimum {
-		dAtA[i] = 0x68
-		i++
-		if m.ExclusiveMinimum {
-			dAtA[i] = 1
+
+	that1, ok := that.(*Clt_CallVerifProgress)
+	if !ok {
+		that2, ok := that.(Clt_CallVerifProgress)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.ExclusiveMaximum {
-		dAtA[i] = 0x70
-		i++
-		if m.ExclusiveMaximum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Items) > 0 {
-		dAtA51 := make([]byte, len(m.Items)*10)
-		var j50 int
-		for _, num := range m.Items {
-			for num >= 1<<7 {
-				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j50++
-			}
-			dAtA51[j50] = uint8(num)
-			j50++
-		}
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j50))
-		i += copy(dAtA[i:], dAtA51[:j50])
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.UniqueItems {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.UniqueItems {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Status != that1.Status {
+		return false
 	}
-	if m.MinItems != 0 {
-		dAtA[i] = 0x88
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinItems))
+	if this.Origin != that1.Origin {
+		return false
 	}
-	if m.MaxItems != 0 {
-		dAtA[i] = 0x90
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxItems))
+	if len(this.Reason) != len(that1.Reason) {
+		return false
 	}
-	if m.HasMaxItems {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.HasMaxItems {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.Reason {
+		if this.Reason[i] != that1.Reason[i] {
+			return false
 		}
-		i++
 	}
-	if len(m.Properties) > 0 {
-		for k, _ := range m.Properties {
-			dAtA[i] = 0xa2
-			i++
-			dAtA[i] = 0x1
-			i++
-			v := m.Properties[k]
-			mapSize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + sovFuzzymonkey(uint64(v))
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintFuzzymonkey(dAtA, i, uint64(v))
-		}
+	if this.ElapsedNs != that1.ElapsedNs {
+		return false
 	}
-	if len(m.Required) > 0 {
-		for _, s := range m.Required {
-			dAtA[i] = 0xaa
-			i++
-			dAtA[i] = 0x1
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if this.ExecutionSteps != that1.ExecutionSteps {
+		return false
 	}
-	if m.MinProperties != 0 {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinProperties))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if m.MaxProperties != 0 {
-		dAtA[i] = 0xb8
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxProperties))
+	return true
+}
+func (this *Srv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.HasMaxProperties {
-		dAtA[i] = 0xc0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.HasMaxProperties {
-			dAtA[i] = 1
+
+	that1, ok := that.(*Srv)
+	if !ok {
+		that2, ok := that.(Srv)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.AdditionalProperties != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.AdditionalProperties.Size()))
-		n52, err := m.AdditionalProperties.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FuzzingProgress.Equal(that1.FuzzingProgress) {
+		return false
+	}
+	if that1.Msg == nil {
+		if this.Msg != nil {
+			return false
 		}
-		i += n52
+	} else if this.Msg == nil {
+		return false
+	} else if !this.Msg.Equal(that1.Msg) {
+		return false
 	}
-	if m.HasAdditionalProperties {
-		dAtA[i] = 0xd0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.HasAdditionalProperties {
-			dAtA[i] = 1
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Srv_FuzzRep_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Srv_FuzzRep_)
+	if !ok {
+		that2, ok := that.(Srv_FuzzRep_)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if len(m.AllOf) > 0 {
-		dAtA54 := make([]byte, len(m.AllOf)*10)
-		var j53 int
-		for _, num := range m.AllOf {
-			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j53++
-			}
-			dAtA54[j53] = uint8(num)
-			j53++
-		}
-		dAtA[i] = 0xda
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j53))
-		i += copy(dAtA[i:], dAtA54[:j53])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.AnyOf) > 0 {
-		dAtA56 := make([]byte, len(m.AnyOf)*10)
-		var j55 int
-		for _, num := range m.AnyOf {
-			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j55++
-			}
-			dAtA56[j55] = uint8(num)
-			j55++
-		}
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j55))
-		i += copy(dAtA[i:], dAtA56[:j55])
+	if !this.FuzzRep.Equal(that1.FuzzRep) {
+		return false
 	}
-	if len(m.OneOf) > 0 {
-		dAtA58 := make([]byte, len(m.OneOf)*10)
-		var j57 int
-		for _, num := range m.OneOf {
-			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j57++
-			}
-			dAtA58[j57] = uint8(num)
-			j57++
+	return true
+}
+func (this *Srv_Call_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Srv_Call_)
+	if !ok {
+		that2, ok := that.(Srv_Call_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j57))
-		i += copy(dAtA[i:], dAtA58[:j57])
 	}
-	if m.Not != 0 {
-		dAtA[i] = 0xf0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Not))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.Call.Equal(that1.Call) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *Schema_JSON_AdditionalProperties) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Srv_Reset_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Schema_JSON_AdditionalProperties) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.AddProps != nil {
-		nn59, err := m.AddProps.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Srv_Reset_)
+	if !ok {
+		that2, ok := that.(Srv_Reset_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += nn59
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x8
-	i++
-	if m.AlwaysSucceed {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if !this.Reset_.Equal(that1.Reset_) {
+		return false
 	}
-	i++
-	return i, nil
-}
-func (m *Schema_JSON_AdditionalProperties_SID) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
-	return i, nil
+	return true
 }
-func encodeVarintFuzzymonkey(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *Srv_FuzzingResult_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Clt) Size() (n int) {
-	if m == nil {
-		return 0
+
+	that1, ok := that.(*Srv_FuzzingResult_)
+	if !ok {
+		that2, ok := that.(Srv_FuzzingResult_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		n += m.Msg.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !this.FuzzingResult.Equal(that1.FuzzingResult) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *Clt_Fuzz_) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Srv_FuzzingProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Fuzz != nil {
-		l = m.Fuzz.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Srv_FuzzingProgress)
+	if !ok {
+		that2, ok := that.(Srv_FuzzingProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
-}
-func (m *Clt_ResetProgress_) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.ResetProgress != nil {
-		l = m.ResetProgress.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.Failure != that1.Failure {
+		return false
 	}
-	return n
-}
-func (m *Clt_CallRequestRaw_) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Success != that1.Success {
+		return false
 	}
-	var l int
-	_ = l
-	if m.CallRequestRaw != nil {
-		l = m.CallRequestRaw.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.LastCallSuccess != that1.LastCallSuccess {
+		return false
 	}
-	return n
-}
-func (m *Clt_CallResponseRaw_) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.LastCheckFailure != that1.LastCheckFailure {
+		return false
 	}
-	var l int
-	_ = l
-	if m.CallResponseRaw != nil {
-		l = m.CallResponseRaw.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.LastCheckSuccess != that1.LastCheckSuccess {
+		return false
 	}
-	return n
-}
-func (m *Clt_CallVerifProgress_) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TotalTestsCount != that1.TotalTestsCount {
+		return false
 	}
-	var l int
-	_ = l
-	if m.CallVerifProgress != nil {
-		l = m.CallVerifProgress.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.TotalCallsCount != that1.TotalCallsCount {
+		return false
 	}
-	return n
-}
-func (m *Clt_Fuzz) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TotalChecksCount != that1.TotalChecksCount {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Resetter != nil {
-		l = m.Resetter.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.TestCallsCount != that1.TestCallsCount {
+		return false
 	}
-	if m.Model != nil {
-		l = m.Model.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.CallChecksCount != that1.CallChecksCount {
+		return false
 	}
-	if len(m.Usage) > 0 {
-		for _, s := range m.Usage {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
-		}
+	if this.CallChecksSkipped != that1.CallChecksSkipped {
+		return false
 	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if m.Ntensity != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Ntensity))
+	return true
+}
+func (this *Srv_FuzzRep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.EIDs) > 0 {
-		l = 0
-		for _, e := range m.EIDs {
-			l += sovFuzzymonkey(uint64(e))
+
+	that1, ok := that.(*Srv_FuzzRep)
+	if !ok {
+		that2, ok := that.(Srv_FuzzRep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		n += 1 + sovFuzzymonkey(uint64(l)) + l
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.EnvRead) > 0 {
-		for k, v := range m.EnvRead {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
+	if this.MaxTestsCount != that1.MaxTestsCount {
+		return false
 	}
-	if len(m.UUIDs) > 0 {
-		for _, s := range m.UUIDs {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
-		}
+	if !bytes.Equal(this.Seed, that1.Seed) {
+		return false
 	}
-	if len(m.Files) > 0 {
-		for k, v := range m.Files {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
+	if this.Token != that1.Token {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.MaxExecutionStepsPerCheck != that1.MaxExecutionStepsPerCheck {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Srv_Call) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_Resetter) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Srv_Call)
+	if !ok {
+		that2, ok := that.(Srv_Call)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Resetter != nil {
-		n += m.Resetter.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	return n
-}
-
-func (m *Clt_Fuzz_Resetter_Shell_) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.EID != that1.EID {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Shell != nil {
-		l = m.Shell.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
-func (m *Clt_Fuzz_Resetter_Shell) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Srv_Call_Input) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Srv_Call_Input)
+	if !ok {
+		that2, ok := that.(Srv_Call_Input)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Rst)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Stop)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Srv_Call_Input_HttpRequest_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_Model) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Srv_Call_Input_HttpRequest_)
+	if !ok {
+		that2, ok := that.(Srv_Call_Input_HttpRequest_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Model != nil {
-		n += m.Model.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !this.HttpRequest.Equal(that1.HttpRequest) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Srv_Call_Input_HttpRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_Fuzz_Model_Openapiv3) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Srv_Call_Input_HttpRequest)
+	if !ok {
+		that2, ok := that.(Srv_Call_Input_HttpRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Openapiv3 != nil {
-		l = m.Openapiv3.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-func (m *Clt_Fuzz_Model_OpenAPIv3) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Method != that1.Method {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.File)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.Url != that1.Url {
+		return false
 	}
-	l = len(m.Host)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if len(this.Headers) != len(that1.Headers) {
+		return false
 	}
-	l = len(m.HeaderAuthorization)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(that1.Headers[i]) {
+			return false
+		}
 	}
-	if m.Spec != nil {
-		l = m.Spec.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !this.Body.Equal(that1.Body) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Srv_Call_Input_HttpRequest_HeaderValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_ResetProgress) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Srv_Call_Input_HttpRequest_HeaderValues)
+	if !ok {
+		that2, ok := that.(Srv_Call_Input_HttpRequest_HeaderValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Status))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Clt_CallRequestRaw) Size() (n int) {
-	if m == nil {
-		return 0
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	return true
+}
+func (this *Srv_Reset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Srv_Reset)
+	if !ok {
+		that2, ok := that.(Srv_Reset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Srv_FuzzingResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_CallRequestRaw_Input) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Srv_FuzzingResult)
+	if !ok {
+		that2, ok := that.(Srv_FuzzingResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.SeedUsed, that1.SeedUsed) {
+		return false
 	}
-	return n
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest_) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.WasShrinking != that1.WasShrinking {
+		return false
 	}
-	var l int
-	_ = l
-	if m.HttpRequest != nil {
-		l = m.HttpRequest.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !bytes.Equal(this.NextSeed, that1.NextSeed) {
+		return false
 	}
-	return n
-}
-func (m *Clt_CallRequestRaw_Input_HttpRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.WillNowShrink != that1.WillNowShrink {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !bytes.Equal(this.SuggestedSeed, that1.SuggestedSeed) {
+		return false
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if len(this.Counterexample) != len(that1.Counterexample) {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFuzzymonkey(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+	for i := range this.Counterexample {
+		if !this.Counterexample[i].Equal(that1.Counterexample[i]) {
+			return false
 		}
 	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
-	}
-	if m.BodyDecoded != nil {
-		l = m.BodyDecoded.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Srv_FuzzingResult_CounterexampleItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Srv_FuzzingResult_CounterexampleItem)
+	if !ok {
+		that2, ok := that.(Srv_FuzzingResult_CounterexampleItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *Clt_CallResponseRaw) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.CallRequest.Equal(that1.CallRequest) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !this.CallResponse.Equal(that1.CallResponse) {
+		return false
 	}
-	if m.OutputId != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.OutputId))
+	if len(this.Logs) != len(that1.Logs) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SpecIR) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_CallResponseRaw_Output) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SpecIR)
+	if !ok {
+		that2, ok := that.(SpecIR)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		n += m.Output.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !this.Schemas.Equal(that1.Schemas) {
+		return false
 	}
-	return n
-}
-
-func (m *Clt_CallResponseRaw_Output_HttpResponse_) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Endpoints) != len(that1.Endpoints) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.HttpResponse != nil {
-		l = m.HttpResponse.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	for i := range this.Endpoints {
+		if !this.Endpoints[i].Equal(that1.Endpoints[i]) {
+			return false
+		}
 	}
-	return n
-}
-func (m *Clt_CallResponseRaw_Output_HttpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	return true
+}
+func (this *Schemas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.StatusCode != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.StatusCode))
+
+	that1, ok := that.(*Schemas)
+	if !ok {
+		that2, ok := that.(Schemas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFuzzymonkey(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.BodyDecoded != nil {
-		l = m.BodyDecoded.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if len(this.Json) != len(that1.Json) {
+		return false
 	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	for i := range this.Json {
+		if !this.Json[i].Equal(that1.Json[i]) {
+			return false
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *RefOrSchemaJSON) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RefOrSchemaJSON)
+	if !ok {
+		that2, ok := that.(RefOrSchemaJSON)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PtrOrSchema == nil {
+		if this.PtrOrSchema != nil {
+			return false
 		}
+	} else if this.PtrOrSchema == nil {
+		return false
+	} else if !this.PtrOrSchema.Equal(that1.PtrOrSchema) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *RefOrSchemaJSON_Ptr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Clt_CallVerifProgress) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RefOrSchemaJSON_Ptr)
+	if !ok {
+		that2, ok := that.(RefOrSchemaJSON_Ptr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Status != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Status))
+	if !this.Ptr.Equal(that1.Ptr) {
+		return false
 	}
-	if m.Origin != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Origin))
+	return true
+}
+func (this *RefOrSchemaJSON_Schema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*RefOrSchemaJSON_Schema)
+	if !ok {
+		that2, ok := that.(RefOrSchemaJSON_Schema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
-	}
-	if m.ExecutionSteps != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.ExecutionSteps))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !this.Schema.Equal(that1.Schema) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *SchemaPtr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SchemaPtr)
+	if !ok {
+		that2, ok := that.(SchemaPtr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.FuzzingProgress != nil {
-		l = m.FuzzingProgress.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Msg != nil {
-		n += m.Msg.Size()
+	if this.SID != that1.SID {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Ref != that1.Ref {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *Endpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_FuzzRep_) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Endpoint)
+	if !ok {
+		that2, ok := that.(Endpoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.FuzzRep != nil {
-		l = m.FuzzRep.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-func (m *Srv_Call_) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1.Endpoint == nil {
+		if this.Endpoint != nil {
+			return false
+		}
+	} else if this.Endpoint == nil {
+		return false
+	} else if !this.Endpoint.Equal(that1.Endpoint) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Call != nil {
-		l = m.Call.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
-func (m *Srv_Reset_) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Endpoint_Json) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Reset_ != nil {
-		l = m.Reset_.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Endpoint_Json)
+	if !ok {
+		that2, ok := that.(Endpoint_Json)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
-}
-func (m *Srv_FuzzingResult_) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.FuzzingResult != nil {
-		l = m.FuzzingResult.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if !this.Json.Equal(that1.Json) {
+		return false
 	}
-	return n
+	return true
 }
-func (m *Srv_FuzzingProgress) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *EndpointJSON) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Failure {
-		n += 2
+
+	that1, ok := that.(*EndpointJSON)
+	if !ok {
+		that2, ok := that.(EndpointJSON)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Success {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LastCallSuccess {
-		n += 2
+	if this.Method != that1.Method {
+		return false
 	}
-	if m.LastCheckFailure {
-		n += 2
+	if len(this.PathPartials) != len(that1.PathPartials) {
+		return false
 	}
-	if m.LastCheckSuccess {
-		n += 2
+	for i := range this.PathPartials {
+		if !this.PathPartials[i].Equal(that1.PathPartials[i]) {
+			return false
+		}
 	}
-	if m.TotalTestsCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.TotalTestsCount))
+	if len(this.Inputs) != len(that1.Inputs) {
+		return false
 	}
-	if m.TotalCallsCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.TotalCallsCount))
+	for i := range this.Inputs {
+		if !this.Inputs[i].Equal(that1.Inputs[i]) {
+			return false
+		}
 	}
-	if m.TotalChecksCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.TotalChecksCount))
+	if len(this.Outputs) != len(that1.Outputs) {
+		return false
 	}
-	if m.TestCallsCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.TestCallsCount))
+	for i := range this.Outputs {
+		if this.Outputs[i] != that1.Outputs[i] {
+			return false
+		}
 	}
-	if m.CallChecksCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.CallChecksCount))
+	if this.OperationId != that1.OperationId {
+		return false
 	}
-	if m.CallChecksSkipped != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.CallChecksSkipped))
+	if len(this.Tags) != len(that1.Tags) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ParamJSON) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_FuzzRep) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ParamJSON)
+	if !ok {
+		that2, ok := that.(ParamJSON)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.MaxTestsCount != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.MaxTestsCount))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.IsRequired != that1.IsRequired {
+		return false
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.SID != that1.SID {
+		return false
 	}
-	if m.MaxExecutionStepsPerCheck != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.MaxExecutionStepsPerCheck))
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Kind != that1.Kind {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *PathPartial) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_Call) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PathPartial)
+	if !ok {
+		that2, ok := that.(PathPartial)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.EID != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.EID))
+	if that1.Pp == nil {
+		if this.Pp != nil {
+			return false
+		}
+	} else if this.Pp == nil {
+		return false
+	} else if !this.Pp.Equal(that1.Pp) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *PathPartial_Part) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_Call_Input) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PathPartial_Part)
+	if !ok {
+		that2, ok := that.(PathPartial_Part)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Part != that1.Part {
+		return false
 	}
-	return n
+	return true
 }
+func (this *PathPartial_Ptr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Srv_Call_Input_HttpRequest_) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PathPartial_Ptr)
+	if !ok {
+		that2, ok := that.(PathPartial_Ptr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.HttpRequest != nil {
-		l = m.HttpRequest.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Ptr != that1.Ptr {
+		return false
+	}
+	return true
 }
-func (m *Srv_Call_Input_HttpRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Schema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Schema)
+	if !ok {
+		that2, ok := that.(Schema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFuzzymonkey(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
-	}
-	if m.Body != nil {
-		l = m.Body.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *Srv_Call_Input_HttpRequest_HeaderValues) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Schema_JSON) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Schema_JSON)
+	if !ok {
+		that2, ok := that.(Schema_JSON)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *Srv_Reset) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Types) != len(that1.Types) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	for i := range this.Types {
+		if this.Types[i] != that1.Types[i] {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *Srv_FuzzingResult) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Enum) != len(that1.Enum) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.SeedUsed)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	for i := range this.Enum {
+		if !this.Enum[i].Equal(that1.Enum[i]) {
+			return false
+		}
 	}
-	if m.WasShrinking {
-		n += 2
+	if this.Format != that1.Format {
+		return false
 	}
-	l = len(m.NextSeed)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.MinLength != that1.MinLength {
+		return false
 	}
-	if m.WillNowShrink {
-		n += 2
+	if this.MaxLength != that1.MaxLength {
+		return false
 	}
-	l = len(m.SuggestedSeed)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.HasMaxLength != that1.HasMaxLength {
+		return false
 	}
-	if len(m.Counterexample) > 0 {
-		for _, e := range m.Counterexample {
-			l = e.Size()
-			n += 1 + l + sovFuzzymonkey(uint64(l))
-		}
+	if this.Pattern != that1.Pattern {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Minimum != that1.Minimum {
+		return false
 	}
-	return n
-}
-
-func (m *Srv_FuzzingResult_CounterexampleItem) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Maximum != that1.Maximum {
+		return false
 	}
-	var l int
-	_ = l
-	if m.CallRequest != nil {
-		l = m.CallRequest.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.HasMinimum != that1.HasMinimum {
+		return false
 	}
-	if m.CallResponse != nil {
-		l = m.CallResponse.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.HasMaximum != that1.HasMaximum {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.TranslatedMultipleOf != that1.TranslatedMultipleOf {
+		return false
 	}
-	return n
-}
-
-func (m *SpecIR) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.ExclusiveMinimum != that1.ExclusiveMinimum {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Schemas != nil {
-		l = m.Schemas.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.ExclusiveMaximum != that1.ExclusiveMaximum {
+		return false
 	}
-	if len(m.Endpoints) > 0 {
-		for k, v := range m.Endpoints {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFuzzymonkey(uint64(l))
-			}
-			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + l
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if this.Items[i] != that1.Items[i] {
+			return false
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.UniqueItems != that1.UniqueItems {
+		return false
 	}
-	return n
-}
-
-func (m *Schemas) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MinItems != that1.MinItems {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Json) > 0 {
-		for k, v := range m.Json {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovFuzzymonkey(uint64(l))
-			}
-			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + l
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
-		}
+	if this.MaxItems != that1.MaxItems {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.HasMaxItems != that1.HasMaxItems {
+		return false
 	}
-	return n
-}
-
-func (m *RefOrSchemaJSON) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Properties) != len(that1.Properties) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.PtrOrSchema != nil {
-		n += m.PtrOrSchema.Size()
+	for i := range this.Properties {
+		if this.Properties[i] != that1.Properties[i] {
+			return false
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(this.Required) != len(that1.Required) {
+		return false
 	}
-	return n
-}
-
-func (m *RefOrSchemaJSON_Ptr) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Required {
+		if this.Required[i] != that1.Required[i] {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Ptr != nil {
-		l = m.Ptr.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.MinProperties != that1.MinProperties {
+		return false
 	}
-	return n
-}
-func (m *RefOrSchemaJSON_Schema) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MaxProperties != that1.MaxProperties {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Schema != nil {
-		l = m.Schema.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.HasMaxProperties != that1.HasMaxProperties {
+		return false
 	}
-	return n
-}
-func (m *SchemaPtr) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.AdditionalProperties.Equal(that1.AdditionalProperties) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.SID != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.SID))
+	if this.HasAdditionalProperties != that1.HasAdditionalProperties {
+		return false
 	}
-	l = len(m.Ref)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if len(this.AllOf) != len(that1.AllOf) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	for i := range this.AllOf {
+		if this.AllOf[i] != that1.AllOf[i] {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *Endpoint) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.AnyOf) != len(that1.AnyOf) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Endpoint != nil {
-		n += m.Endpoint.Size()
+	for i := range this.AnyOf {
+		if this.AnyOf[i] != that1.AnyOf[i] {
+			return false
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(this.OneOf) != len(that1.OneOf) {
+		return false
 	}
-	return n
-}
-
-func (m *Endpoint_Json) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.OneOf {
+		if this.OneOf[i] != that1.OneOf[i] {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Json != nil {
-		l = m.Json.Size()
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	if this.Not != that1.Not {
+		return false
 	}
-	return n
-}
-func (m *EndpointJSON) Size() (n int) {
-	if m == nil {
-		return 0
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Method != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Method))
+	return true
+}
+func (this *Schema_JSON_AdditionalProperties) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.PathPartials) > 0 {
-		for _, e := range m.PathPartials {
-			l = e.Size()
-			n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Schema_JSON_AdditionalProperties)
+	if !ok {
+		that2, ok := that.(Schema_JSON_AdditionalProperties)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovFuzzymonkey(uint64(l))
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Outputs) > 0 {
-		for k, v := range m.Outputs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + 1 + sovFuzzymonkey(uint64(v))
-			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+	if that1.AddProps == nil {
+		if this.AddProps != nil {
+			return false
 		}
+	} else if this.AddProps == nil {
+		return false
+	} else if !this.AddProps.Equal(that1.AddProps) {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *ParamJSON) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRequired {
-		n += 2
-	}
-	if m.SID != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.SID))
+func (this *Schema_JSON_AdditionalProperties_AlwaysSucceed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+
+	that1, ok := that.(*Schema_JSON_AdditionalProperties_AlwaysSucceed)
+	if !ok {
+		that2, ok := that.(Schema_JSON_AdditionalProperties_AlwaysSucceed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Kind != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Kind))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.AlwaysSucceed != that1.AlwaysSucceed {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Schema_JSON_AdditionalProperties_SID) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PathPartial) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Schema_JSON_AdditionalProperties_SID)
+	if !ok {
+		that2, ok := that.(Schema_JSON_AdditionalProperties_SID)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Pp != nil {
-		n += m.Pp.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.SID != that1.SID {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *PathPartial_Part) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Part)
-	n += 1 + l + sovFuzzymonkey(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FuzzyMonkeyClient is the client API for FuzzyMonkey service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FuzzyMonkeyClient interface {
+	Do(ctx context.Context, opts ...grpc.CallOption) (FuzzyMonkey_DoClient, error)
 }
-func (m *PathPartial_Ptr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ptr)
-	n += 1 + l + sovFuzzymonkey(uint64(l))
-	return n
+
+type fuzzyMonkeyClient struct {
+	cc *grpc.ClientConn
 }
-func (m *Schema) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func NewFuzzyMonkeyClient(cc *grpc.ClientConn) FuzzyMonkeyClient {
+	return &fuzzyMonkeyClient{cc}
+}
+
+func (c *fuzzyMonkeyClient) Do(ctx context.Context, opts ...grpc.CallOption) (FuzzyMonkey_DoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FuzzyMonkey_serviceDesc.Streams[0], "/fm.FuzzyMonkey/Do", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	x := &fuzzyMonkeyDoClient{stream}
+	return x, nil
 }
 
-func (m *Schema_JSON) Size() (n int) {
-	if m == nil {
-		return 0
+type FuzzyMonkey_DoClient interface {
+	Send(*Clt) error
+	Recv() (*Srv, error)
+	grpc.ClientStream
+}
+
+type fuzzyMonkeyDoClient struct {
+	grpc.ClientStream
+}
+
+func (x *fuzzyMonkeyDoClient) Send(m *Clt) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *fuzzyMonkeyDoClient) Recv() (*Srv, error) {
+	m := new(Srv)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Types) > 0 {
-		l = 0
-		for _, e := range m.Types {
-			l += sovFuzzymonkey(uint64(e))
-		}
-		n += 1 + sovFuzzymonkey(uint64(l)) + l
+	return m, nil
+}
+
+// FuzzyMonkeyServer is the server API for FuzzyMonkey service.
+type FuzzyMonkeyServer interface {
+	Do(FuzzyMonkey_DoServer) error
+}
+
+// UnimplementedFuzzyMonkeyServer can be embedded to have forward compatible implementations.
+type UnimplementedFuzzyMonkeyServer struct {
+}
+
+func (*UnimplementedFuzzyMonkeyServer) Do(srv FuzzyMonkey_DoServer) error {
+	return status.Errorf(codes.Unimplemented, "method Do not implemented")
+}
+
+func RegisterFuzzyMonkeyServer(s *grpc.Server, srv FuzzyMonkeyServer) {
+	s.RegisterService(&_FuzzyMonkey_serviceDesc, srv)
+}
+
+func _FuzzyMonkey_Do_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FuzzyMonkeyServer).Do(&fuzzyMonkeyDoServer{stream})
+}
+
+type FuzzyMonkey_DoServer interface {
+	Send(*Srv) error
+	Recv() (*Clt, error)
+	grpc.ServerStream
+}
+
+type fuzzyMonkeyDoServer struct {
+	grpc.ServerStream
+}
+
+func (x *fuzzyMonkeyDoServer) Send(m *Srv) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *fuzzyMonkeyDoServer) Recv() (*Clt, error) {
+	m := new(Clt)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Enum) > 0 {
-		for _, e := range m.Enum {
-			l = e.Size()
-			n += 1 + l + sovFuzzymonkey(uint64(l))
-		}
+	return m, nil
+}
+
+var _FuzzyMonkey_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fm.FuzzyMonkey",
+	HandlerType: (*FuzzyMonkeyServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Do",
+			Handler:       _FuzzyMonkey_Do_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/internal/fm/fuzzymonkey.proto",
+}
+
+func (m *Clt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Format != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.Format))
+	return dAtA[:n], nil
+}
+
+func (m *Clt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MinLength != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.MinLength))
+	if m.Msg != nil {
+		{
+			size := m.Msg.Size()
+			i -= size
+			if _, err := m.Msg.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.MaxLength != 0 {
-		n += 1 + sovFuzzymonkey(uint64(m.MaxLength))
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fuzz != nil {
+		{
+			size, err := m.Fuzz.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.HasMaxLength {
-		n += 2
+	return len(dAtA) - i, nil
+}
+func (m *Clt_ResetProgress_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_ResetProgress_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResetProgress != nil {
+		{
+			size, err := m.ResetProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovFuzzymonkey(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CallRequestRaw != nil {
+		{
+			size, err := m.CallRequestRaw.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Minimum != 0 {
-		n += 9
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallResponseRaw_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CallResponseRaw != nil {
+		{
+			size, err := m.CallResponseRaw.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Maximum != 0 {
-		n += 9
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallVerifProgress_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallVerifProgress_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CallVerifProgress != nil {
+		{
+			size, err := m.CallVerifProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.HasMinimum {
-		n += 2
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasMaximum {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TranslatedMultipleOf != 0 {
-		n += 9
+	if len(m.Files) > 0 {
+		for k := range m.Files {
+			v := m.Files[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ExclusiveMinimum {
-		n += 2
+	if len(m.UUIDs) > 0 {
+		for iNdEx := len(m.UUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UUIDs[iNdEx])
+			copy(dAtA[i:], m.UUIDs[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.UUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.ExclusiveMaximum {
-		n += 2
+	if len(m.EnvRead) > 0 {
+		for k := range m.EnvRead {
+			v := m.EnvRead[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if len(m.Items) > 0 {
-		l = 0
-		for _, e := range m.Items {
-			l += sovFuzzymonkey(uint64(e))
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
 		}
-		n += 1 + sovFuzzymonkey(uint64(l)) + l
 	}
-	if m.UniqueItems {
-		n += 3
+	if len(m.EIDs) > 0 {
+		dAtA7 := make([]byte, len(m.EIDs)*10)
+		var j6 int
+		for _, num := range m.EIDs {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.MinItems != 0 {
-		n += 2 + sovFuzzymonkey(uint64(m.MinItems))
+	if m.Ntensity != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Ntensity))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaxItems != 0 {
-		n += 2 + sovFuzzymonkey(uint64(m.MaxItems))
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.HasMaxItems {
-		n += 3
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Usage[iNdEx])
+			copy(dAtA[i:], m.Usage[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Usage[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Properties) > 0 {
-		for k, v := range m.Properties {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + sovFuzzymonkey(uint64(v))
-			n += mapEntrySize + 2 + sovFuzzymonkey(uint64(mapEntrySize))
+	if m.Model != nil {
+		{
+			size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Required) > 0 {
-		for _, s := range m.Required {
-			l = len(s)
-			n += 2 + l + sovFuzzymonkey(uint64(l))
+	if m.Resetter != nil {
+		{
+			size, err := m.Resetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MinProperties != 0 {
-		n += 2 + sovFuzzymonkey(uint64(m.MinProperties))
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxProperties != 0 {
-		n += 2 + sovFuzzymonkey(uint64(m.MaxProperties))
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HasMaxProperties {
-		n += 3
+	if m.Resetter != nil {
+		{
+			size := m.Resetter.Size()
+			i -= size
+			if _, err := m.Resetter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.AdditionalProperties != nil {
-		l = m.AdditionalProperties.Size()
-		n += 2 + l + sovFuzzymonkey(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter_Shell_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Shell_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Shell != nil {
+		{
+			size, err := m.Shell.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.HasAdditionalProperties {
-		n += 3
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Process_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Process_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Process != nil {
+		{
+			size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AllOf) > 0 {
-		l = 0
-		for _, e := range m.AllOf {
-			l += sovFuzzymonkey(uint64(e))
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Http) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Http) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Http != nil {
+		{
+			size, err := m.Http.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
 		}
-		n += 2 + sovFuzzymonkey(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.AnyOf) > 0 {
-		l = 0
-		for _, e := range m.AnyOf {
-			l += sovFuzzymonkey(uint64(e))
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Starlark_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Starlark_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Starlark != nil {
+		{
+			size, err := m.Starlark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
 		}
-		n += 2 + sovFuzzymonkey(uint64(l)) + l
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.OneOf) > 0 {
-		l = 0
-		for _, e := range m.OneOf {
-			l += sovFuzzymonkey(uint64(e))
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Snapshot_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Snapshot_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
 		}
-		n += 2 + sovFuzzymonkey(uint64(l)) + l
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Not != 0 {
-		n += 2 + sovFuzzymonkey(uint64(m.Not))
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Sequence_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Sequence_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sequence != nil {
+		{
+			size, err := m.Sequence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Shell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Shell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Shell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LogFile) > 0 {
+		i -= len(m.LogFile)
+		copy(dAtA[i:], m.LogFile)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.LogFile)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if m.EnvSnapshotTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.EnvSnapshotTimeoutNs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ExecTimeoutNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Shell) > 0 {
+		i -= len(m.Shell)
+		copy(dAtA[i:], m.Shell)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Shell)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stop) > 0 {
+		i -= len(m.Stop)
+		copy(dAtA[i:], m.Stop)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Stop)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rst) > 0 {
+		i -= len(m.Rst)
+		copy(dAtA[i:], m.Rst)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Rst)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Schema_JSON_AdditionalProperties) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Clt_Fuzz_Resetter_Process) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Process) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Process) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AddProps != nil {
-		n += m.AddProps.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LogFile) > 0 {
+		i -= len(m.LogFile)
+		copy(dAtA[i:], m.LogFile)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.LogFile)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if m.RestartOnReset {
+		i--
+		if m.RestartOnReset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadyTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ReadyTimeoutNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReadyTcp) > 0 {
+		i -= len(m.ReadyTcp)
+		copy(dAtA[i:], m.ReadyTcp)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.ReadyTcp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReadyHttp) > 0 {
+		i -= len(m.ReadyHttp)
+		copy(dAtA[i:], m.ReadyHttp)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.ReadyHttp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Cmd) > 0 {
+		for iNdEx := len(m.Cmd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cmd[iNdEx])
+			copy(dAtA[i:], m.Cmd[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Cmd[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Clt_Fuzz_Resetter_HTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_HTTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 2
-	return n
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TimeoutNs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpectedStatus != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ExpectedStatus))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Schema_JSON_AdditionalProperties_SID) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Clt_Fuzz_Resetter_Starlark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Starlark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Starlark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ExecTimeoutNs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Stop) > 0 {
+		i -= len(m.Stop)
+		copy(dAtA[i:], m.Stop)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Stop)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rst) > 0 {
+		i -= len(m.Rst)
+		copy(dAtA[i:], m.Rst)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Rst)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter_Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Process != nil {
+		{
+			size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter_Sequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Sequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Resetters) > 0 {
+		for iNdEx := len(m.Resetters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resetters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Model != nil {
+		{
+			size := m.Model.Size()
+			i -= size
+			if _, err := m.Model.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Openapiv3 != nil {
+		{
+			size, err := m.Openapiv3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Model_Graphql) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Graphql) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Graphql != nil {
+		{
+			size, err := m.Graphql.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Model_Grpc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Grpc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Grpc != nil {
+		{
+			size, err := m.Grpc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Model_Asyncapi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Asyncapi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Asyncapi != nil {
+		{
+			size, err := m.Asyncapi.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Model_Openrpc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Openrpc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Openrpc != nil {
+		{
+			size, err := m.Openrpc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Overrides) > 0 {
+		for k := range m.Overrides {
+			v := m.Overrides[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Query) > 0 {
+		for k := range m.Query {
+			v := m.Query[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.MaxRetryWaitNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxRetryWaitNs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.HonorRetryAfter {
+		i--
+		if m.HonorRetryAfter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxCallsPerSecond != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxCallsPerSecond))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.StreamDurationNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.StreamDurationNs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CookieJar {
+		i--
+		if m.CookieJar {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxResponseBytes != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxResponseBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ReadTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ReadTimeoutNs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RequestTimeoutNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.RequestTimeoutNs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.FreshConnectionsPerTest {
+		i--
+		if m.FreshConnectionsPerTest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxIdleConnsPerHost != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxIdleConnsPerHost))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxConnsPerHost != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxConnsPerHost))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Http2 {
+		i--
+		if m.Http2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TlsServerName) > 0 {
+		i -= len(m.TlsServerName)
+		copy(dAtA[i:], m.TlsServerName)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.TlsServerName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TlsInsecureSkipVerify {
+		i--
+		if m.TlsInsecureSkipVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TlsKeyFile) > 0 {
+		i -= len(m.TlsKeyFile)
+		copy(dAtA[i:], m.TlsKeyFile)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.TlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TlsCertFile) > 0 {
+		i -= len(m.TlsCertFile)
+		copy(dAtA[i:], m.TlsCertFile)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.TlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TlsCaFile) > 0 {
+		i -= len(m.TlsCaFile)
+		copy(dAtA[i:], m.TlsCaFile)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.TlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Proxy) > 0 {
+		i -= len(m.Proxy)
+		copy(dAtA[i:], m.Proxy)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Proxy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HeaderAuthorization) > 0 {
+		i -= len(m.HeaderAuthorization)
+		copy(dAtA[i:], m.HeaderAuthorization)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.HeaderAuthorization)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		for k := range m.Query {
+			v := m.Query[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_GraphQL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_GraphQL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_GraphQL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Http != nil {
+		{
+			size, err := m.Http.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_GRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_GRPC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_GRPC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtoFiles) > 0 {
+		for iNdEx := len(m.ProtoFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProtoFiles[iNdEx])
+			copy(dAtA[i:], m.ProtoFiles[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.ProtoFiles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_AsyncAPI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_AsyncAPI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_AsyncAPI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ReceiveWindowNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ReceiveWindowNs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_OpenRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model_OpenRPC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_OpenRPC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Http != nil {
+		{
+			size, err := m.Http.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_ResetProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_ResetProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_ResetProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HttpRequest != nil {
+		{
+			size, err := m.HttpRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GrpcRequest != nil {
+		{
+			size, err := m.GrpcRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw_Input_WebsocketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_WebsocketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WebsocketRequest != nil {
+		{
+			size, err := m.WebsocketRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Graphql != nil {
+		{
+			size, err := m.Graphql.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UnixSocket) > 0 {
+		i -= len(m.UnixSocket)
+		copy(dAtA[i:], m.UnixSocket)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.UnixSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BodyDecoded != nil {
+		{
+			size, err := m.BodyDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Variables != nil {
+		{
+			size, err := m.Variables.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BodyDecoded != nil {
+		{
+			size, err := m.BodyDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BodyDecoded != nil {
+		{
+			size, err := m.BodyDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OutputId != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.OutputId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Output != nil {
+		{
+			size := m.Output.Size()
+			i -= size
+			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HttpResponse != nil {
+		{
+			size, err := m.HttpResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GrpcResponse != nil {
+		{
+			size, err := m.GrpcResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallResponseRaw_Output_WebsocketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_WebsocketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WebsocketResponse != nil {
+		{
+			size, err := m.WebsocketResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StreamCut {
+		i--
+		if m.StreamCut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Connection != nil {
+		{
+			size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Timings != nil {
+		{
+			size, err := m.Timings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BodyDecoded != nil {
+		{
+			size, err := m.BodyDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StatusCode != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.StatusCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TransferNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TransferNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TtfbNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TtfbNs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TlsHandshakeNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TlsHandshakeNs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConnectNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ConnectNs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DnsNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.DnsNs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StatusCode != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.StatusCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tls != nil {
+		{
+			size, err := m.Tls.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reused {
+		i--
+		if m.Reused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LocalPort != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.LocalPort))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LocalIp) > 0 {
+		i -= len(m.LocalIp)
+		copy(dAtA[i:], m.LocalIp)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.LocalIp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RemotePort != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.RemotePort))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RemoteIp) > 0 {
+		i -= len(m.RemoteIp)
+		copy(dAtA[i:], m.RemoteIp)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.RemoteIp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PeerSubject) > 0 {
+		i -= len(m.PeerSubject)
+		copy(dAtA[i:], m.PeerSubject)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.PeerSubject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CipherSuite) > 0 {
+		i -= len(m.CipherSuite)
+		copy(dAtA[i:], m.CipherSuite)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.CipherSuite)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DataDecoded != nil {
+		{
+			size, err := m.DataDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BodyDecoded != nil {
+		{
+			size, err := m.BodyDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Trailers) > 0 {
+		for k := range m.Trailers {
+			v := m.Trailers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataDecoded != nil {
+		{
+			size, err := m.DataDecoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sent {
+		i--
+		if m.Sent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallResponseRaw_LogLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw_LogLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_LogLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OffsetNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.OffsetNs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Line) > 0 {
+		i -= len(m.Line)
+		copy(dAtA[i:], m.Line)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Line)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_CallVerifProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallVerifProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clt_CallVerifProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutionSteps != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ExecutionSteps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Origin != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Msg != nil {
+		{
+			size := m.Msg.Size()
+			i -= size
+			if _, err := m.Msg.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.FuzzingProgress != nil {
+		{
+			size, err := m.FuzzingProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzRep_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzRep_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FuzzRep != nil {
+		{
+			size, err := m.FuzzRep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Srv_Call_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Call != nil {
+		{
+			size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Srv_Reset_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Reset_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reset_ != nil {
+		{
+			size, err := m.Reset_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Srv_FuzzingResult_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FuzzingResult != nil {
+		{
+			size, err := m.FuzzingResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Srv_FuzzingProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzingProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzingProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CallChecksSkipped != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallChecksSkipped))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CallChecksCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.CallChecksCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TestCallsCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TestCallsCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TotalChecksCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalChecksCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalCallsCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalCallsCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalTestsCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.TotalTestsCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastCheckSuccess {
+		i--
+		if m.LastCheckSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastCheckFailure {
+		i--
+		if m.LastCheckFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastCallSuccess {
+		i--
+		if m.LastCallSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Failure {
+		i--
+		if m.Failure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzRep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzRep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzRep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxExecutionStepsPerCheck != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxExecutionStepsPerCheck))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxTestsCount != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxTestsCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Call) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Call) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EID != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.EID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Call_Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Call_Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call_Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Call_Input_HttpRequest_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call_Input_HttpRequest_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HttpRequest != nil {
+		{
+			size, err := m.HttpRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Srv_Call_Input_HttpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Call_Input_HttpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call_Input_HttpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Reset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Reset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_Reset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzingResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzingResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Counterexample) > 0 {
+		for iNdEx := len(m.Counterexample) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counterexample[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SuggestedSeed) > 0 {
+		i -= len(m.SuggestedSeed)
+		copy(dAtA[i:], m.SuggestedSeed)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.SuggestedSeed)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WillNowShrink {
+		i--
+		if m.WillNowShrink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextSeed) > 0 {
+		i -= len(m.NextSeed)
+		copy(dAtA[i:], m.NextSeed)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.NextSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WasShrinking {
+		i--
+		if m.WasShrinking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SeedUsed) > 0 {
+		i -= len(m.SeedUsed)
+		copy(dAtA[i:], m.SeedUsed)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.SeedUsed)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CallResponse != nil {
+		{
+			size, err := m.CallResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CallRequest != nil {
+		{
+			size, err := m.CallRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecIR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecIR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecIR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Endpoints) > 0 {
+		for k := range m.Endpoints {
+			v := m.Endpoints[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Schemas != nil {
+		{
+			size, err := m.Schemas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schemas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schemas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schemas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Json) > 0 {
+		for k := range m.Json {
+			v := m.Json[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefOrSchemaJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefOrSchemaJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PtrOrSchema != nil {
+		{
+			size := m.PtrOrSchema.Size()
+			i -= size
+			if _, err := m.PtrOrSchema.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefOrSchemaJSON_Ptr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON_Ptr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ptr != nil {
+		{
+			size, err := m.Ptr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RefOrSchemaJSON_Schema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON_Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchemaPtr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaPtr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaPtr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SID != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Endpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Endpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Endpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Endpoint != nil {
+		{
+			size := m.Endpoint.Size()
+			i -= size
+			if _, err := m.Endpoint.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Endpoint_Json) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Endpoint_Json) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Json != nil {
+		{
+			size, err := m.Json.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EndpointJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Outputs) > 0 {
+		for k := range m.Outputs {
+			v := m.Outputs[k]
+			baseI := i
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PathPartials) > 0 {
+		for iNdEx := len(m.PathPartials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathPartials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Method != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Kind != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SID != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsRequired {
+		i--
+		if m.IsRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PathPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PathPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PathPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pp != nil {
+		{
+			size := m.Pp.Size()
+			i -= size
+			if _, err := m.Pp.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PathPartial_Part) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PathPartial_Part) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Part)
+	copy(dAtA[i:], m.Part)
+	i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Part)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *PathPartial_Ptr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PathPartial_Ptr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Ptr)
+	copy(dAtA[i:], m.Ptr)
+	i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Ptr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *Schema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schema_JSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schema_JSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema_JSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Not != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Not))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.OneOf) > 0 {
+		dAtA72 := make([]byte, len(m.OneOf)*10)
+		var j71 int
+		for _, num := range m.OneOf {
+			for num >= 1<<7 {
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j71++
+			}
+			dAtA72[j71] = uint8(num)
+			j71++
+		}
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j71))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.AnyOf) > 0 {
+		dAtA74 := make([]byte, len(m.AnyOf)*10)
+		var j73 int
+		for _, num := range m.AnyOf {
+			for num >= 1<<7 {
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j73++
+			}
+			dAtA74[j73] = uint8(num)
+			j73++
+		}
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j73))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.AllOf) > 0 {
+		dAtA76 := make([]byte, len(m.AllOf)*10)
+		var j75 int
+		for _, num := range m.AllOf {
+			for num >= 1<<7 {
+				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j75++
+			}
+			dAtA76[j75] = uint8(num)
+			j75++
+		}
+		i -= j75
+		copy(dAtA[i:], dAtA76[:j75])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j75))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.HasAdditionalProperties {
+		i--
+		if m.HasAdditionalProperties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.AdditionalProperties != nil {
+		{
+			size, err := m.AdditionalProperties.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HasMaxProperties {
+		i--
+		if m.HasMaxProperties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxProperties != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxProperties))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MinProperties != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinProperties))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Properties) > 0 {
+		for k := range m.Properties {
+			v := m.Properties[k]
+			baseI := i
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintFuzzymonkey(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.HasMaxItems {
+		i--
+		if m.HasMaxItems {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxItems != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxItems))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MinItems != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinItems))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UniqueItems {
+		i--
+		if m.UniqueItems {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Items) > 0 {
+		dAtA79 := make([]byte, len(m.Items)*10)
+		var j78 int
+		for _, num := range m.Items {
+			for num >= 1<<7 {
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j78++
+			}
+			dAtA79[j78] = uint8(num)
+			j78++
+		}
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j78))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ExclusiveMaximum {
+		i--
+		if m.ExclusiveMaximum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ExclusiveMinimum {
+		i--
+		if m.ExclusiveMinimum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TranslatedMultipleOf != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TranslatedMultipleOf))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.HasMaximum {
+		i--
+		if m.HasMaximum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HasMinimum {
+		i--
+		if m.HasMinimum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Maximum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Maximum))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.Minimum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Minimum))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasMaxLength {
+		i--
+		if m.HasMaxLength {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxLength != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinLength != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.MinLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Format != 0 {
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Enum) > 0 {
+		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enum[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFuzzymonkey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Types) > 0 {
+		dAtA81 := make([]byte, len(m.Types)*10)
+		var j80 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j80++
+			}
+			dAtA81[j80] = uint8(num)
+			j80++
+		}
+		i -= j80
+		copy(dAtA[i:], dAtA81[:j80])
+		i = encodeVarintFuzzymonkey(dAtA, i, uint64(j80))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schema_JSON_AdditionalProperties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schema_JSON_AdditionalProperties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema_JSON_AdditionalProperties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AddProps != nil {
+		{
+			size := m.AddProps.Size()
+			i -= size
+			if _, err := m.AddProps.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.AlwaysSucceed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *Schema_JSON_AdditionalProperties_SID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema_JSON_AdditionalProperties_SID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintFuzzymonkey(dAtA, i, uint64(m.SID))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func encodeVarintFuzzymonkey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFuzzymonkey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Clt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		n += m.Msg.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fuzz != nil {
+		l = m.Fuzz.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_ResetProgress_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetProgress != nil {
+		l = m.ResetProgress.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallRequestRaw != nil {
+		l = m.CallRequestRaw.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallResponseRaw != nil {
+		l = m.CallResponseRaw.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallVerifProgress_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallVerifProgress != nil {
+		l = m.CallVerifProgress.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resetter != nil {
+		l = m.Resetter.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Model != nil {
+		l = m.Model.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Usage) > 0 {
+		for _, s := range m.Usage {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Ntensity != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Ntensity))
+	}
+	if len(m.EIDs) > 0 {
+		l = 0
+		for _, e := range m.EIDs {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 1 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.EnvRead) > 0 {
+		for k, v := range m.EnvRead {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.UUIDs) > 0 {
+		for _, s := range m.UUIDs {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if len(m.Files) > 0 {
+		for k, v := range m.Files {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resetter != nil {
+		n += m.Resetter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Shell_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shell != nil {
+		l = m.Shell.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Process_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Http) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Http != nil {
+		l = m.Http.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Starlark_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Starlark != nil {
+		l = m.Starlark.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Snapshot_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Sequence_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != nil {
+		l = m.Sequence.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Shell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Rst)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Stop)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Shell)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ExecTimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ExecTimeoutNs))
+	}
+	if m.EnvSnapshotTimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.EnvSnapshotTimeoutNs))
+	}
+	l = len(m.LogFile)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Process) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ReadyHttp)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.ReadyTcp)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ReadyTimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ReadyTimeoutNs))
+	}
+	if m.RestartOnReset {
+		n += 2
+	}
+	l = len(m.LogFile)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_HTTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ExpectedStatus != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ExpectedStatus))
+	}
+	if m.TimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TimeoutNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Starlark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Rst)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Stop)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ExecTimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ExecTimeoutNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Sequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resetters) > 0 {
+		for _, e := range m.Resetters {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Model != nil {
+		n += m.Model.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Openapiv3 != nil {
+		l = m.Openapiv3.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_Graphql) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Graphql != nil {
+		l = m.Graphql.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_Grpc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Grpc != nil {
+		l = m.Grpc.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_Asyncapi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Asyncapi != nil {
+		l = m.Asyncapi.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_Openrpc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Openrpc != nil {
+		l = m.Openrpc.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.HeaderAuthorization)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Proxy)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.TlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.TlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.TlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.TlsInsecureSkipVerify {
+		n += 2
+	}
+	l = len(m.TlsServerName)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Http2 {
+		n += 2
+	}
+	if m.MaxConnsPerHost != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MaxConnsPerHost))
+	}
+	if m.MaxIdleConnsPerHost != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MaxIdleConnsPerHost))
+	}
+	if m.FreshConnectionsPerTest {
+		n += 2
+	}
+	if m.RequestTimeoutNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.RequestTimeoutNs))
+	}
+	if m.ReadTimeoutNs != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.ReadTimeoutNs))
+	}
+	if m.MaxResponseBytes != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MaxResponseBytes))
+	}
+	if m.CookieJar {
+		n += 3
+	}
+	if m.StreamDurationNs != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.StreamDurationNs))
+	}
+	if m.MaxCallsPerSecond != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MaxCallsPerSecond))
+	}
+	if m.HonorRetryAfter {
+		n += 3
+	}
+	if m.MaxRetryWaitNs != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MaxRetryWaitNs))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 2 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 2 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Query) > 0 {
+		for k, v := range m.Query {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 2 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for k, v := range m.Overrides {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Query) > 0 {
+		for k, v := range m.Query {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_GraphQL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Http != nil {
+		l = m.Http.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_GRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtoFiles) > 0 {
+		for _, s := range m.ProtoFiles {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_AsyncAPI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ReceiveWindowNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ReceiveWindowNs))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + len(v) + sovFuzzymonkey(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_Fuzz_Model_OpenRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Http != nil {
+		l = m.Http.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_ResetProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Status))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpRequest != nil {
+		l = m.HttpRequest.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrpcRequest != nil {
+		l = m.GrpcRequest.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw_Input_WebsocketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WebsocketRequest != nil {
+		l = m.WebsocketRequest.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		l = m.BodyDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.UnixSocket)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Graphql != nil {
+		l = m.Graphql.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Variables != nil {
+		l = m.Variables.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		l = m.BodyDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		l = m.BodyDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.OutputId != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.OutputId))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		n += m.Output.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpResponse != nil {
+		l = m.HttpResponse.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrpcResponse != nil {
+		l = m.GrpcResponse.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw_Output_WebsocketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WebsocketResponse != nil {
+		l = m.WebsocketResponse.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.StatusCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		l = m.BodyDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if m.Timings != nil {
+		l = m.Timings.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.StreamCut {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DnsNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.DnsNs))
+	}
+	if m.ConnectNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ConnectNs))
+	}
+	if m.TlsHandshakeNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TlsHandshakeNs))
+	}
+	if m.TtfbNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TtfbNs))
+	}
+	if m.TransferNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TransferNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.StatusCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoteIp)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.RemotePort != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.RemotePort))
+	}
+	l = len(m.LocalIp)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.LocalPort != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.LocalPort))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Reused {
+		n += 2
+	}
+	if m.Tls != nil {
+		l = m.Tls.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.CipherSuite)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.PeerSubject)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.DataDecoded != nil {
+		l = m.DataDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Code))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Trailers) > 0 {
+		for k, v := range m.Trailers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		l = m.BodyDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sent {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.DataDecoded != nil {
+		l = m.DataDecoded.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallResponseRaw_LogLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.OffsetNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.OffsetNs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clt_CallVerifProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Status))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Origin))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ElapsedNs))
+	}
+	if m.ExecutionSteps != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.ExecutionSteps))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzingProgress != nil {
+		l = m.FuzzingProgress.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Msg != nil {
+		n += m.Msg.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_FuzzRep_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzRep != nil {
+		l = m.FuzzRep.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Call_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Call != nil {
+		l = m.Call.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Reset_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reset_ != nil {
+		l = m.Reset_.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Srv_FuzzingResult_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzingResult != nil {
+		l = m.FuzzingResult.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Srv_FuzzingProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failure {
+		n += 2
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.LastCallSuccess {
+		n += 2
+	}
+	if m.LastCheckFailure {
+		n += 2
+	}
+	if m.LastCheckSuccess {
+		n += 2
+	}
+	if m.TotalTestsCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TotalTestsCount))
+	}
+	if m.TotalCallsCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TotalCallsCount))
+	}
+	if m.TotalChecksCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TotalChecksCount))
+	}
+	if m.TestCallsCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.TestCallsCount))
+	}
+	if m.CallChecksCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.CallChecksCount))
+	}
+	if m.CallChecksSkipped != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.CallChecksSkipped))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_FuzzRep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTestsCount != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MaxTestsCount))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.MaxExecutionStepsPerCheck != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MaxExecutionStepsPerCheck))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_Call) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.EID != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.EID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_Call_Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_Call_Input_HttpRequest_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpRequest != nil {
+		l = m.HttpRequest.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Call_Input_HttpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.Body != nil {
+		l = m.Body.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_Call_Input_HttpRequest_HeaderValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_Reset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_FuzzingResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeedUsed)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.WasShrinking {
+		n += 2
+	}
+	l = len(m.NextSeed)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.WillNowShrink {
+		n += 2
+	}
+	l = len(m.SuggestedSeed)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Counterexample) > 0 {
+		for _, e := range m.Counterexample {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallRequest != nil {
+		l = m.CallRequest.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.CallResponse != nil {
+		l = m.CallResponse.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecIR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schemas != nil {
+		l = m.Schemas.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for k, v := range m.Endpoints {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Schemas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		for k, v := range m.Json {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovFuzzymonkey(uint64(l))
+			}
+			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + l
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefOrSchemaJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PtrOrSchema != nil {
+		n += m.PtrOrSchema.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefOrSchemaJSON_Ptr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ptr != nil {
+		l = m.Ptr.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *RefOrSchemaJSON_Schema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *SchemaPtr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SID != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.SID))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Endpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Endpoint != nil {
+		n += m.Endpoint.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Endpoint_Json) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Json != nil {
+		l = m.Json.Size()
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	return n
+}
+func (m *EndpointJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Method != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Method))
+	}
+	if len(m.PathPartials) > 0 {
+		for _, e := range m.PathPartials {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for k, v := range m.Outputs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovFuzzymonkey(uint64(k)) + 1 + sovFuzzymonkey(uint64(v))
+			n += mapEntrySize + 1 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ParamJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRequired {
+		n += 2
+	}
+	if m.SID != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.SID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Kind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PathPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pp != nil {
+		n += m.Pp.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PathPartial_Part) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Part)
+	n += 1 + l + sovFuzzymonkey(uint64(l))
+	return n
+}
+func (m *PathPartial_Ptr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ptr)
+	n += 1 + l + sovFuzzymonkey(uint64(l))
+	return n
+}
+func (m *Schema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Schema_JSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 1 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if len(m.Enum) > 0 {
+		for _, e := range m.Enum {
+			l = e.Size()
+			n += 1 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.Format != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.Format))
+	}
+	if m.MinLength != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MinLength))
+	}
+	if m.MaxLength != 0 {
+		n += 1 + sovFuzzymonkey(uint64(m.MaxLength))
+	}
+	if m.HasMaxLength {
+		n += 2
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.Minimum != 0 {
+		n += 9
+	}
+	if m.Maximum != 0 {
+		n += 9
+	}
+	if m.HasMinimum {
+		n += 2
+	}
+	if m.HasMaximum {
+		n += 2
+	}
+	if m.TranslatedMultipleOf != 0 {
+		n += 9
+	}
+	if m.ExclusiveMinimum {
+		n += 2
+	}
+	if m.ExclusiveMaximum {
+		n += 2
+	}
+	if len(m.Items) > 0 {
+		l = 0
+		for _, e := range m.Items {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 1 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if m.UniqueItems {
+		n += 3
+	}
+	if m.MinItems != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MinItems))
+	}
+	if m.MaxItems != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MaxItems))
+	}
+	if m.HasMaxItems {
+		n += 3
+	}
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFuzzymonkey(uint64(len(k))) + 1 + sovFuzzymonkey(uint64(v))
+			n += mapEntrySize + 2 + sovFuzzymonkey(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 2 + l + sovFuzzymonkey(uint64(l))
+		}
+	}
+	if m.MinProperties != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MinProperties))
+	}
+	if m.MaxProperties != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.MaxProperties))
+	}
+	if m.HasMaxProperties {
+		n += 3
+	}
+	if m.AdditionalProperties != nil {
+		l = m.AdditionalProperties.Size()
+		n += 2 + l + sovFuzzymonkey(uint64(l))
+	}
+	if m.HasAdditionalProperties {
+		n += 3
+	}
+	if len(m.AllOf) > 0 {
+		l = 0
+		for _, e := range m.AllOf {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 2 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if len(m.AnyOf) > 0 {
+		l = 0
+		for _, e := range m.AnyOf {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 2 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if len(m.OneOf) > 0 {
+		l = 0
+		for _, e := range m.OneOf {
+			l += sovFuzzymonkey(uint64(e))
+		}
+		n += 2 + sovFuzzymonkey(uint64(l)) + l
+	}
+	if m.Not != 0 {
+		n += 2 + sovFuzzymonkey(uint64(m.Not))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Schema_JSON_AdditionalProperties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddProps != nil {
+		n += m.AddProps.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *Schema_JSON_AdditionalProperties_SID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovFuzzymonkey(uint64(m.SID))
+	return n
+}
+
+func sovFuzzymonkey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFuzzymonkey(x uint64) (n int) {
+	return sovFuzzymonkey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Clt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fuzz", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &Clt_Fuzz_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_ResetProgress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &Clt_ResetProgress_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallRequestRaw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallRequestRaw{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &Clt_CallRequestRaw_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallResponseRaw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallResponseRaw{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &Clt_CallResponseRaw_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallVerifProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallVerifProgress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &Clt_CallVerifProgress_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fuzz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fuzz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resetter == nil {
+				m.Resetter = &Clt_Fuzz_Resetter{}
+			}
+			if err := m.Resetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Model == nil {
+				m.Model = &Clt_Fuzz_Model{}
+			}
+			if err := m.Model.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ntensity", wireType)
+			}
+			m.Ntensity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ntensity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EIDs = append(m.EIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EIDs) == 0 {
+					m.EIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EIDs = append(m.EIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIDs", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnvRead == nil {
+				m.EnvRead = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnvRead[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UUIDs = append(m.UUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Files == nil {
+				m.Files = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Files[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_Shell{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Shell_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_Process{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Process_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_HTTP{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Http{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starlark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_Starlark{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Starlark_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_Snapshot{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Snapshot_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Resetter_Sequence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resetter = &Clt_Fuzz_Resetter_Sequence_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTimeoutNs", wireType)
+			}
+			m.ExecTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvSnapshotTimeoutNs", wireType)
+			}
+			m.EnvSnapshotTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnvSnapshotTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Process) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyHttp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadyHttp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTcp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadyTcp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTimeoutNs", wireType)
+			}
+			m.ReadyTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartOnReset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartOnReset = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_HTTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedStatus", wireType)
+			}
+			m.ExpectedStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedStatus |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutNs", wireType)
+			}
+			m.TimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Starlark) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Starlark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Starlark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTimeoutNs", wireType)
+			}
+			m.ExecTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Clt_Fuzz_Resetter_Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Sequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resetters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resetters = append(m.Resetters, &Clt_Fuzz_Resetter{})
+			if err := m.Resetters[len(m.Resetters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Openapiv3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Model_OpenAPIv3{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Model = &Clt_Fuzz_Model_Openapiv3{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphql", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Model_GraphQL{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Model = &Clt_Fuzz_Model_Graphql{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grpc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Model_GRPC{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Model = &Clt_Fuzz_Model_Grpc{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asyncapi", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Model_AsyncAPI{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Model = &Clt_Fuzz_Model_Asyncapi{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Openrpc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_Fuzz_Model_OpenRPC{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Model = &Clt_Fuzz_Model_Openrpc{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenAPIv3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenAPIv3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderAuthorization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderAuthorization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &SpecIR{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsInsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TlsInsecureSkipVerify = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Http2 = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConnsPerHost", wireType)
+			}
+			m.MaxConnsPerHost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConnsPerHost |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIdleConnsPerHost", wireType)
+			}
+			m.MaxIdleConnsPerHost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIdleConnsPerHost |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreshConnectionsPerTest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FreshConnectionsPerTest = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeoutNs", wireType)
+			}
+			m.RequestTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeoutNs", wireType)
+			}
+			m.ReadTimeoutNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeoutNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseBytes", wireType)
+			}
+			m.MaxResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookieJar", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CookieJar = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamDurationNs", wireType)
+			}
+			m.StreamDurationNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamDurationNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallsPerSecond", wireType)
+			}
+			m.MaxCallsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallsPerSecond |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HonorRetryAfter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HonorRetryAfter = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryWaitNs", wireType)
+			}
+			m.MaxRetryWaitNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryWaitNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Query[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Overrides == nil {
+				m.Overrides = make(map[string]*Clt_Fuzz_Model_OpenAPIv3_Override)
+			}
+			var mapkey string
+			var mapvalue *Clt_Fuzz_Model_OpenAPIv3_Override
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clt_Fuzz_Model_OpenAPIv3_Override{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Overrides[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3_Override) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Override: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Override: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Query[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_GraphQL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraphQL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraphQL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Http == nil {
+				m.Http = &Clt_Fuzz_Model_OpenAPIv3{}
+			}
+			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_GRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoFiles = append(m.ProtoFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &SpecIR{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_AsyncAPI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AsyncAPI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AsyncAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveWindowNs", wireType)
+			}
+			m.ReceiveWindowNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveWindowNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &SpecIR{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_OpenRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Http == nil {
+				m.Http = &Clt_Fuzz_Model_OpenAPIv3{}
+			}
+			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Clt_ResetProgress_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			}
+			m.ElapsedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallRequestRaw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallRequestRaw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Clt_CallRequestRaw_Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallRequestRaw_Input_HttpRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &Clt_CallRequestRaw_Input_HttpRequest_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallRequestRaw_Input_GrpcRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &Clt_CallRequestRaw_Input_GrpcRequest_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsocketRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Clt_CallRequestRaw_Input_WebSocketRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &Clt_CallRequestRaw_Input_WebsocketRequest{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HttpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HttpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
+			}
+			var mapkey string
+			var mapvalue *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clt_CallRequestRaw_Input_HttpRequest_HeaderValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BodyDecoded == nil {
+				m.BodyDecoded = &types.Value{}
+			}
+			if err := m.BodyDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnixSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphql", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Graphql == nil {
+				m.Graphql = &Clt_CallRequestRaw_Input_HttpRequest_GraphQL{}
+			}
+			if err := m.Graphql.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input_HttpRequest_GraphQL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraphQL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraphQL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Variables == nil {
+				m.Variables = &types.Value{}
+			}
+			if err := m.Variables.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrpcRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrpcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]*Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues)
+			}
+			var mapkey string
+			var mapvalue *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BodyDecoded == nil {
+				m.BodyDecoded = &types.Value{}
+			}
+			if err := m.BodyDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovFuzzymonkey(uint64(m.SID))
-	return n
-}
 
-func sovFuzzymonkey(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozFuzzymonkey(x uint64) (n int) {
-	return sovFuzzymonkey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Clt) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallRequestRaw_Input_WebSocketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9696,7 +22881,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -9704,17 +22889,17 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebSocketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebSocketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fuzz", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -9724,29 +22909,29 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_Fuzz{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Msg = &Clt_Fuzz_{v}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetProgress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -9756,27 +22941,61 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_ResetProgress{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Msg = &Clt_ResetProgress_{v}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallRequestRaw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9788,7 +23007,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9797,18 +23016,73 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_CallRequestRaw{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BodyDecoded == nil {
+				m.BodyDecoded = &types.Value{}
+			}
+			if err := m.BodyDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Msg = &Clt_CallRequestRaw_{v}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallResponseRaw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallResponseRaw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallResponseRaw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallResponseRaw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9820,7 +23094,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9829,18 +23103,41 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_CallResponseRaw{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Output == nil {
+				m.Output = &Clt_CallResponseRaw_Output{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Msg = &Clt_CallResponseRaw_{v}
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputId", wireType)
+			}
+			m.OutputId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallVerifProgress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9852,7 +23149,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9861,14 +23158,16 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_CallVerifProgress{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &Clt_CallResponseRaw_LogLine{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Msg = &Clt_CallVerifProgress_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9876,7 +23175,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -9892,7 +23191,7 @@ func (m *Clt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9907,7 +23206,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -9915,15 +23214,15 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fuzz: wiretype end group for non-group")
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fuzz: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resetter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9935,7 +23234,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9944,19 +23243,21 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resetter == nil {
-				m.Resetter = &Clt_Fuzz_Resetter{}
-			}
-			if err := m.Resetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Clt_CallResponseRaw_Output_HttpResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &Clt_CallResponseRaw_Output_HttpResponse_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9968,7 +23269,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9977,50 +23278,23 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Model == nil {
-				m.Model = &Clt_Fuzz_Model{}
-			}
-			if err := m.Model.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFuzzymonkey
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Usage = append(m.Usage, string(dAtA[iNdEx:postIndex]))
+			v := &Clt_CallResponseRaw_Output_GrpcResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &Clt_CallResponseRaw_Output_GrpcResponse_{v}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsocketResponse", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10030,28 +23304,83 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seed == nil {
-				m.Seed = []byte{}
+			v := &Clt_CallResponseRaw_Output_WebSocketResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Output = &Clt_CallResponseRaw_Output_WebsocketResponse{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ntensity", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Ntensity = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HttpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HttpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10061,89 +23390,29 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ntensity |= (uint32(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.EIDs = append(m.EIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthFuzzymonkey
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.EIDs) == 0 {
-					m.EIDs = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.EIDs = append(m.EIDs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIDs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
 			}
-			var msglen int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			}
+			m.StatusCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10153,113 +23422,46 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StatusCode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10271,7 +23473,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10280,14 +23482,17 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnvRead == nil {
-				m.EnvRead = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -10300,7 +23505,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -10317,7 +23522,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -10327,13 +23532,16 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowFuzzymonkey
@@ -10343,28 +23551,33 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -10373,13 +23586,242 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.EnvRead[mapkey] = mapvalue
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BodyDecoded == nil {
+				m.BodyDecoded = &types.Value{}
+			}
+			if err := m.BodyDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			}
+			m.ElapsedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timings == nil {
+				m.Timings = &Clt_CallResponseRaw_Output_HttpResponse_Timings{}
+			}
+			if err := m.Timings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UUIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &Clt_CallResponseRaw_Output_HttpResponse_Redirect{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connection == nil {
+				m.Connection = &Clt_CallResponseRaw_Output_HttpResponse_Connection{}
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Clt_CallResponseRaw_Output_HttpResponse_Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamCut", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10389,26 +23831,68 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.StreamCut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UUIDs = append(m.UUIDs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10418,109 +23902,23 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Files == nil {
-				m.Files = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Files[mapkey] = mapvalue
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10528,7 +23926,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -10544,7 +23942,7 @@ func (m *Clt_Fuzz) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10559,7 +23957,7 @@ func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -10567,17 +23965,17 @@ func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resetter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Timings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resetter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Timings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsNs", wireType)
 			}
-			var msglen int
+			m.DnsNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -10587,31 +23985,94 @@ func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DnsNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFuzzymonkey
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectNs", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ConnectNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v := &Clt_Fuzz_Resetter_Shell{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsHandshakeNs", wireType)
+			}
+			m.TlsHandshakeNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TlsHandshakeNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtfbNs", wireType)
+			}
+			m.TtfbNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TtfbNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferNs", wireType)
+			}
+			m.TransferNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Resetter = &Clt_Fuzz_Resetter_Shell_{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -10627,7 +24088,7 @@ func (m *Clt_Fuzz_Resetter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10642,7 +24103,7 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -10650,15 +24111,15 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shell: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redirect: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redirect: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10670,7 +24131,7 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10680,14 +24141,36 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			}
+			m.StatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10699,7 +24182,7 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10709,14 +24192,17 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rst = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10728,7 +24214,7 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10738,65 +24224,17 @@ func (m *Clt_Fuzz_Resetter_Shell) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stop = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFuzzymonkey
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Openapiv3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10808,7 +24246,7 @@ func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10817,14 +24255,111 @@ func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_Fuzz_Model_OpenAPIv3{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Headers == nil {
+				m.Headers = make(map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
 			}
-			m.Model = &Clt_Fuzz_Model_Openapiv3{v}
+			var mapkey string
+			var mapvalue *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10832,7 +24367,7 @@ func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -10848,7 +24383,7 @@ func (m *Clt_Fuzz_Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10863,7 +24398,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -10871,15 +24406,15 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenAPIv3: wiretype end group for non-group")
+			return fmt.Errorf("proto: Connection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenAPIv3: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Connection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10891,7 +24426,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10901,14 +24436,36 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.File = string(dAtA[iNdEx:postIndex])
+			m.RemoteIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePort", wireType)
+			}
+			m.RemotePort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemotePort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10920,7 +24477,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10930,14 +24487,36 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.LocalIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalPort", wireType)
+			}
+			m.LocalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderAuthorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10949,7 +24528,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10959,14 +24538,37 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeaderAuthorization = string(dAtA[iNdEx:postIndex])
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reused = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10978,7 +24580,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10987,13 +24589,16 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &SpecIR{}
+			if m.Tls == nil {
+				m.Tls = &Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS{}
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11003,7 +24608,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -11019,7 +24624,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11034,7 +24639,7 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -11042,17 +24647,17 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetProgress: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11062,16 +24667,29 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Clt_ResetProgress_Status(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CipherSuite", wireType)
 			}
-			m.ElapsedNs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11081,14 +24699,27 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElapsedNs |= (int64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CipherSuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerSubject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11100,7 +24731,7 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11110,10 +24741,13 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
+			m.PeerSubject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11121,7 +24755,7 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -11137,7 +24771,7 @@ func (m *Clt_ResetProgress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11152,7 +24786,7 @@ func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -11160,17 +24794,17 @@ func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallRequestRaw: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallRequestRaw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11180,28 +24814,27 @@ func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Clt_CallRequestRaw_Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11213,7 +24846,7 @@ func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11223,65 +24856,49 @@ func (m *Clt_CallRequestRaw) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
+			m.Event = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthFuzzymonkey
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFuzzymonkey
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDecoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11293,7 +24910,7 @@ func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11302,14 +24919,18 @@ func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_CallRequestRaw_Input_HttpRequest{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DataDecoded == nil {
+				m.DataDecoded = &types.Value{}
+			}
+			if err := m.DataDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &Clt_CallRequestRaw_Input_HttpRequest_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11317,7 +24938,7 @@ func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -11333,7 +24954,7 @@ func (m *Clt_CallRequestRaw_Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11348,7 +24969,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -11356,15 +24977,15 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HttpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrpcResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HttpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrpcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11376,7 +24997,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11386,14 +25007,68 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11405,24 +25080,156 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)
+			}
+			var mapkey string
+			var mapvalue *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFuzzymonkey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFuzzymonkey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trailers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11434,7 +25241,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11443,14 +25250,17 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]*Clt_CallRequestRaw_Input_HttpRequest_HeaderValues)
+			if m.Trailers == nil {
+				m.Trailers = make(map[string]*Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues)
 			}
 			var mapkey string
-			var mapvalue *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues
+			var mapvalue *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11463,7 +25273,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -11480,7 +25290,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -11490,6 +25300,9 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -11506,7 +25319,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -11515,13 +25328,13 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &Clt_CallRequestRaw_Input_HttpRequest_HeaderValues{}
+					mapvalue = &Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -11532,7 +25345,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -11541,9 +25354,9 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Headers[mapkey] = mapvalue
+			m.Trailers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
@@ -11557,7 +25370,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11566,6 +25379,9 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -11574,7 +25390,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				m.Body = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
 			}
@@ -11588,7 +25404,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11597,6 +25413,9 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -11607,175 +25426,11 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallRequestRaw_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFuzzymonkey
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderValues: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderValues: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFuzzymonkey
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallResponseRaw) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFuzzymonkey
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CallResponseRaw: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallResponseRaw: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFuzzymonkey
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Output == nil {
-				m.Output = &Clt_CallResponseRaw_Output{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
 			}
-			m.OutputId = 0
+			m.ElapsedNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11785,7 +25440,7 @@ func (m *Clt_CallResponseRaw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputId |= (uint32(b) & 0x7F) << shift
+				m.ElapsedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11796,7 +25451,7 @@ func (m *Clt_CallResponseRaw) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -11812,7 +25467,7 @@ func (m *Clt_CallResponseRaw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11827,7 +25482,7 @@ func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -11835,17 +25490,17 @@ func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataValues: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataValues: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11855,23 +25510,23 @@ func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Clt_CallResponseRaw_Output_HttpResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &Clt_CallResponseRaw_Output_HttpResponse_{v}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11879,7 +25534,7 @@ func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -11895,7 +25550,7 @@ func (m *Clt_CallResponseRaw_Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11910,7 +25565,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -11918,10 +25573,10 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HttpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebSocketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HttpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebSocketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11938,7 +25593,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11948,16 +25603,19 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			m.StatusCode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11967,16 +25625,31 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusCode |= (uint32(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &Clt_CallResponseRaw_Output_WebSocketResponse_Message{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
 			}
-			var stringLen uint64
+			m.ElapsedNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -11986,26 +25659,67 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ElapsedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallResponseRaw_Output_WebSocketResponse_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFuzzymonkey
 			}
-			var msglen int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFuzzymonkey
@@ -12015,118 +25729,15 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFuzzymonkey
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]*Clt_CallResponseRaw_Output_HttpResponse_HeaderValues)
-			}
-			var mapkey string
-			var mapvalue *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFuzzymonkey
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFuzzymonkey
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Headers[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
+			m.Sent = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12138,7 +25749,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12147,17 +25758,20 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
-			if m.Body == nil {
-				m.Body = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDecoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12169,7 +25783,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12178,17 +25792,20 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BodyDecoded == nil {
-				m.BodyDecoded = &types.Value{}
+			if m.DataDecoded == nil {
+				m.DataDecoded = &types.Value{}
 			}
-			if err := m.BodyDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DataDecoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
 			}
@@ -12202,7 +25819,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElapsedNs |= (int64(b) & 0x7F) << shift
+				m.ElapsedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12213,7 +25830,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -12229,7 +25846,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_LogLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12244,7 +25861,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -12252,15 +25869,47 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12272,7 +25921,7 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12282,18 +25931,40 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) Unmarshal(dAtA []
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetNs", wireType)
+			}
+			m.OffsetNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -12324,7 +25995,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -12352,7 +26023,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12362,6 +26033,9 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12381,7 +26055,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Clt_CallVerifProgress_Status(b) & 0x7F) << shift
+				m.Status |= Clt_CallVerifProgress_Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12400,7 +26074,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Origin |= (Clt_CallVerifProgress_Origin(b) & 0x7F) << shift
+				m.Origin |= Clt_CallVerifProgress_Origin(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12419,7 +26093,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12429,6 +26103,9 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12448,7 +26125,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElapsedNs |= (int64(b) & 0x7F) << shift
+				m.ElapsedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12467,7 +26144,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionSteps |= (uint64(b) & 0x7F) << shift
+				m.ExecutionSteps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12478,7 +26155,7 @@ func (m *Clt_CallVerifProgress) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -12509,7 +26186,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -12537,7 +26214,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12546,6 +26223,9 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12570,7 +26250,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12579,6 +26259,9 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12602,7 +26285,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12611,6 +26294,9 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12634,7 +26320,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12643,6 +26329,9 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12666,7 +26355,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12675,6 +26364,9 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -12690,7 +26382,7 @@ func (m *Srv) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -12721,7 +26413,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -12749,7 +26441,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12769,7 +26461,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12789,7 +26481,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12809,7 +26501,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12829,7 +26521,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12849,7 +26541,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalTestsCount |= (uint32(b) & 0x7F) << shift
+				m.TotalTestsCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12868,7 +26560,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalCallsCount |= (uint32(b) & 0x7F) << shift
+				m.TotalCallsCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12887,7 +26579,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalChecksCount |= (uint32(b) & 0x7F) << shift
+				m.TotalChecksCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12906,7 +26598,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TestCallsCount |= (uint32(b) & 0x7F) << shift
+				m.TestCallsCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12925,7 +26617,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallChecksCount |= (uint32(b) & 0x7F) << shift
+				m.CallChecksCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12944,7 +26636,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallChecksSkipped |= (uint32(b) & 0x7F) << shift
+				m.CallChecksSkipped |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12955,7 +26647,7 @@ func (m *Srv_FuzzingProgress) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -12986,7 +26678,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13014,7 +26706,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTestsCount |= (uint32(b) & 0x7F) << shift
+				m.MaxTestsCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13033,7 +26725,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13042,6 +26734,9 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13064,7 +26759,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13074,6 +26769,9 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13093,7 +26791,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxExecutionStepsPerCheck |= (uint64(b) & 0x7F) << shift
+				m.MaxExecutionStepsPerCheck |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13104,7 +26802,7 @@ func (m *Srv_FuzzRep) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13135,7 +26833,7 @@ func (m *Srv_Call) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13163,7 +26861,7 @@ func (m *Srv_Call) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13172,6 +26870,9 @@ func (m *Srv_Call) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13196,7 +26897,7 @@ func (m *Srv_Call) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EID |= (uint32(b) & 0x7F) << shift
+				m.EID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13207,7 +26908,7 @@ func (m *Srv_Call) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13238,7 +26939,7 @@ func (m *Srv_Call_Input) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13266,7 +26967,7 @@ func (m *Srv_Call_Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13275,6 +26976,9 @@ func (m *Srv_Call_Input) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13290,7 +26994,7 @@ func (m *Srv_Call_Input) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13321,7 +27025,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13349,7 +27053,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13359,6 +27063,9 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13378,7 +27085,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13388,6 +27095,9 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13407,7 +27117,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13416,6 +27126,9 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13436,7 +27149,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -13453,7 +27166,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -13463,6 +27176,9 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -13479,7 +27195,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -13488,7 +27204,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if postmsgIndex > l {
@@ -13505,7 +27221,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -13530,7 +27246,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13539,6 +27255,9 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13555,7 +27274,7 @@ func (m *Srv_Call_Input_HttpRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13586,7 +27305,7 @@ func (m *Srv_Call_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13614,7 +27333,7 @@ func (m *Srv_Call_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13624,6 +27343,9 @@ func (m *Srv_Call_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13635,7 +27357,7 @@ func (m *Srv_Call_Input_HttpRequest_HeaderValues) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13666,7 +27388,7 @@ func (m *Srv_Reset) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13686,7 +27408,7 @@ func (m *Srv_Reset) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13717,7 +27439,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13745,7 +27467,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13754,6 +27476,9 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13776,7 +27501,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13796,7 +27521,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13805,6 +27530,9 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13827,7 +27555,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13847,7 +27575,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13856,6 +27584,9 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13878,7 +27609,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13887,6 +27618,9 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13901,7 +27635,7 @@ func (m *Srv_FuzzingResult) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -13932,7 +27666,7 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -13960,7 +27694,7 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13969,6 +27703,9 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -13993,7 +27730,7 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14002,6 +27739,9 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14012,13 +27752,47 @@ func (m *Srv_FuzzingResult_CounterexampleItem) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Clt_CallResponseRaw_LogLine{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14049,7 +27823,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14077,7 +27851,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14086,6 +27860,9 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14110,7 +27887,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14119,6 +27896,9 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14139,7 +27919,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -14155,7 +27935,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= (uint32(b) & 0x7F) << shift
+						mapkey |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14171,7 +27951,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14180,7 +27960,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if postmsgIndex > l {
@@ -14197,7 +27977,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -14214,7 +27994,7 @@ func (m *SpecIR) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14245,7 +28025,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14273,7 +28053,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14282,6 +28062,9 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14302,7 +28085,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -14318,7 +28101,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= (uint32(b) & 0x7F) << shift
+						mapkey |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14334,7 +28117,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14343,7 +28126,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if postmsgIndex > l {
@@ -14360,7 +28143,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -14377,7 +28160,7 @@ func (m *Schemas) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14408,7 +28191,7 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14436,7 +28219,7 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14445,6 +28228,9 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14468,7 +28254,7 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14477,6 +28263,9 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14492,7 +28281,7 @@ func (m *RefOrSchemaJSON) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14523,7 +28312,7 @@ func (m *SchemaPtr) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14551,7 +28340,7 @@ func (m *SchemaPtr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SID |= (uint32(b) & 0x7F) << shift
+				m.SID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14570,7 +28359,7 @@ func (m *SchemaPtr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14580,6 +28369,9 @@ func (m *SchemaPtr) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14591,7 +28383,7 @@ func (m *SchemaPtr) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14622,7 +28414,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14650,7 +28442,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14659,6 +28451,9 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14674,7 +28469,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14705,7 +28500,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14733,7 +28528,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Method |= (EndpointJSON_Method(b) & 0x7F) << shift
+				m.Method |= EndpointJSON_Method(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14752,7 +28547,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14761,6 +28556,9 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14783,7 +28581,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14792,6 +28590,9 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14814,7 +28615,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14823,6 +28624,9 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -14843,7 +28647,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -14859,7 +28663,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= (uint32(b) & 0x7F) << shift
+						mapkey |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14874,7 +28678,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= (uint32(b) & 0x7F) << shift
+						mapvalue |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -14885,7 +28689,7 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -14896,13 +28700,77 @@ func (m *EndpointJSON) Unmarshal(dAtA []byte) error {
 			}
 			m.Outputs[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFuzzymonkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFuzzymonkey(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -14933,7 +28801,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -14961,7 +28829,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14981,7 +28849,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SID |= (uint32(b) & 0x7F) << shift
+				m.SID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15000,7 +28868,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15010,6 +28878,9 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15029,7 +28900,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= (ParamJSON_Kind(b) & 0x7F) << shift
+				m.Kind |= ParamJSON_Kind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15040,7 +28911,7 @@ func (m *ParamJSON) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -15071,7 +28942,7 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -15099,7 +28970,7 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15109,6 +28980,9 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15128,7 +29002,7 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15138,6 +29012,9 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15149,7 +29026,7 @@ func (m *PathPartial) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -15180,7 +29057,7 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -15200,7 +29077,7 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -15231,7 +29108,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -15257,7 +29134,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (Schema_JSON_Type(b) & 0x7F) << shift
+					v |= Schema_JSON_Type(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15274,7 +29151,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15283,6 +29160,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					return ErrInvalidLengthFuzzymonkey
 				}
 				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
 				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
@@ -15301,7 +29181,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (Schema_JSON_Type(b) & 0x7F) << shift
+						v |= Schema_JSON_Type(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -15325,7 +29205,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15334,6 +29214,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15356,7 +29239,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Format |= (Schema_JSON_Format(b) & 0x7F) << shift
+				m.Format |= Schema_JSON_Format(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15375,7 +29258,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinLength |= (uint64(b) & 0x7F) << shift
+				m.MinLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15394,7 +29277,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLength |= (uint64(b) & 0x7F) << shift
+				m.MaxLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15413,7 +29296,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15433,7 +29316,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15443,6 +29326,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15484,7 +29370,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15504,7 +29390,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15535,7 +29421,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15555,7 +29441,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15573,7 +29459,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15590,7 +29476,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15599,12 +29485,15 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					return ErrInvalidLengthFuzzymonkey
 				}
 				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
 				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
 				var count int
-				for _, integer := range dAtA {
+				for _, integer := range dAtA[iNdEx:postIndex] {
 					if integer < 128 {
 						count++
 					}
@@ -15624,7 +29513,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
+						v |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -15648,7 +29537,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15668,7 +29557,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinItems |= (uint64(b) & 0x7F) << shift
+				m.MinItems |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15687,7 +29576,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxItems |= (uint64(b) & 0x7F) << shift
+				m.MaxItems |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15706,7 +29595,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15726,7 +29615,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15735,6 +29624,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15755,7 +29647,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15772,7 +29664,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -15782,6 +29674,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthFuzzymonkey
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -15797,7 +29692,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= (uint32(b) & 0x7F) << shift
+						mapvalue |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -15808,7 +29703,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthFuzzymonkey
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -15833,7 +29728,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15843,6 +29738,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15862,7 +29760,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinProperties |= (uint64(b) & 0x7F) << shift
+				m.MinProperties |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15881,7 +29779,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxProperties |= (uint64(b) & 0x7F) << shift
+				m.MaxProperties |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15900,7 +29798,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15920,7 +29818,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15929,6 +29827,9 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFuzzymonkey
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15953,7 +29854,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15971,7 +29872,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15988,7 +29889,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -15997,12 +29898,15 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					return ErrInvalidLengthFuzzymonkey
 				}
 				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
 				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
 				var count int
-				for _, integer := range dAtA {
+				for _, integer := range dAtA[iNdEx:postIndex] {
 					if integer < 128 {
 						count++
 					}
@@ -16022,7 +29926,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
+						v |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -16044,7 +29948,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -16061,7 +29965,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -16070,12 +29974,15 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					return ErrInvalidLengthFuzzymonkey
 				}
 				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
 				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
 				var count int
-				for _, integer := range dAtA {
+				for _, integer := range dAtA[iNdEx:postIndex] {
 					if integer < 128 {
 						count++
 					}
@@ -16095,7 +30002,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
+						v |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -16117,7 +30024,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -16134,7 +30041,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -16143,12 +30050,15 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 					return ErrInvalidLengthFuzzymonkey
 				}
 				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFuzzymonkey
+				}
 				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
 				var count int
-				for _, integer := range dAtA {
+				for _, integer := range dAtA[iNdEx:postIndex] {
 					if integer < 128 {
 						count++
 					}
@@ -16168,7 +30078,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
+						v |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -16192,7 +30102,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Not |= (uint32(b) & 0x7F) << shift
+				m.Not |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16203,7 +30113,7 @@ func (m *Schema_JSON) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -16234,7 +30144,7 @@ func (m *Schema_JSON_AdditionalProperties) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -16262,7 +30172,7 @@ func (m *Schema_JSON_AdditionalProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16283,7 +30193,7 @@ func (m *Schema_JSON_AdditionalProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (uint32(b) & 0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16295,7 +30205,7 @@ func (m *Schema_JSON_AdditionalProperties) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFuzzymonkey
 			}
 			if (iNdEx + skippy) > l {
@@ -16314,6 +30224,7 @@ func (m *Schema_JSON_AdditionalProperties) Unmarshal(dAtA []byte) error {
 func skipFuzzymonkey(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -16345,10 +30256,8 @@ func skipFuzzymonkey(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -16365,260 +30274,34 @@ func skipFuzzymonkey(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthFuzzymonkey
 			}
-			return iNdEx, nil
+			iNdEx += length
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowFuzzymonkey
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipFuzzymonkey(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFuzzymonkey
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFuzzymonkey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthFuzzymonkey = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowFuzzymonkey   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthFuzzymonkey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFuzzymonkey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFuzzymonkey = fmt.Errorf("proto: unexpected end of group")
 )
-
-func init() {
-	proto.RegisterFile("pkg/internal/fm/fuzzymonkey.proto", fileDescriptor_fuzzymonkey_5e0d18cbb143f986)
-}
-
-var fileDescriptor_fuzzymonkey_5e0d18cbb143f986 = []byte{
-	// 3178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x4b, 0x73, 0x1b, 0x47,
-	0x92, 0x26, 0x88, 0x77, 0xe2, 0xc1, 0x66, 0x89, 0x94, 0xa0, 0x96, 0x44, 0xcb, 0x58, 0x4b, 0xa6,
-	0x25, 0x19, 0xb4, 0x25, 0xad, 0x2c, 0x2b, 0xd6, 0xf6, 0xf2, 0x01, 0x19, 0xd4, 0x83, 0x60, 0x34,
-	0x28, 0x6f, 0xec, 0x5e, 0xb0, 0x45, 0xa0, 0x00, 0xb4, 0xd9, 0x2f, 0x75, 0x57, 0x93, 0x80, 0x4e,
-	0x1b, 0x7b, 0xd8, 0xd8, 0xd8, 0xc3, 0xc6, 0x44, 0xcc, 0x65, 0x62, 0x22, 0xe6, 0x3a, 0x31, 0x87,
-	0xb9, 0x8d, 0x6f, 0x13, 0x73, 0x9d, 0x98, 0xa3, 0x0f, 0x9e, 0x98, 0x99, 0x9b, 0x43, 0x3f, 0x61,
-	0x7e, 0xc1, 0x44, 0x56, 0x55, 0x03, 0xdd, 0x20, 0xf5, 0xf2, 0x09, 0x95, 0x99, 0x5f, 0x65, 0x65,
-	0x65, 0x65, 0x67, 0x66, 0x15, 0xe0, 0x7d, 0xef, 0x68, 0xb8, 0x61, 0x3a, 0x9c, 0xf9, 0x0e, 0xb5,
-	0x36, 0x06, 0xf6, 0xc6, 0x20, 0x7c, 0xf1, 0x62, 0x62, 0xbb, 0xce, 0x11, 0x9b, 0x34, 0x3c, 0xdf,
-	0xe5, 0x2e, 0x59, 0x1c, 0xd8, 0xfa, 0xe5, 0xa1, 0xeb, 0x0e, 0x2d, 0xb6, 0x21, 0x38, 0x87, 0xe1,
-	0x60, 0x23, 0xe0, 0x7e, 0xd8, 0xe3, 0x12, 0xa1, 0x7f, 0x3c, 0x34, 0xf9, 0x28, 0x3c, 0x6c, 0xf4,
-	0x5c, 0x7b, 0x63, 0xe8, 0x0e, 0xdd, 0x19, 0x0c, 0x29, 0x41, 0x88, 0x91, 0x84, 0xd7, 0x7f, 0xb8,
-	0x00, 0xe9, 0x6d, 0x8b, 0x93, 0x3a, 0x64, 0x70, 0xb5, 0x5a, 0xea, 0x6a, 0x6a, 0xbd, 0x74, 0xbb,
-	0xdc, 0x18, 0xd8, 0x8d, 0x6d, 0x8b, 0x37, 0x1e, 0x86, 0x2f, 0x5e, 0xb4, 0x16, 0x0c, 0x21, 0x23,
-	0x5f, 0x42, 0xd5, 0x67, 0x01, 0xe3, 0x5d, 0xcf, 0x77, 0x87, 0x3e, 0x0b, 0x82, 0xda, 0xa2, 0x40,
-	0xaf, 0x46, 0x68, 0x03, 0xa5, 0xfb, 0x4a, 0xd8, 0x5a, 0x30, 0x2a, 0x7e, 0x9c, 0x41, 0xb6, 0x40,
-	0xeb, 0x51, 0xcb, 0xea, 0xfa, 0xec, 0x79, 0xc8, 0x02, 0xde, 0xf5, 0xe9, 0x49, 0x2d, 0x2d, 0x34,
-	0x9c, 0x8f, 0x34, 0x6c, 0x53, 0xcb, 0x32, 0xa4, 0xd8, 0xa0, 0x27, 0xad, 0x05, 0xa3, 0xda, 0x4b,
-	0x70, 0x48, 0x13, 0x96, 0x95, 0x8e, 0xc0, 0x73, 0x9d, 0x80, 0x09, 0x25, 0x19, 0xa1, 0xe4, 0x42,
-	0x52, 0x89, 0x94, 0x4b, 0x2d, 0x4b, 0xbd, 0x24, 0x8b, 0x3c, 0x86, 0x73, 0x42, 0xcd, 0x31, 0xf3,
-	0xcd, 0xc1, 0x6c, 0x3f, 0x59, 0xa1, 0xe8, 0x62, 0x5c, 0xd1, 0x37, 0x88, 0x88, 0xed, 0x49, 0x2c,
-	0x9f, 0x60, 0xea, 0xff, 0x9b, 0x87, 0x0c, 0x3a, 0x8a, 0x7c, 0x0a, 0x05, 0xb1, 0x63, 0xce, 0x7c,
-	0xe5, 0xc8, 0xd5, 0xb8, 0x23, 0xa5, 0x7f, 0x38, 0xf3, 0x8d, 0x29, 0x8c, 0xac, 0x43, 0xd6, 0x76,
-	0xfb, 0xcc, 0x52, 0xae, 0x24, 0x09, 0xfc, 0x53, 0x94, 0x18, 0x12, 0x40, 0x56, 0x20, 0x1b, 0x06,
-	0x74, 0xc8, 0x6a, 0xe9, 0xab, 0xe9, 0xf5, 0xa2, 0x21, 0x09, 0x42, 0x20, 0x13, 0x30, 0xd6, 0x17,
-	0x2e, 0x28, 0x1b, 0x62, 0x4c, 0x74, 0x28, 0x38, 0x9c, 0x39, 0x81, 0xc9, 0x27, 0x62, 0x47, 0x15,
-	0x63, 0x4a, 0x23, 0xbe, 0xb9, 0xbb, 0x13, 0xd4, 0x72, 0x57, 0xd3, 0xeb, 0x15, 0x43, 0x8c, 0xc9,
-	0x27, 0x90, 0xb3, 0xe8, 0x21, 0xb3, 0x82, 0x5a, 0xfe, 0x6a, 0x7a, 0xbd, 0x74, 0xbb, 0x96, 0x30,
-	0xe2, 0x89, 0x10, 0x35, 0x1d, 0xee, 0x4f, 0x0c, 0x85, 0x23, 0x77, 0xa1, 0xc0, 0x9c, 0xe3, 0xae,
-	0xcf, 0x68, 0xbf, 0x56, 0x10, 0x73, 0x2e, 0x26, 0xe6, 0x34, 0x9d, 0x63, 0x83, 0xd1, 0xbe, 0x9c,
-	0x94, 0x67, 0x92, 0xc2, 0x1d, 0x3c, 0x7b, 0x86, 0x8b, 0x17, 0xe5, 0x0e, 0x04, 0x41, 0x3e, 0x86,
-	0xec, 0xc0, 0xb4, 0x58, 0x50, 0x03, 0xa1, 0xe8, 0x42, 0x42, 0xd1, 0x43, 0x94, 0x48, 0x35, 0x12,
-	0xa5, 0xff, 0x2c, 0x05, 0x85, 0xc8, 0x8f, 0xe4, 0x0e, 0x64, 0x83, 0x11, 0xb3, 0x2c, 0xe5, 0xed,
-	0x4b, 0x67, 0x7a, 0xbb, 0xd1, 0x41, 0x48, 0x6b, 0xc1, 0x90, 0x58, 0x7d, 0x1b, 0xb2, 0x82, 0x83,
-	0xf6, 0x04, 0x9c, 0xfa, 0x5c, 0xcc, 0x2e, 0x1a, 0x92, 0x20, 0x1a, 0xa4, 0xfd, 0x80, 0x8b, 0xf3,
-	0x28, 0x1a, 0x38, 0x14, 0x3e, 0xe6, 0xae, 0x27, 0x62, 0xb5, 0x68, 0x88, 0xf1, 0x16, 0xcc, 0x8e,
-	0x5a, 0xff, 0x4b, 0x0a, 0xb2, 0xe2, 0xa8, 0xc8, 0xbf, 0x40, 0xd1, 0xf5, 0x98, 0x43, 0x3d, 0xf3,
-	0xf8, 0x8e, 0xb2, 0xe9, 0xf2, 0xe9, 0x13, 0x6d, 0xb4, 0x3d, 0xe6, 0x6c, 0xee, 0xef, 0x1e, 0xdf,
-	0x69, 0x2d, 0x18, 0xb3, 0x09, 0xfa, 0xff, 0xa4, 0xa0, 0x38, 0x15, 0xe1, 0xaa, 0xb8, 0x63, 0x65,
-	0x9c, 0x18, 0x23, 0x6f, 0xe4, 0x4e, 0x8d, 0x13, 0x63, 0xf2, 0x29, 0xac, 0x8c, 0x18, 0xed, 0x33,
-	0xbf, 0x4b, 0x43, 0x3e, 0x72, 0x7d, 0xf3, 0x05, 0xe5, 0xa6, 0xeb, 0x28, 0x6b, 0xcf, 0x49, 0xd9,
-	0x66, 0x5c, 0x44, 0xd6, 0x20, 0x13, 0x78, 0xac, 0xa7, 0xbe, 0x1b, 0x40, 0x0b, 0x3b, 0x1e, 0xeb,
-	0xed, 0x1a, 0x86, 0xe0, 0x6f, 0xe5, 0x55, 0x50, 0xea, 0x9f, 0x43, 0x29, 0x76, 0xfc, 0xe8, 0x9a,
-	0x23, 0x36, 0x51, 0x16, 0xe1, 0x10, 0x5d, 0x78, 0x4c, 0xad, 0x90, 0x29, 0x8b, 0x24, 0xf1, 0x60,
-	0xf1, 0x7e, 0x4a, 0x7f, 0x00, 0xe5, 0x78, 0x14, 0xbc, 0xd3, 0xdc, 0xfb, 0x00, 0xb3, 0x83, 0x7f,
-	0xa7, 0x99, 0xdf, 0xa5, 0xa0, 0x92, 0xc8, 0x42, 0xe4, 0x2e, 0xe4, 0x02, 0x4e, 0x79, 0x18, 0x08,
-	0x05, 0xd5, 0xd9, 0x79, 0x24, 0x60, 0x8d, 0x8e, 0xc0, 0x18, 0x0a, 0x4b, 0xae, 0x00, 0x30, 0x8b,
-	0x7a, 0x01, 0xeb, 0x77, 0x1d, 0x99, 0xe6, 0xd2, 0x46, 0x51, 0x71, 0xf6, 0x02, 0x72, 0x1e, 0x72,
-	0x3e, 0xa3, 0x81, 0xf0, 0x32, 0x86, 0xb2, 0xa2, 0xea, 0xf7, 0x20, 0x27, 0x15, 0x91, 0x02, 0x64,
-	0xf6, 0xda, 0xed, 0x7d, 0x6d, 0x81, 0x94, 0x20, 0x2f, 0x02, 0x8b, 0xf5, 0xb5, 0x14, 0x29, 0x42,
-	0x96, 0x39, 0x7d, 0xd6, 0xd7, 0x16, 0x09, 0x40, 0x6e, 0x40, 0x4d, 0x8b, 0xf5, 0xb5, 0xb4, 0xfe,
-	0xbb, 0x0c, 0x54, 0x93, 0xa9, 0x8f, 0xdc, 0x86, 0xac, 0xe9, 0x78, 0x21, 0x9f, 0x0f, 0xa3, 0x24,
-	0xac, 0xb1, 0x8b, 0x18, 0x43, 0x42, 0x63, 0x66, 0x2d, 0xc6, 0xcd, 0xd2, 0x7f, 0x48, 0x43, 0x56,
-	0x00, 0xc9, 0x53, 0x28, 0x8f, 0x38, 0xf7, 0xa2, 0x14, 0xac, 0x94, 0xaf, 0xbf, 0x4e, 0x79, 0xa3,
-	0xc5, 0xb9, 0xa7, 0x98, 0xad, 0x05, 0xa3, 0x34, 0x9a, 0x91, 0xfa, 0xdf, 0x17, 0xa1, 0x14, 0x13,
-	0xa3, 0x01, 0x36, 0xe3, 0x23, 0xb7, 0xaf, 0x4e, 0x4b, 0x51, 0x78, 0x84, 0xa1, 0x6f, 0x45, 0xdf,
-	0x54, 0xe8, 0x5b, 0xa4, 0x0d, 0x79, 0x19, 0x99, 0x81, 0x70, 0x61, 0xe9, 0xf6, 0x3f, 0xbf, 0xad,
-	0x0d, 0x8d, 0x96, 0x9c, 0xa7, 0x92, 0x8b, 0xd2, 0x82, 0x9f, 0xc6, 0xa1, 0xdb, 0x9f, 0x44, 0x89,
-	0x10, 0xc7, 0xe4, 0x73, 0x28, 0xe3, 0x6f, 0xb7, 0xcf, 0x7a, 0x6e, 0x9f, 0xf5, 0x55, 0x7a, 0x3f,
-	0xdf, 0x90, 0x05, 0xb4, 0x11, 0x55, 0xc6, 0xc6, 0x37, 0x18, 0x3f, 0x46, 0x09, 0xb1, 0x3b, 0x12,
-	0xaa, 0x5f, 0x87, 0xb2, 0x5c, 0x47, 0xc8, 0xc4, 0x89, 0x8b, 0x28, 0xc3, 0x30, 0x12, 0xae, 0x95,
-	0x94, 0xfe, 0x3c, 0xc2, 0xbd, 0x32, 0x58, 0x1f, 0xc7, 0x83, 0xf5, 0xdd, 0xf7, 0x29, 0xd7, 0x8f,
-	0xc5, 0x38, 0x7e, 0x9d, 0xe2, 0xb8, 0xf5, 0xff, 0xcf, 0xc2, 0xd2, 0x5c, 0xad, 0x23, 0xf7, 0x20,
-	0xe7, 0x86, 0x7c, 0x16, 0x37, 0x6b, 0xaf, 0x28, 0x8a, 0x8d, 0xb6, 0x40, 0x19, 0x0a, 0x8d, 0x35,
-	0x43, 0x8e, 0x76, 0xfb, 0xc2, 0xd0, 0x8a, 0x31, 0xa5, 0xf5, 0x5f, 0x67, 0x20, 0x27, 0xe1, 0xc4,
-	0x80, 0x8a, 0x8a, 0x1f, 0xa9, 0x49, 0xad, 0x72, 0xf3, 0xf5, 0xab, 0xa8, 0x6d, 0x49, 0x76, 0x6b,
-	0xc1, 0x28, 0x8f, 0x62, 0xb4, 0xfe, 0x87, 0x34, 0x94, 0xe3, 0x00, 0xfc, 0xbc, 0x99, 0xef, 0xbb,
-	0x7e, 0x94, 0x97, 0x05, 0x41, 0xde, 0x83, 0x92, 0xfc, 0x38, 0xbb, 0x78, 0x42, 0xca, 0x48, 0x90,
-	0xac, 0x6d, 0xb7, 0xcf, 0x12, 0x1f, 0x65, 0x6a, 0x16, 0xfd, 0xc4, 0x98, 0x85, 0x5a, 0x46, 0x84,
-	0xda, 0xfd, 0x77, 0xb0, 0xf6, 0x0d, 0xd1, 0x96, 0x7d, 0x4d, 0xb4, 0xe5, 0xde, 0x3a, 0xda, 0xe6,
-	0xd2, 0x4d, 0x7e, 0x2e, 0xdd, 0xbc, 0x75, 0x30, 0xf2, 0x37, 0x06, 0xe3, 0x5e, 0x32, 0x18, 0x7f,
-	0x82, 0x27, 0x4e, 0xc7, 0x63, 0x21, 0x0a, 0x39, 0xfd, 0xbf, 0xd2, 0xb0, 0x7c, 0xaa, 0x67, 0x42,
-	0x5f, 0x39, 0xd4, 0x9e, 0x16, 0x32, 0x1c, 0x93, 0xfb, 0xd3, 0xac, 0xbc, 0x28, 0xb2, 0xf2, 0xd5,
-	0x57, 0xb6, 0x5c, 0xf3, 0x99, 0xf9, 0x3e, 0xe4, 0x5c, 0xdf, 0x1c, 0x9a, 0xf2, 0x94, 0x5f, 0x3b,
-	0xb3, 0x2d, 0x70, 0x86, 0xc2, 0xc7, 0xe2, 0x23, 0x13, 0xcf, 0x8e, 0x73, 0xce, 0xcf, 0xce, 0xe7,
-	0xfa, 0x0f, 0x61, 0x89, 0x8d, 0x59, 0x2f, 0xc4, 0xca, 0xd9, 0x0d, 0x38, 0xf3, 0x02, 0x71, 0xb2,
-	0x19, 0xa3, 0x3a, 0x65, 0x77, 0x90, 0x5b, 0x6f, 0x4d, 0x93, 0x7f, 0x05, 0x8a, 0x7b, 0xed, 0x6e,
-	0xe7, 0x60, 0xf3, 0xe0, 0x59, 0x47, 0x55, 0x80, 0xb0, 0xd7, 0x63, 0x41, 0xa0, 0xa5, 0x04, 0x71,
-	0x64, 0x7a, 0x9e, 0xa8, 0x01, 0x25, 0xc8, 0x63, 0x0d, 0x08, 0x7d, 0xa6, 0xa5, 0xb1, 0x64, 0xf4,
-	0x5d, 0x87, 0x69, 0x99, 0xfa, 0xe7, 0x90, 0x93, 0xb6, 0x2b, 0x4d, 0x6d, 0x63, 0xf7, 0xeb, 0xdd,
-	0x3d, 0x6d, 0x81, 0x94, 0xa1, 0x70, 0x18, 0x9a, 0x16, 0xef, 0x9a, 0x8e, 0x96, 0x22, 0x04, 0xaa,
-	0x74, 0xc0, 0x99, 0x3f, 0xfd, 0x1a, 0xb5, 0xc5, 0xad, 0x2c, 0xa4, 0xed, 0x60, 0x58, 0xff, 0x45,
-	0x15, 0xd2, 0x1d, 0xff, 0x18, 0x5b, 0x6e, 0x6c, 0xdd, 0x4d, 0x67, 0x38, 0x6b, 0x72, 0x53, 0xb3,
-	0x6e, 0xb9, 0xe3, 0x1f, 0x8b, 0xbe, 0xc4, 0x74, 0x86, 0x91, 0xd7, 0x8c, 0xa5, 0x41, 0x92, 0x41,
-	0x6e, 0x41, 0x01, 0x59, 0x5d, 0x9f, 0x79, 0x2a, 0x6c, 0x96, 0xe2, 0x73, 0x0d, 0xe6, 0xb5, 0x16,
-	0x8c, 0xfc, 0x40, 0x0e, 0xf1, 0x22, 0x81, 0x1d, 0xb2, 0x6a, 0xec, 0xcb, 0x11, 0x12, 0x4f, 0x07,
-	0x2f, 0x12, 0x28, 0x23, 0xd7, 0x20, 0x2b, 0x9a, 0x27, 0xd5, 0x80, 0x54, 0x22, 0x90, 0x28, 0xc9,
-	0xd8, 0xa8, 0x09, 0x29, 0xde, 0x37, 0x22, 0xe3, 0x7d, 0x16, 0x84, 0x16, 0x57, 0x09, 0x7c, 0x75,
-	0xce, 0x74, 0x43, 0x08, 0xf1, 0xbe, 0x31, 0x88, 0x33, 0xf4, 0xbf, 0xa5, 0x61, 0x69, 0x6e, 0x77,
-	0xa4, 0x36, 0xf5, 0xb8, 0xf0, 0x43, 0xc1, 0x88, 0x48, 0x94, 0xa8, 0x53, 0x12, 0xbb, 0x2c, 0x18,
-	0x11, 0x49, 0x6e, 0xc0, 0xb2, 0x45, 0x03, 0xde, 0x15, 0x37, 0x86, 0x08, 0x93, 0x16, 0x98, 0x25,
-	0x14, 0xe0, 0xde, 0x3a, 0x0a, 0x7b, 0x0b, 0x88, 0xc4, 0x8e, 0x58, 0xef, 0xa8, 0x1b, 0x2d, 0x95,
-	0x11, 0x60, 0x4d, 0x80, 0x51, 0xf0, 0x50, 0xad, 0x99, 0x44, 0x47, 0xaa, 0xb3, 0x73, 0xe8, 0xce,
-	0xcc, 0x0e, 0xee, 0x72, 0x6a, 0x75, 0x39, 0x0b, 0x38, 0xa6, 0xc1, 0xd0, 0xe1, 0x22, 0x16, 0x2b,
-	0xc6, 0x92, 0x10, 0x1c, 0x20, 0x7f, 0x1b, 0xd9, 0x33, 0x2c, 0x1a, 0x1d, 0x61, 0xf3, 0x31, 0x2c,
-	0x1a, 0xad, 0xb0, 0xb7, 0x80, 0x28, 0x2c, 0xae, 0x16, 0x81, 0x0b, 0x02, 0xac, 0x49, 0xb0, 0x10,
-	0x48, 0xf4, 0x3a, 0x68, 0xb8, 0x7e, 0x42, 0x71, 0x51, 0x60, 0xab, 0xc8, 0x8f, 0xe9, 0xbd, 0xa1,
-	0xee, 0x6a, 0x09, 0xb5, 0x20, 0x6d, 0x40, 0x41, 0x5c, 0x6b, 0x43, 0x5d, 0xc8, 0x14, 0x56, 0x7d,
-	0x22, 0xb5, 0x92, 0x40, 0x2f, 0xcf, 0xd0, 0x1d, 0x29, 0xd0, 0x7f, 0x95, 0x82, 0xbc, 0x8a, 0x3e,
-	0x72, 0x1d, 0x96, 0x6c, 0x3a, 0x4e, 0x78, 0x25, 0x25, 0xe6, 0x55, 0x6c, 0x3a, 0x8e, 0xf9, 0x24,
-	0xba, 0x2b, 0x2d, 0xc6, 0xee, 0x4a, 0x2b, 0x90, 0xe5, 0xee, 0x11, 0x8b, 0x6a, 0x86, 0x24, 0xc8,
-	0xbf, 0xc2, 0x15, 0xd4, 0x38, 0xf7, 0xdd, 0x77, 0x3d, 0xe6, 0x4b, 0x03, 0xc5, 0x81, 0x66, 0x8c,
-	0x8b, 0x36, 0x1d, 0x37, 0x13, 0x49, 0x60, 0x9f, 0xf9, 0xc2, 0x4e, 0xfd, 0xaf, 0x69, 0xc8, 0xa0,
-	0x2b, 0xf0, 0x82, 0x17, 0xef, 0xe3, 0x48, 0xfc, 0x83, 0x48, 0x76, 0x6f, 0x1a, 0xa4, 0x9b, 0xbb,
-	0x3b, 0xaa, 0xb0, 0xe1, 0x50, 0xff, 0xf9, 0xb4, 0x6f, 0xdb, 0x3e, 0xb3, 0x6f, 0x5b, 0x3b, 0xad,
-	0xec, 0x75, 0xdd, 0xda, 0xef, 0x7f, 0x72, 0xb7, 0xd6, 0x9c, 0xef, 0xd6, 0x6e, 0xbe, 0x7e, 0xe5,
-	0x57, 0x54, 0xcd, 0x1b, 0xb1, 0x1e, 0xed, 0xd5, 0x95, 0x51, 0x60, 0xde, 0xba, 0xe6, 0x0d, 0xdf,
-	0x58, 0xf3, 0x36, 0x93, 0x35, 0xef, 0xed, 0x4c, 0x7f, 0x4d, 0xdb, 0x95, 0x87, 0xac, 0x48, 0x54,
-	0xfa, 0x6f, 0xd3, 0x50, 0x49, 0xa4, 0x20, 0x72, 0x09, 0x8a, 0x18, 0x55, 0xdd, 0x30, 0x60, 0xd2,
-	0xa9, 0x65, 0xa3, 0x80, 0x8c, 0x67, 0x01, 0xeb, 0x93, 0x7f, 0x82, 0xca, 0x09, 0x0d, 0xba, 0xc1,
-	0xc8, 0x37, 0x9d, 0x23, 0xd3, 0x19, 0xaa, 0x34, 0x53, 0x3e, 0xa1, 0x41, 0x27, 0xe2, 0xa1, 0x06,
-	0x87, 0x8d, 0x79, 0x57, 0x04, 0x6a, 0x5a, 0x6a, 0x40, 0x46, 0x07, 0x83, 0xf5, 0x3a, 0x2c, 0x9d,
-	0x98, 0x96, 0xd5, 0x75, 0xdc, 0x13, 0xa5, 0x46, 0x65, 0x96, 0x0a, 0xb2, 0xf7, 0xdc, 0x13, 0xa9,
-	0x87, 0x5c, 0x83, 0x6a, 0x10, 0x0e, 0x87, 0x2c, 0xe0, 0xac, 0x2f, 0x35, 0xc9, 0x3e, 0xa5, 0x32,
-	0xe5, 0x0a, 0x75, 0xfb, 0x50, 0x15, 0x5f, 0x0b, 0xf3, 0xd9, 0x98, 0xda, 0x9e, 0xc5, 0xc4, 0xab,
-	0x80, 0xba, 0x0e, 0x9c, 0xca, 0xaf, 0x8d, 0xed, 0x04, 0x76, 0x97, 0x33, 0xdb, 0x98, 0x9b, 0xaf,
-	0xff, 0x32, 0x05, 0xe4, 0x34, 0x8c, 0x7c, 0x05, 0xe5, 0xf8, 0xc3, 0xcf, 0x5b, 0x5d, 0x69, 0x4a,
-	0xb1, 0x87, 0x1f, 0xb2, 0x0d, 0x95, 0xc4, 0xab, 0x8f, 0x3a, 0xca, 0x37, 0x35, 0xb7, 0xe5, 0xf8,
-	0xb3, 0x4f, 0x54, 0x1a, 0xbf, 0x4b, 0x41, 0x4e, 0xde, 0x76, 0xc9, 0x35, 0xc8, 0x07, 0xbd, 0x11,
-	0xb3, 0x69, 0x54, 0x14, 0x4b, 0x62, 0xe7, 0x92, 0x65, 0x44, 0x32, 0xf2, 0x19, 0x14, 0x99, 0xd3,
-	0xf7, 0x5c, 0xd3, 0xe1, 0x81, 0xb8, 0x59, 0xa9, 0xe7, 0x0e, 0xa9, 0xa5, 0xd1, 0x8c, 0x64, 0x32,
-	0xda, 0x67, 0x58, 0xfd, 0x11, 0x54, 0x93, 0xc2, 0x78, 0x74, 0x56, 0x64, 0x74, 0xd6, 0x93, 0xd1,
-	0x29, 0x0a, 0x66, 0x34, 0x29, 0x16, 0x7e, 0xf5, 0xff, 0x4e, 0x41, 0x5e, 0x59, 0x46, 0x3e, 0x82,
-	0xcc, 0xb7, 0xd8, 0xc7, 0xa4, 0x84, 0x2d, 0xab, 0x31, 0xa3, 0x1b, 0x8f, 0x02, 0xd7, 0x91, 0x76,
-	0x08, 0x88, 0xfe, 0x04, 0x8a, 0x53, 0xd6, 0x19, 0xab, 0x7f, 0x94, 0x5c, 0xfd, 0x1c, 0xaa, 0x32,
-	0xd8, 0xa0, 0xed, 0x4b, 0x7d, 0x8f, 0x3a, 0xed, 0xbd, 0xb8, 0x11, 0x1e, 0x2c, 0xcd, 0x49, 0xc9,
-	0xfb, 0x90, 0xf6, 0x78, 0xf4, 0xdc, 0x55, 0x99, 0x99, 0xb2, 0xcf, 0xfd, 0xd6, 0x82, 0x81, 0x32,
-	0xf2, 0x11, 0xe4, 0xa4, 0x2b, 0x13, 0xed, 0x83, 0xe0, 0x34, 0x50, 0x47, 0x6b, 0xc1, 0x50, 0x80,
-	0xad, 0x25, 0xa8, 0x78, 0xdc, 0xef, 0xba, 0x7e, 0x57, 0x32, 0xea, 0x1b, 0x50, 0x9c, 0xea, 0x43,
-	0xfb, 0x3b, 0xbb, 0x3b, 0x91, 0xfd, 0x9d, 0xdd, 0x1d, 0xf1, 0x58, 0xc3, 0x06, 0xd3, 0xc7, 0x1a,
-	0x36, 0xa8, 0x7f, 0x09, 0x85, 0xc8, 0x7d, 0xe4, 0xfa, 0xd4, 0x4f, 0xb8, 0xac, 0x16, 0x77, 0xad,
-	0x5a, 0x57, 0xc8, 0xb7, 0x00, 0x0a, 0xd1, 0xa1, 0xd5, 0xff, 0x2f, 0x0d, 0xe5, 0x38, 0x88, 0x6c,
-	0x24, 0xb2, 0x64, 0x55, 0x36, 0x4e, 0x71, 0x44, 0xe3, 0xa9, 0x10, 0x4f, 0xd3, 0xe7, 0x5d, 0xa8,
-	0x78, 0x94, 0x8f, 0xba, 0x1e, 0xf5, 0xb9, 0x49, 0xad, 0x28, 0x64, 0xc4, 0xae, 0xf7, 0x29, 0x1f,
-	0xed, 0x4b, 0xbe, 0x51, 0xf6, 0x66, 0x44, 0x40, 0xae, 0x41, 0x4e, 0xa4, 0x97, 0x28, 0xc3, 0x56,
-	0x24, 0xdc, 0xa7, 0xb6, 0x38, 0x04, 0x25, 0x24, 0x9f, 0x41, 0x5e, 0x36, 0xdb, 0xd1, 0x65, 0xe6,
-	0xca, 0x29, 0x73, 0x64, 0xf0, 0x47, 0xb9, 0x57, 0xa1, 0xf5, 0x07, 0x50, 0x8e, 0x0b, 0xce, 0x88,
-	0x85, 0xc4, 0xab, 0x4a, 0x25, 0x7e, 0xec, 0x27, 0x90, 0x93, 0x7b, 0xc4, 0x86, 0xf5, 0xd9, 0xde,
-	0xe3, 0xbd, 0xf6, 0xbf, 0x61, 0x37, 0x9a, 0x87, 0xf4, 0xd7, 0xcd, 0x03, 0x2d, 0x85, 0x9d, 0x6b,
-	0xab, 0xb9, 0xb9, 0xa3, 0x2d, 0xe2, 0x68, 0xbf, 0xdd, 0x39, 0xd0, 0xd2, 0x28, 0xdc, 0x7f, 0x76,
-	0xa0, 0x65, 0x48, 0x11, 0xb2, 0xfb, 0x9b, 0x07, 0xdb, 0x2d, 0x2d, 0x4b, 0x00, 0x72, 0x3b, 0xcd,
-	0x27, 0xcd, 0x83, 0xa6, 0x96, 0x43, 0x4d, 0xdb, 0xed, 0xbd, 0xbd, 0xe6, 0xf6, 0x81, 0x96, 0x47,
-	0xa2, 0xbd, 0x7f, 0xb0, 0xdb, 0xde, 0xeb, 0x68, 0x05, 0x9c, 0x70, 0x60, 0x6c, 0x6e, 0x37, 0xb5,
-	0x62, 0xfd, 0x8f, 0x29, 0x28, 0x4e, 0x7d, 0x80, 0x37, 0x40, 0x33, 0x10, 0x49, 0xc4, 0xf4, 0x55,
-	0x7e, 0x2d, 0x18, 0x60, 0x06, 0x86, 0xe2, 0x44, 0xf1, 0xb1, 0x38, 0x8b, 0x8f, 0xe8, 0xee, 0x91,
-	0x8e, 0xdd, 0x3d, 0xae, 0x43, 0xe6, 0xc8, 0x74, 0xe4, 0x93, 0x69, 0x55, 0x16, 0xe4, 0xe9, 0x1a,
-	0x8d, 0xc7, 0xa6, 0xd3, 0x37, 0x84, 0xbc, 0xfe, 0x08, 0x32, 0x48, 0x25, 0xf7, 0x5c, 0x90, 0x25,
-	0x4c, 0x6e, 0x1a, 0x0f, 0x50, 0x5b, 0x44, 0x83, 0x9f, 0x87, 0xcc, 0x9f, 0x68, 0x69, 0xdc, 0xa1,
-	0x2c, 0x76, 0x5a, 0x06, 0xc7, 0x3d, 0xd7, 0x3d, 0x32, 0x99, 0x96, 0xad, 0x7f, 0x01, 0xa5, 0xd8,
-	0xd1, 0x93, 0x15, 0x9c, 0x1b, 0x3d, 0x3c, 0x62, 0x18, 0x22, 0x45, 0x88, 0xfc, 0x94, 0x16, 0x15,
-	0x13, 0x89, 0xad, 0x0c, 0x2c, 0x7a, 0x5e, 0xfd, 0xc7, 0x32, 0xe4, 0xe4, 0x67, 0xa0, 0xff, 0xb9,
-	0x0c, 0x19, 0xe1, 0x8d, 0x1b, 0x90, 0xe5, 0x13, 0x4f, 0xd5, 0xc3, 0xea, 0xed, 0x95, 0xb9, 0x8f,
-	0xaa, 0x71, 0x30, 0xf1, 0x98, 0x21, 0x21, 0x58, 0x78, 0x99, 0x13, 0xda, 0x2a, 0x12, 0x5f, 0x59,
-	0x78, 0x11, 0x43, 0x1a, 0x90, 0x1b, 0xb8, 0xbe, 0x4d, 0xb9, 0xba, 0x60, 0x9d, 0x9f, 0x57, 0xfc,
-	0x50, 0x48, 0x0d, 0x85, 0xc2, 0xeb, 0x93, 0x6d, 0x3a, 0x5d, 0x8b, 0x39, 0x43, 0x3e, 0x52, 0x8d,
-	0x51, 0xd1, 0x36, 0x9d, 0x27, 0x82, 0x21, 0xc4, 0x74, 0x1c, 0x89, 0xb3, 0x4a, 0x4c, 0xc7, 0x4a,
-	0xfc, 0x01, 0x54, 0x47, 0x34, 0xe8, 0xc6, 0x20, 0x39, 0x59, 0x15, 0x47, 0x34, 0x78, 0x3a, 0x45,
-	0xd5, 0x20, 0xef, 0x51, 0xce, 0x99, 0xef, 0x88, 0x1e, 0xb6, 0x68, 0x44, 0x24, 0x4a, 0x6c, 0xd3,
-	0x31, 0xed, 0xd0, 0x16, 0x0d, 0x6b, 0xca, 0x88, 0x48, 0x21, 0xa1, 0x63, 0x21, 0x29, 0x2a, 0x89,
-	0x24, 0x31, 0x8e, 0xc4, 0x9a, 0x6a, 0x1e, 0xc8, 0x38, 0xc2, 0x05, 0xd5, 0xd4, 0x08, 0xa0, 0xa6,
-	0x97, 0x66, 0x00, 0xa5, 0xe1, 0x2e, 0x9c, 0xe7, 0x3e, 0x75, 0x02, 0x8b, 0x62, 0x85, 0xb5, 0x43,
-	0x8b, 0x9b, 0x9e, 0xc5, 0xba, 0xee, 0xa0, 0x56, 0x16, 0x4b, 0xad, 0xcc, 0xa4, 0x4f, 0x95, 0xb0,
-	0x3d, 0x20, 0x37, 0x61, 0x99, 0x8d, 0x7b, 0x56, 0x18, 0x98, 0xc7, 0x6c, 0xba, 0x7a, 0x45, 0x36,
-	0xfb, 0x53, 0x41, 0x64, 0x43, 0x12, 0xac, 0x2c, 0xa9, 0xce, 0x83, 0x95, 0x3d, 0x2b, 0x90, 0x35,
-	0x39, 0xb3, 0x83, 0xda, 0x92, 0x78, 0xd6, 0x97, 0x04, 0x79, 0x1f, 0xca, 0xa1, 0x63, 0x3e, 0x0f,
-	0x59, 0x57, 0x0a, 0x35, 0x31, 0xbb, 0x24, 0x79, 0xbb, 0x02, 0x72, 0x09, 0xf0, 0xa8, 0x94, 0x7c,
-	0x59, 0x1c, 0x4e, 0xc1, 0x36, 0x9d, 0x99, 0x90, 0x8e, 0x95, 0x90, 0x28, 0x21, 0x1d, 0x4b, 0x61,
-	0x1d, 0x2a, 0xd1, 0xc1, 0x49, 0xc0, 0x39, 0xa9, 0x5d, 0x7a, 0x49, 0x62, 0xbe, 0x02, 0xf0, 0x7c,
-	0xd7, 0x63, 0x3e, 0x37, 0x59, 0x50, 0x5b, 0x11, 0xc1, 0xf7, 0xde, 0x7c, 0x38, 0xed, 0x4f, 0x11,
-	0x32, 0x63, 0xc5, 0xa6, 0x10, 0x1d, 0x0a, 0xd3, 0xcf, 0x7d, 0x55, 0xb4, 0x7d, 0x53, 0x1a, 0x9b,
-	0x1c, 0x34, 0x3d, 0xb6, 0xc0, 0x79, 0x61, 0x62, 0xc5, 0x36, 0x9d, 0x99, 0x4e, 0x01, 0xa3, 0xe3,
-	0x38, 0xec, 0x82, 0x82, 0xd1, 0x71, 0x0c, 0x76, 0x0b, 0x48, 0xb4, 0x9d, 0x18, 0xb4, 0x26, 0xfd,
-	0x2d, 0xf7, 0x14, 0x43, 0xff, 0x3b, 0xac, 0xd2, 0x7e, 0xdf, 0xc4, 0xb6, 0x9f, 0x5a, 0xf1, 0x09,
-	0x17, 0x45, 0xa5, 0xf9, 0x60, 0x7e, 0x8f, 0x9b, 0x53, 0xf0, 0x4c, 0x89, 0xb1, 0x42, 0xcf, 0xe0,
-	0x92, 0x07, 0x70, 0x11, 0x0d, 0x39, 0x5b, 0xbd, 0x2e, 0xec, 0xb9, 0x30, 0xa2, 0xc1, 0x59, 0x1a,
-	0xc9, 0x2a, 0xe4, 0xb0, 0x4b, 0x72, 0x07, 0xb5, 0x4b, 0x32, 0x0e, 0xa8, 0x65, 0xb5, 0x07, 0x82,
-	0xed, 0x4c, 0x90, 0x7d, 0x59, 0xb1, 0x9d, 0x89, 0x64, 0xbb, 0x8e, 0x08, 0xda, 0x2b, 0x92, 0xed,
-	0x3a, 0x18, 0xa5, 0x1a, 0xa4, 0x1d, 0x97, 0xd7, 0xd6, 0x64, 0x12, 0x75, 0x5c, 0xae, 0x7f, 0x01,
-	0x4b, 0x73, 0x87, 0xf4, 0xa6, 0x37, 0xf9, 0x78, 0xf5, 0xd0, 0xff, 0x13, 0x56, 0xce, 0xb4, 0xf6,
-	0x43, 0xa8, 0x52, 0xeb, 0x84, 0x4e, 0x02, 0x79, 0xf1, 0x8d, 0x32, 0x3a, 0xde, 0xe3, 0x25, 0xbf,
-	0x23, 0xd9, 0x98, 0x17, 0xa7, 0x69, 0x1d, 0xf3, 0x62, 0x67, 0x77, 0x67, 0xab, 0x04, 0x45, 0xda,
-	0xef, 0x0b, 0xdf, 0x04, 0x75, 0x17, 0x32, 0x98, 0xed, 0x4e, 0x55, 0x27, 0xea, 0xa8, 0x44, 0xed,
-	0x84, 0x96, 0x25, 0x9f, 0x5b, 0x0e, 0x5d, 0xd7, 0x62, 0xd4, 0xd1, 0xd2, 0x48, 0x98, 0x0e, 0x67,
-	0xc3, 0x28, 0x57, 0x3b, 0xa1, 0x7d, 0xc8, 0x7c, 0x2d, 0x8b, 0xe9, 0x9c, 0xfa, 0x3e, 0x9d, 0x68,
-	0x39, 0x64, 0x07, 0xdc, 0x37, 0x9d, 0xa1, 0x96, 0xc7, 0xb1, 0x7b, 0xf8, 0x2d, 0xeb, 0x71, 0xad,
-	0x50, 0xff, 0x3e, 0x05, 0x39, 0x99, 0x06, 0xe5, 0x43, 0xff, 0x5e, 0x53, 0x5b, 0x20, 0x15, 0x28,
-	0xf6, 0x29, 0x67, 0x5d, 0x6e, 0xda, 0x4c, 0x2e, 0x8b, 0xa4, 0xac, 0x0f, 0xcc, 0xa6, 0xa6, 0xa5,
-	0x65, 0x48, 0x19, 0x0a, 0x23, 0x37, 0xe0, 0x58, 0x87, 0xb4, 0x1c, 0x42, 0x4c, 0xef, 0xf8, 0xae,
-	0x56, 0x50, 0xa3, 0x7b, 0x5a, 0x11, 0xcd, 0x0e, 0x7d, 0x53, 0x03, 0xb2, 0x0c, 0x95, 0xd0, 0x37,
-	0xbb, 0x3e, 0x1b, 0x30, 0x9f, 0x39, 0x3d, 0xa6, 0x95, 0x50, 0x91, 0xcf, 0x86, 0x6c, 0xac, 0x2d,
-	0xe3, 0xd0, 0x74, 0xf8, 0x9d, 0xdb, 0x1a, 0x51, 0xc3, 0x7b, 0x77, 0xb5, 0x73, 0x38, 0x1c, 0x58,
-	0x2e, 0xe5, 0xda, 0x0a, 0x9a, 0xdb, 0x77, 0xc3, 0x43, 0x8b, 0x69, 0xab, 0xa2, 0x68, 0x4d, 0x38,
-	0xd3, 0xce, 0x23, 0xf7, 0xd0, 0x74, 0xa8, 0x3f, 0xd1, 0x2e, 0xa0, 0x2d, 0x1e, 0x0d, 0x82, 0x13,
-	0xd7, 0xef, 0x6b, 0xb5, 0xdb, 0x37, 0xa1, 0x84, 0xed, 0xfe, 0xe4, 0xa9, 0xf8, 0xbb, 0x99, 0x5c,
-	0x86, 0xc5, 0x1d, 0x97, 0xe4, 0x55, 0x83, 0xad, 0xe7, 0xd5, 0x95, 0xa0, 0xbe, 0xb0, 0x9e, 0xfa,
-	0x24, 0xb5, 0xb5, 0xf9, 0x9b, 0x97, 0x6b, 0xa9, 0x3f, 0xbd, 0x5c, 0x4b, 0x7d, 0xff, 0x72, 0x2d,
-	0xf5, 0xe3, 0xcb, 0xb5, 0xd4, 0x7f, 0x6c, 0xc4, 0xfe, 0x76, 0x8e, 0xe9, 0xd9, 0x76, 0x37, 0xe4,
-	0xff, 0xd7, 0x1b, 0x73, 0xff, 0x6d, 0x1f, 0xe6, 0x44, 0xf1, 0xb9, 0xf3, 0x8f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2e, 0x17, 0x00, 0x79, 0xf5, 0x1e, 0x00, 0x00,
-}
@@ -0,0 +1,302 @@
+package asyncapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+var _ modeler.StreamingCaller = (*caller)(nil)
+
+type namedLambda struct {
+	name   string
+	lambda modeler.CheckerFunc
+}
+
+// caller publishes a message then collects messages received in return
+type caller struct {
+	showf modeler.ShowFunc
+
+	m      *asyncm
+	eid    uint32
+	ch     *channel
+	url    string
+	window time.Duration
+
+	buildRequestErr error
+	reqBody         []byte
+	reqDecoded      *types.Value
+
+	connErr, sendErr, closedErr error
+
+	cn          *conn
+	start       time.Time
+	windowTimer *time.Timer
+	quiet       <-chan time.Time
+	done        bool
+
+	repProto *fm.Clt_CallResponseRaw_Output_WebSocketResponse
+
+	checks []namedLambda
+}
+
+// NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
+func (m *asyncm) NewCaller(ctx context.Context, msg *fm.Srv_Call, showf modeler.ShowFunc) modeler.Caller {
+	c := &caller{
+		showf:    showf,
+		m:        m,
+		eid:      msg.GetEID(),
+		window:   time.Duration(m.ReceiveWindowNs),
+		repProto: &fm.Clt_CallResponseRaw_Output_WebSocketResponse{},
+	}
+	c.checks = []namedLambda{
+		{"connection to server", c.checkConn},
+		{"valid JSON messages", c.checkValidJSON},
+		{"messages validate schema", c.checkValidatesJSONSchema},
+	}
+
+	ch, ok := m.channels[msg.GetEID()]
+	if !ok {
+		c.buildRequestErr = fmt.Errorf("no channel for endpoint #%d", msg.GetEID())
+		log.Println("[ERR]", c.buildRequestErr)
+		return c
+	}
+	c.ch = ch
+	c.url = m.channelURL(ch)
+
+	c.reqDecoded = msg.GetInput().GetHttpRequest().GetBody()
+	if c.reqDecoded == nil {
+		c.reqDecoded = &types.Value{Kind: &types.Value_NullValue{}}
+	}
+	buf := &bytes.Buffer{}
+	if c.buildRequestErr = (&jsonpb.Marshaler{}).Marshal(buf, c.reqDecoded); c.buildRequestErr != nil {
+		log.Println("[ERR]", c.buildRequestErr)
+		return c
+	}
+	c.reqBody = buf.Bytes()
+	return c
+}
+
+// RequestProto returns call input as used by the client
+func (c *caller) RequestProto() (i *fm.Clt_CallRequestRaw) {
+	i = &fm.Clt_CallRequestRaw{}
+	if err := c.buildRequestErr; err != nil {
+		i.Reason = strings.Split(err.Error(), "\n")
+		return
+	}
+
+	i.Input = &fm.Clt_CallRequestRaw_Input{
+		Input: &fm.Clt_CallRequestRaw_Input_WebsocketRequest{
+			WebsocketRequest: &fm.Clt_CallRequestRaw_Input_WebSocketRequest{
+				Url:         c.url,
+				Channel:     c.ch.name,
+				Body:        c.reqBody,
+				BodyDecoded: c.reqDecoded,
+			},
+		}}
+	return
+}
+
+// Do publishes the message then waits for messages in return
+func (c *caller) Do(ctx context.Context) {
+	c.Send(ctx)
+	for c.Receive(ctx) {
+	}
+}
+
+// Send publishes the message
+func (c *caller) Send(ctx context.Context) {
+	c.showf("> %s", c.ch.name)
+	c.start = time.Now()
+	// Armed once a first message is received
+	c.quiet = nil
+
+	if c.cn, c.connErr = c.m.connFor(ctx, c.url); c.connErr != nil {
+		c.repProto.Error = c.connErr.Error()
+		c.showf("< %s", c.connErr)
+		c.end()
+		return
+	}
+	if c.closedErr = dropStale(c.cn); c.closedErr != nil {
+		c.repProto.Error = c.closedErr.Error()
+		c.showf("< connection closed: %s", c.closedErr)
+		c.end()
+		return
+	}
+
+	if c.sendErr = c.cn.send(c.reqBody); c.sendErr != nil {
+		log.Println("[ERR]", c.sendErr)
+		c.repProto.Error = c.sendErr.Error()
+		c.showf("< %s", c.sendErr)
+		c.end()
+		return
+	}
+	c.repProto.Messages = append(c.repProto.Messages, &fm.Clt_CallResponseRaw_Output_WebSocketResponse_Message{
+		Sent:        true,
+		Data:        c.reqBody,
+		DataDecoded: c.reqDecoded,
+	})
+
+	if !c.ch.hasReply {
+		c.end()
+		return
+	}
+	c.windowTimer = time.NewTimer(c.window)
+}
+
+// dropStale discards messages received since the previous call
+func dropStale(cn *conn) error {
+	for {
+		select {
+		case msg, ok := <-cn.messages:
+			if !ok {
+				return cn.error()
+			}
+			log.Printf("[NFO] dropping message received in between calls: %q", msg.data)
+		default:
+			return nil
+		}
+	}
+}
+
+// Receive collects the next message unless the receive window elapsed or
+// no message was received for a tenth of that window.
+func (c *caller) Receive(ctx context.Context) bool {
+	if c.done {
+		return false
+	}
+	select {
+	case <-ctx.Done():
+	case <-c.windowTimer.C:
+	case <-c.quiet:
+	case msg, ok := <-c.cn.messages:
+		if !ok {
+			c.closedErr = c.cn.error()
+			c.repProto.Error = c.closedErr.Error()
+			c.showf("< connection closed: %s", c.closedErr)
+			break
+		}
+		rep := &fm.Clt_CallResponseRaw_Output_WebSocketResponse_Message{
+			Data:      msg.data,
+			ElapsedNs: msg.at.Sub(c.start).Nanoseconds(),
+		}
+		var x types.Value
+		if err := jsonpb.UnmarshalString(string(msg.data), &x); err != nil {
+			log.Println("[NFO] message could not be decoded:", err)
+		} else {
+			rep.DataDecoded = &x
+		}
+		c.repProto.Messages = append(c.repProto.Messages, rep)
+		c.quiet = time.After(c.window / 10)
+		return true
+	}
+	c.end()
+	return false
+}
+
+// end closes the stream of messages, recording how long the call took
+func (c *caller) end() {
+	c.done = true
+	if c.windowTimer != nil {
+		c.windowTimer.Stop()
+	}
+	c.repProto.ElapsedNs = time.Since(c.start).Nanoseconds()
+	if c.repProto.Error == "" {
+		c.showf("< %d message(s)", len(c.repProto.Messages)-1)
+	}
+}
+
+// ResponseProto returns call output as received by the client
+func (c *caller) ResponseProto() *fm.Clt_CallResponseRaw {
+	return &fm.Clt_CallResponseRaw{
+		Output: &fm.Clt_CallResponseRaw_Output{
+			Output: &fm.Clt_CallResponseRaw_Output_WebsocketResponse{
+				WebsocketResponse: c.repProto,
+			}}}
+}
+
+// NextCallerCheck returns ("",nil) when out of checks to run.
+// Otherwise it returns named checks inherent to the caller.
+func (c *caller) NextCallerCheck() (string, modeler.CheckerFunc) {
+	if len(c.checks) == 0 {
+		return "", nil
+	}
+	var nameAndLambda namedLambda
+	nameAndLambda, c.checks = c.checks[0], c.checks[1:]
+	return nameAndLambda.name, nameAndLambda.lambda
+}
+
+// received returns the messages that were not sent by us
+func (c *caller) received() []*fm.Clt_CallResponseRaw_Output_WebSocketResponse_Message {
+	if len(c.repProto.Messages) == 0 {
+		return nil
+	}
+	return c.repProto.Messages[1:]
+}
+
+func (c *caller) checkConn() (s, skipped string, f []string) {
+	switch {
+	case c.connErr != nil:
+		f = append(f, "communication with server could not be established")
+		f = append(f, c.connErr.Error())
+	case c.sendErr != nil:
+		f = append(f, "message could not be sent")
+		f = append(f, c.sendErr.Error())
+	case c.closedErr != nil:
+		f = append(f, "connection was closed by server")
+		f = append(f, c.closedErr.Error())
+	default:
+		s = "message sent"
+	}
+	return
+}
+
+func (c *caller) checkValidJSON() (s, skipped string, f []string) {
+	msgs := c.received()
+	if len(msgs) == 0 {
+		skipped = "no message received"
+		return
+	}
+	for i, msg := range msgs {
+		if msg.DataDecoded == nil {
+			f = append(f, fmt.Sprintf("message #%d is not JSON: %q", 1+i, msg.Data))
+		}
+	}
+	if len(f) == 0 {
+		s = fmt.Sprintf("%d message(s) decoded", len(msgs))
+	}
+	return
+}
+
+func (c *caller) checkValidatesJSONSchema() (s, skipped string, f []string) {
+	if !c.ch.hasReply {
+		skipped = "channel has no subscribe operation"
+		return
+	}
+	msgs := c.received()
+	if len(msgs) == 0 {
+		skipped = "no message received"
+		return
+	}
+	SID := c.m.Spec.Spec.Endpoints[c.eid].GetJson().GetOutputs()[0]
+	for i, msg := range msgs {
+		if msg.DataDecoded == nil {
+			continue
+		}
+		for _, err := range c.m.Validate(SID, msg.DataDecoded) {
+			f = append(f, fmt.Sprintf("message #%d: %s", 1+i, err))
+		}
+	}
+	if len(f) == 0 {
+		s = "messages validate JSON Schema"
+	}
+	return
+}
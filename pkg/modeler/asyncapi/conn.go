@@ -0,0 +1,102 @@
+package asyncapi
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"golang.org/x/net/websocket"
+)
+
+const dialTimeout = 10 * time.Second
+
+// received is a message read from a connection
+type received struct {
+	data []byte
+	at   time.Time
+}
+
+// conn is a WebSocket connection whose messages are read in the background
+type conn struct {
+	ws *websocket.Conn
+	// messages is closed once reading fails, after err is set
+	messages chan received
+
+	mu  sync.Mutex
+	err error
+}
+
+func (m *asyncm) connFor(ctx context.Context, u string) (*conn, error) {
+	if c, ok := m.conns[u]; ok {
+		return c, nil
+	}
+
+	// Origin is mandatory in this WebSocket client
+	origin := "http" + strings.TrimPrefix(m.Url, "ws")
+	config, err := websocket.NewConfig(u, origin)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	config.Dialer = &net.Dialer{Timeout: dialTimeout}
+	config.Header.Set("User-Agent", ctx.Value(ctxvalues.UserAgent).(string))
+	for _, key := range m.headerKeys() {
+		config.Header.Set(key, m.Headers[key])
+	}
+
+	log.Println("[NFO] dialing", u)
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	c := &conn{
+		ws:       ws,
+		messages: make(chan received, 64),
+	}
+	go c.read()
+	if m.conns == nil {
+		m.conns = make(map[string]*conn)
+	}
+	m.conns[u] = c
+	return c, nil
+}
+
+func (c *conn) read() {
+	defer close(c.messages)
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(c.ws, &data); err != nil {
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+			return
+		}
+		c.messages <- received{data: data, at: time.Now()}
+	}
+}
+
+// error is why reading failed, once messages is closed
+func (c *conn) error() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// send writes data as a text frame
+func (c *conn) send(data []byte) error {
+	return websocket.Message.Send(c.ws, string(data))
+}
+
+func (c *conn) close() error {
+	err := c.ws.Close()
+	// Unblocks the reader if it is waiting on a full buffer
+	for range c.messages {
+	}
+	return err
+}
@@ -0,0 +1,274 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+)
+
+type schemaJSON = map[string]interface{}
+type schemasJSON = map[string]schemaJSON
+
+const (
+	componentsSchemas  = "#/components/schemas/"
+	componentsMessages = "#/components/messages/"
+)
+
+// document is the part of an AsyncAPI 2.x document describing messages:
+// https://www.asyncapi.com/docs/specifications/v2.0.0
+type document struct {
+	AsyncAPI string             `json:"asyncapi"`
+	Servers  map[string]*server `json:"servers"`
+	Channels map[string]*struct {
+		// Publish describes messages the application expects to receive
+		// (i.e. messages we send)
+		Publish *operation `json:"publish"`
+		// Subscribe describes messages the application sends
+		// (i.e. messages we receive)
+		Subscribe *operation `json:"subscribe"`
+	} `json:"channels"`
+	Components struct {
+		Schemas  map[string]*openapi3.SchemaRef `json:"schemas"`
+		Messages map[string]*message            `json:"messages"`
+	} `json:"components"`
+}
+
+type server struct {
+	URL      string `json:"url"`
+	Protocol string `json:"protocol"`
+}
+
+type operation struct {
+	OperationID string `json:"operationId"`
+	Tags        []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	Message *message `json:"message"`
+}
+
+type message struct {
+	Ref         string              `json:"$ref"`
+	Name        string              `json:"name"`
+	ContentType string              `json:"contentType"`
+	Payload     *openapi3.SchemaRef `json:"payload"`
+	OneOf       []*message          `json:"oneOf"`
+}
+
+// channel is a linted channel of a document
+type channel struct {
+	name                 string
+	operationID          string
+	tags                 []string
+	publish, subscribe   schemaJSON
+	hasPublish, hasReply bool
+}
+
+func parseDocument(blob []byte) (doc *document, err error) {
+	// NOTE: YAML is a superset of JSON
+	var js []byte
+	if js, err = yaml.YAMLToJSON(blob); err != nil {
+		return
+	}
+	doc = &document{}
+	if err = json.Unmarshal(js, doc); err != nil {
+		return
+	}
+	if !strings.HasPrefix(doc.AsyncAPI, "2.") {
+		err = fmt.Errorf("unsupported AsyncAPI version %q (want 2.x.y)", doc.AsyncAPI)
+	}
+	return
+}
+
+// defaultURL is the URL of the first WebSocket server, by name
+func (doc *document) defaultURL() string {
+	names := make([]string, 0, len(doc.Servers))
+	for name := range doc.Servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := doc.Servers[name]
+		if s.Protocol != "ws" && s.Protocol != "wss" {
+			continue
+		}
+		if strings.Contains(s.URL, "://") {
+			return s.URL
+		}
+		return s.Protocol + "://" + s.URL
+	}
+	return ""
+}
+
+// schemas converts components' schemas
+func (doc *document) schemas() schemasJSON {
+	schemas := make(schemasJSON, len(doc.Components.Schemas))
+	for name, schema := range doc.Components.Schemas {
+		schemas[name] = openapiv3.SchemaFromOA3(schema)
+	}
+	return schemas
+}
+
+// payloadSchema returns the schema of m's payload(s), resolving message refs
+func (doc *document) payloadSchema(m *message, seen map[string]bool) (schema schemaJSON, err error) {
+	if ref := m.Ref; ref != "" {
+		name := strings.TrimPrefix(ref, componentsMessages)
+		mm, ok := doc.Components.Messages[name]
+		if !ok || name == ref {
+			err = fmt.Errorf("no such message %q", ref)
+			return
+		}
+		if seen[name] {
+			err = fmt.Errorf("message %q references itself", ref)
+			return
+		}
+		seen[name] = true
+		defer delete(seen, name)
+		return doc.payloadSchema(mm, seen)
+	}
+
+	if len(m.OneOf) != 0 {
+		oneOf := make([]schemaJSON, 0, len(m.OneOf))
+		for _, mm := range m.OneOf {
+			var s schemaJSON
+			if s, err = doc.payloadSchema(mm, seen); err != nil {
+				return
+			}
+			oneOf = append(oneOf, s)
+		}
+		schema = schemaJSON{"oneOf": oneOf}
+		return
+	}
+
+	if ct := m.ContentType; ct != "" && !strings.Contains(ct, "json") {
+		err = fmt.Errorf("unsupported content type %q", ct)
+		return
+	}
+	if m.Payload == nil {
+		// Any JSON value
+		schema = schemaJSON{}
+		return
+	}
+	schema = openapiv3.SchemaFromOA3(m.Payload)
+	return
+}
+
+// channels lints channels then returns those we can publish to, sorted
+func (doc *document) channels() (channels []*channel, err error) {
+	schemas := doc.schemas()
+	names := make([]string, 0, len(doc.Channels))
+	for name := range doc.Channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		c := doc.Channels[name]
+		if c == nil || c.Publish == nil {
+			continue
+		}
+		ch := &channel{
+			name:        name,
+			operationID: c.Publish.OperationID,
+			hasPublish:  true,
+		}
+		for _, tag := range c.Publish.Tags {
+			ch.tags = append(ch.tags, tag.Name)
+		}
+
+		if c.Publish.Message == nil {
+			err = fmt.Errorf("channel %q: publish has no message", name)
+			return
+		}
+		if ch.publish, err = doc.payloadSchema(c.Publish.Message, map[string]bool{}); err != nil {
+			err = fmt.Errorf("channel %q: publish: %v", name, err)
+			return
+		}
+		if err = checkRefs(schemas, ch.publish); err != nil {
+			err = fmt.Errorf("channel %q: publish: %v", name, err)
+			return
+		}
+
+		if sub := c.Subscribe; sub != nil && sub.Message != nil {
+			ch.hasReply = true
+			if ch.subscribe, err = doc.payloadSchema(sub.Message, map[string]bool{}); err != nil {
+				err = fmt.Errorf("channel %q: subscribe: %v", name, err)
+				return
+			}
+			if err = checkRefs(schemas, ch.subscribe); err != nil {
+				err = fmt.Errorf("channel %q: subscribe: %v", name, err)
+				return
+			}
+		}
+		channels = append(channels, ch)
+	}
+
+	for name, schema := range schemas {
+		if err = checkRefs(schemas, schema); err != nil {
+			err = fmt.Errorf("schema %q: %v", name, err)
+			return
+		}
+	}
+	return
+}
+
+// checkRefs ensures all references point to components' schemas
+func checkRefs(schemas schemasJSON, schema schemaJSON) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		if name := strings.TrimPrefix(ref, componentsSchemas); name == ref || schemas[name] == nil {
+			return fmt.Errorf("no such schema %q", ref)
+		}
+		return nil
+	}
+	for _, key := range []string{"items", "allOf", "anyOf", "oneOf"} {
+		if of, ok := schema[key].([]schemaJSON); ok {
+			for _, s := range of {
+				if err := checkRefs(schemas, s); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if properties, ok := schema["properties"].(schemasJSON); ok {
+		for _, s := range properties {
+			if err := checkRefs(schemas, s); err != nil {
+				return err
+			}
+		}
+	}
+	if not, ok := schema["not"].(schemaJSON); ok {
+		return checkRefs(schemas, not)
+	}
+	return nil
+}
+
+// newSpec maps each channel to an endpoint publishing a message to it
+func newSpec(doc *document, channels []*channel) (spec *openapiv3.Spec, err error) {
+	if spec, err = openapiv3.NewSpec(doc.schemas()); err != nil {
+		return
+	}
+	for _, ch := range channels {
+		e := &fm.EndpointJSON{
+			Method:       fm.EndpointJSON_POST,
+			PathPartials: []*fm.PathPartial{{Pp: &fm.PathPartial_Part{Part: ch.name}}},
+			Inputs: []*fm.ParamJSON{{
+				IsRequired: true,
+				SID:        spec.SID(ch.publish),
+				Kind:       fm.ParamJSON_body,
+			}},
+			Outputs:     map[uint32]uint32{},
+			OperationId: ch.operationID,
+			Tags:        ch.tags,
+		}
+		if ch.hasReply {
+			e.Outputs[0] = spec.SID(ch.subscribe)
+		}
+		spec.AddEndpoint(e)
+	}
+	return
+}
@@ -0,0 +1,239 @@
+package asyncapi
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"go.starlark.net/starlark"
+)
+
+var _ modeler.Interface = (*asyncm)(nil)
+
+type T = asyncm
+
+const defaultReceiveWindow = time.Second
+
+// asyncm models message-based APIs described by AsyncAPI documents:
+// each channel one can publish to is an endpoint whose input is a message.
+// Messages received on the channel's connection are validated
+// against the channel's subscribe operation.
+type asyncm struct {
+	fm.Clt_Fuzz_Model_AsyncAPI
+
+	*openapiv3.Spec
+
+	resetter resetter.Interface
+
+	files    map[string]string
+	channels map[uint32]*channel
+
+	// conns are kept open for the duration of a test, keyed by URL
+	conns map[string]*conn
+}
+
+// ToProto describes the model, its spec included, for the server
+func (m *asyncm) ToProto() *fm.Clt_Fuzz_Model {
+	if m.Spec != nil {
+		m.Clt_Fuzz_Model_AsyncAPI.Spec = m.Spec.Spec
+	}
+	return &fm.Clt_Fuzz_Model{
+		Model: &fm.Clt_Fuzz_Model_Asyncapi{
+			Asyncapi: &m.Clt_Fuzz_Model_AsyncAPI,
+		},
+	}
+}
+
+// FromProto restores a model described by ToProto
+func (m *asyncm) FromProto(p *fm.Clt_Fuzz_Model) error {
+	if mm := p.GetAsyncapi(); mm != nil {
+		m.Clt_Fuzz_Model_AsyncAPI = *mm
+		m.Spec = openapiv3.SpecFromProto(mm.GetSpec())
+		return nil
+	}
+	return fmt.Errorf("unexpected model type: %T", p.GetModel())
+}
+
+// SetResetter sets the resetter in use before each test
+func (m *asyncm) SetResetter(sr resetter.Interface) { m.resetter = sr }
+
+// GetResetter returns the resetter in use before each test
+func (m *asyncm) GetResetter() resetter.Interface { return m.resetter }
+
+// NewFromKwargs reads file, url, receive_window & headers from the kwargs of AsyncAPI(...)
+func (m *asyncm) NewFromKwargs(d starlark.StringDict) (modeler.Interface, *modeler.Error) {
+	m = &asyncm{}
+	m.ReceiveWindowNs = defaultReceiveWindow.Nanoseconds()
+	for key, value := range d {
+		switch key {
+		case "file":
+			file, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, "a string", value.Type())
+			}
+			m.File = file.GoString()
+
+		case "url":
+			u, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, "a string", value.Type())
+			}
+			if m.Url = u.GoString(); !isWebSocketURL(m.Url) {
+				return nil, modeler.NewError(key, "a URL such as ws://localhost:8080", m.Url)
+			}
+
+		case "receive_window":
+			const want = `a positive duration such as "500ms" or "2s"`
+			str, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, want, value.Type())
+			}
+			window, err := time.ParseDuration(str.GoString())
+			if err != nil || window <= 0 {
+				return nil, modeler.NewError(key, want, str.GoString())
+			}
+			m.ReceiveWindowNs = window.Nanoseconds()
+
+		case "headers":
+			dict, ok := value.(*starlark.Dict)
+			if !ok {
+				return nil, modeler.NewError(key, "a dict of strings", value.Type())
+			}
+			m.Headers = make(map[string]string, dict.Len())
+			for _, kv := range dict.Items() {
+				k, ok := kv[0].(starlark.String)
+				if !ok {
+					return nil, modeler.NewError(key, "a dict of strings", kv[0].Type())
+				}
+				v, ok := kv[1].(starlark.String)
+				if !ok {
+					return nil, modeler.NewError(key, "a dict of strings", kv[1].Type())
+				}
+				m.Headers[k.GoString()] = v.GoString()
+			}
+
+		default:
+			return nil, modeler.NewError(key, "unset (unknown field)", value.Type())
+		}
+	}
+
+	if m.File == "" {
+		return nil, modeler.NewError("file", "a path to an AsyncAPI document", `""`)
+	}
+	return m, nil
+}
+
+func isWebSocketURL(u string) bool {
+	return strings.HasPrefix(u, "ws://") || strings.HasPrefix(u, "wss://")
+}
+
+// Lint reads the AsyncAPI document and maps its channels into endpoints
+func (m *asyncm) Lint(ctx context.Context, showSpec bool) (err error) {
+	var blob []byte
+	if blob, err = ioutil.ReadFile(m.File); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.files = map[string]string{m.File: string(blob)}
+
+	var doc *document
+	if doc, err = parseDocument(blob); err != nil {
+		err = fmt.Errorf("%s: %v", m.File, err)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if m.Url == "" {
+		if m.Url = doc.defaultURL(); m.Url == "" {
+			err = fmt.Errorf("%s: no ws:// or wss:// server found, please set url", m.File)
+			log.Println("[ERR]", err)
+			return
+		}
+		log.Println("[NFO] using server", m.Url)
+	}
+
+	var channels []*channel
+	if channels, err = doc.channels(); err != nil {
+		err = fmt.Errorf("%s: %v", m.File, err)
+		log.Println("[ERR]", err)
+		return
+	}
+	if len(channels) == 0 {
+		err = fmt.Errorf("%s: no channel with a publish operation", m.File)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if showSpec {
+		for _, ch := range channels {
+			reply := "(no reply)"
+			if ch.hasReply {
+				reply = "subscribe"
+			}
+			fmt.Fprintf(os.Stderr, "%s: publish -> %s\n", ch.name, reply)
+		}
+	}
+
+	log.Println("[NFO] mapping channels to endpoints")
+	if m.Spec, err = newSpec(doc, channels); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.channels = make(map[uint32]*channel, len(channels))
+	for i, ch := range channels {
+		// Endpoints were added in order, starting from 1
+		m.channels[uint32(1+i)] = ch
+	}
+
+	log.Println("[NFO] model is valid")
+	return
+}
+
+// Files lists the local files read by the model, keyed by path.
+func (m *asyncm) Files() map[string]string {
+	return m.files
+}
+
+// channelURL is where messages of ch are exchanged:
+// channels named after paths are relative to the server URL.
+func (m *asyncm) channelURL(ch *channel) string {
+	if strings.HasPrefix(ch.name, "/") {
+		return strings.TrimSuffix(m.Url, "/") + ch.name
+	}
+	return m.Url
+}
+
+// headerKeys returns the keys of the configured headers, sorted
+func (m *asyncm) headerKeys() []string {
+	keys := make([]string, 0, len(m.Headers))
+	for key := range m.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ResetCaller is called before each test: connections are not
+// shared across tests so messages from one test do not leak into the next.
+func (m *asyncm) ResetCaller() { m.closeConns() }
+
+// Close releases connections held across calls
+func (m *asyncm) Close() { m.closeConns() }
+
+func (m *asyncm) closeConns() {
+	for u, c := range m.conns {
+		if err := c.close(); err != nil {
+			log.Println("[NFO] closing", u, err)
+		}
+	}
+	m.conns = nil
+}
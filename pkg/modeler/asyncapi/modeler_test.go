@@ -0,0 +1,306 @@
+package asyncapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/modelertest"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"golang.org/x/net/websocket"
+)
+
+func newChat(t *testing.T, kwargs starlark.StringDict) *asyncm {
+	kwargs["file"] = starlark.String(filepath.Join("testdata", "chat.yaml"))
+	return modelertest.New(t, &asyncm{}, kwargs).(*asyncm)
+}
+
+func TestKwargs(t *testing.T) {
+	modelertest.CheckKwargs(t, &asyncm{}, map[string]string{
+		`{"file": "a.yaml"}`: "",
+		`{"file": "a.yaml", "uri": "ws://localhost:8080"}`:             "(uri = ...) must be unset (unknown field), got: string",
+		`{"file": "a.yaml", "url": "wss://example.com/ws"}`:            "",
+		`{"file": "a.yaml", "receive_window": "200ms"}`:                "",
+		`{"file": "a.yaml", "headers": {"Authorization": "Bearer x"}}`: "",
+		`{"url": "ws://localhost:8080"}`:                               `(file = ...) must be a path to an AsyncAPI document, got: ""`,
+		`{"file": 42}`:                                                 "(file = ...) must be a string, got: int",
+		`{"file": "a.yaml", "url": "http://localhost:8080"}`:           "(url = ...) must be a URL such as ws://localhost:8080, got: http://localhost:8080",
+		`{"file": "a.yaml", "receive_window": "-1s"}`:                  `(receive_window = ...) must be a positive duration such as "500ms" or "2s", got: -1s`,
+		`{"file": "a.yaml", "receive_window": 1}`:                      `(receive_window = ...) must be a positive duration such as "500ms" or "2s", got: int`,
+		`{"file": "a.yaml", "headers": {"k": 1}}`:                      "(headers = ...) must be a dict of strings, got: int",
+	})
+}
+
+func TestLintMapsChannelsToEndpoints(t *testing.T) {
+	m := newChat(t, starlark.StringDict{})
+	require.Len(t, m.Files(), 1)
+	require.Equal(t, "ws://localhost:8080", m.Url)
+	require.Equal(t, 3, m.InputsCount())
+
+	endpoints := m.ToProto().GetAsyncapi().GetSpec().GetEndpoints()
+	require.Len(t, endpoints, 2)
+
+	chat := endpoints[1].GetJson()
+	require.Equal(t, fm.EndpointJSON_POST, chat.GetMethod())
+	require.Equal(t, "/chat", chat.GetPathPartials()[0].GetPart())
+	require.Equal(t, "postMessage", chat.GetOperationId())
+	require.Equal(t, []string{"chat"}, chat.GetTags())
+	require.Contains(t, chat.GetOutputs(), uint32(0))
+	require.Equal(t, "ws://localhost:8080/chat", m.channelURL(m.channels[1]))
+
+	notify := endpoints[2].GetJson()
+	require.Equal(t, "/notify", notify.GetPathPartials()[0].GetPart())
+	require.Empty(t, notify.GetOutputs())
+
+	for js, valid := range map[string]bool{
+		`{"text": "hi", "author": "alice"}`: true,
+		`{"text": "hi", "author": ""}`:      false,
+		`{"author": "alice"}`:               false,
+		`{"text": 42}`:                      false,
+	} {
+		errs := m.Validate(chat.GetInputs()[0].GetSID(), jsonValue(t, js))
+		require.Equal(t, valid, len(errs) == 0, js)
+	}
+	SID := chat.GetOutputs()[0]
+	require.Empty(t, m.Validate(SID, jsonValue(t, `{"ok": true}`)))
+	require.Empty(t, m.Validate(SID, jsonValue(t, `{"text": "hi"}`)))
+	require.NotEmpty(t, m.Validate(SID, jsonValue(t, `{"ok": "yes"}`)))
+}
+
+func TestLintErrors(t *testing.T) {
+	for doc, expected := range map[string]string{
+		`asyncapi: 3.0.0`: `unsupported AsyncAPI version "3.0.0" (want 2.x.y)`,
+		`{asyncapi: 2.0.0, channels: {/a: {subscribe: {message: {}}}}}`:                                        `no channel with a publish operation`,
+		`{asyncapi: 2.0.0, channels: {/a: {publish: {}}}}`:                                                     `channel "/a": publish has no message`,
+		`{asyncapi: 2.0.0, channels: {/a: {publish: {message: {$ref: "#/components/messages/M"}}}}}`:           `channel "/a": publish: no such message "#/components/messages/M"`,
+		`{asyncapi: 2.0.0, channels: {/a: {publish: {message: {payload: {$ref: "#/components/schemas/S"}}}}}}`: `channel "/a": publish: no such schema "#/components/schemas/S"`,
+		`{asyncapi: 2.0.0, channels: {/a: {publish: {message: {contentType: text/plain}}}}}`:                   `channel "/a": publish: unsupported content type "text/plain"`,
+		`{asyncapi: 2.0.0, channels: {/a: {publish: {message: {}}, subscribe: {message: {oneOf: [{$ref: "#/components/messages/M"}]}}}}, components: {messages: {M: {payload: {items: {$ref: "#/components/schemas/S"}, type: array}}}}}`: `channel "/a": subscribe: no such schema "#/components/schemas/S"`,
+	} {
+		t.Run(doc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "doc.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(doc), 0644))
+			mdl, merr := (&asyncm{}).NewFromKwargs(starlark.StringDict{
+				"file": starlark.String(path),
+				"url":  starlark.String("ws://localhost:8080"),
+			})
+			require.Nil(t, merr)
+			err := mdl.Lint(context.Background(), false)
+			require.EqualError(t, err, path+": "+expected)
+		})
+	}
+}
+
+func jsonValue(t *testing.T, js string) *types.Value {
+	var v types.Value
+	require.NoError(t, jsonpb.UnmarshalString(js, &v))
+	return &v
+}
+
+// chatServer acks chat messages, replying according to their text
+func chatServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/chat", websocket.Handler(func(ws *websocket.Conn) {
+		for {
+			var msg struct {
+				Text string `json:"text"`
+			}
+			if err := websocket.JSON.Receive(ws, &msg); err != nil {
+				return
+			}
+			var reply string
+			switch msg.Text {
+			case "garbage":
+				reply = `not JSON`
+			case "bad":
+				reply = `{"ok": "yes"}`
+			case "echo":
+				reply = `{"text": "echo"}`
+			case "bye":
+				return
+			default:
+				reply = `{"ok": true}`
+			}
+			if err := websocket.Message.Send(ws, reply); err != nil {
+				t.Log(err)
+				return
+			}
+		}
+	}))
+	mux.Handle("/notify", websocket.Handler(func(ws *websocket.Conn) {
+		var data []byte
+		for websocket.Message.Receive(ws, &data) == nil {
+		}
+	}))
+	return httptest.NewServer(mux)
+}
+
+func callOn(t *testing.T, m *asyncm, channel, body string) *caller {
+	var msg *fm.Srv_Call
+	for EID, ch := range m.channels {
+		if ch.name == channel {
+			msg = &fm.Srv_Call{EID: EID, Input: &fm.Srv_Call_Input{
+				Input: &fm.Srv_Call_Input_HttpRequest_{HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+					Method: "POST",
+					Url:    channel,
+					Body:   jsonValue(t, body),
+				}},
+			}}
+		}
+	}
+	require.NotNil(t, msg)
+
+	ctx := modelertest.Context()
+	c := m.NewCaller(ctx, msg, t.Logf).(*caller)
+	require.Empty(t, c.RequestProto().GetReason())
+	c.Do(ctx)
+	return c
+}
+
+func runChecks(c *caller) (failed, skipped []string) {
+	for {
+		name, check := c.NextCallerCheck()
+		if check == nil {
+			return
+		}
+		_, s, f := check()
+		if len(f) != 0 {
+			failed = append(failed, name)
+		}
+		if s != "" {
+			skipped = append(skipped, name)
+		}
+	}
+}
+
+func TestCallerExchangesMessages(t *testing.T) {
+	srv := chatServer(t)
+	defer srv.Close()
+	m := newChat(t, starlark.StringDict{
+		"url":            starlark.String("ws" + strings.TrimPrefix(srv.URL, "http")),
+		"receive_window": starlark.String("500ms"),
+	})
+	defer m.Close()
+
+	for name, tc := range map[string]struct {
+		channel, body string
+		received      int
+		failed        []string
+		skipped       []string
+	}{
+		"ack":          {"/chat", `{"text": "hi"}`, 1, nil, nil},
+		"echo":         {"/chat", `{"text": "echo"}`, 1, nil, nil},
+		"not JSON":     {"/chat", `{"text": "garbage"}`, 1, []string{"valid JSON messages"}, nil},
+		"invalid":      {"/chat", `{"text": "bad"}`, 1, []string{"messages validate schema"}, nil},
+		"closed":       {"/chat", `{"text": "bye"}`, 0, []string{"connection to server"}, []string{"valid JSON messages", "messages validate schema"}},
+		"no subscribe": {"/notify", `{"topic": "t"}`, 0, nil, []string{"valid JSON messages", "messages validate schema"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m.ResetCaller()
+			c := callOn(t, m, tc.channel, tc.body)
+
+			req := c.RequestProto().GetInput().GetWebsocketRequest()
+			require.Equal(t, tc.channel, req.GetChannel())
+			require.True(t, strings.HasSuffix(req.GetUrl(), tc.channel))
+
+			rep := c.ResponseProto().GetOutput().GetWebsocketResponse()
+			require.Len(t, rep.GetMessages(), 1+tc.received)
+			require.True(t, rep.GetMessages()[0].GetSent())
+			var sent interface{}
+			require.NoError(t, json.Unmarshal(rep.GetMessages()[0].GetData(), &sent))
+
+			failed, skipped := runChecks(c)
+			require.Equal(t, tc.failed, failed)
+			require.Equal(t, tc.skipped, skipped)
+		})
+	}
+}
+
+func TestCallerKeepsConnectionWithinTest(t *testing.T) {
+	srv := chatServer(t)
+	defer srv.Close()
+	m := newChat(t, starlark.StringDict{
+		"url":            starlark.String("ws" + strings.TrimPrefix(srv.URL, "http")),
+		"receive_window": starlark.String("500ms"),
+	})
+	defer m.Close()
+
+	callOn(t, m, "/chat", `{"text": "hi"}`)
+	require.Len(t, m.conns, 1)
+	first := m.conns[m.channelURL(m.channels[1])]
+	c := callOn(t, m, "/chat", `{"text": "hi"}`)
+	require.Len(t, c.received(), 1)
+	require.Equal(t, first, m.conns[m.channelURL(m.channels[1])])
+
+	m.ResetCaller()
+	require.Empty(t, m.conns)
+}
+
+func TestCallerReportsUnreachableServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	m := newChat(t, starlark.StringDict{"url": starlark.String("ws://" + addr)})
+	defer m.Close()
+
+	c := callOn(t, m, "/chat", `{"text": "hi"}`)
+	rep := c.ResponseProto().GetOutput().GetWebsocketResponse()
+	require.NotEmpty(t, rep.GetError())
+	require.NotZero(t, rep.GetElapsedNs())
+
+	name, check := c.NextCallerCheck()
+	require.Equal(t, "connection to server", name)
+	_, _, f := check()
+	require.NotEmpty(t, f)
+}
+
+func TestCallerStreamsMessages(t *testing.T) {
+	srv := chatServer(t)
+	defer srv.Close()
+	m := newChat(t, starlark.StringDict{
+		"url":            starlark.String("ws" + strings.TrimPrefix(srv.URL, "http")),
+		"receive_window": starlark.String("500ms"),
+	})
+	defer m.Close()
+
+	for channel, expected := range map[string]int{"/chat": 1, "/notify": 0} {
+		t.Run(channel, func(t *testing.T) {
+			var msg *fm.Srv_Call
+			for EID, ch := range m.channels {
+				if ch.name == channel {
+					msg = &fm.Srv_Call{EID: EID, Input: &fm.Srv_Call_Input{
+						Input: &fm.Srv_Call_Input_HttpRequest_{HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+							Body: jsonValue(t, `{"text": "hi", "topic": "t"}`),
+						}},
+					}}
+				}
+			}
+			ctx := modelertest.Context()
+			c := m.NewCaller(ctx, msg, t.Logf).(modeler.StreamingCaller)
+
+			c.Send(ctx)
+			received := 0
+			for c.Receive(ctx) {
+				received++
+			}
+			require.Equal(t, expected, received)
+			require.False(t, c.Receive(ctx))
+			rep := c.ResponseProto().GetOutput().GetWebsocketResponse()
+			require.Len(t, rep.GetMessages(), 1+expected)
+			require.NotZero(t, rep.GetElapsedNs())
+		})
+	}
+}
@@ -24,3 +24,25 @@ type Caller interface {
 	// Otherwise it returns named checks inherent to the caller.
 	NextCallerCheck() (string, CheckerFunc)
 }
+
+// StreamingCaller is a Caller whose request gets a stream of messages in return.
+// Do amounts to Send followed by calls to Receive until it returns false.
+type StreamingCaller interface {
+	Caller
+
+	// Send sends the request
+	Send(context.Context)
+
+	// Receive waits for the next message. It returns false once
+	// the stream is over, so no more messages are expected.
+	Receive(context.Context) bool
+}
+
+// RetryingCaller is a Caller that may retry its request as asked by the server.
+type RetryingCaller interface {
+	Caller
+
+	// Retries describes the retries that happened during Do and, when the server
+	// was still throttling the call after these, why retrying stopped.
+	Retries() (retries []string, throttled string)
+}
@@ -0,0 +1,46 @@
+package modeler
+
+// EndpointsFilter describes which endpoints get selected for testing.
+// Criteria apply in order, each one narrowing down the selection
+// that the previous ones left.
+type EndpointsFilter struct {
+	Criteria []EndpointsCriterion
+}
+
+// EndpointsCriterion is one selection step of an EndpointsFilter
+type EndpointsCriterion struct {
+	Kind  EndpointsCriterionKind
+	Value string
+}
+
+// EndpointsCriterionKind tells how an EndpointsCriterion's Value selects endpoints
+type EndpointsCriterionKind int
+
+const (
+	// Only keeps endpoints described by this regular expression
+	Only EndpointsCriterionKind = iota + 1
+	// Except drops endpoints described by this regular expression
+	Except
+
+	// CallsWithInput keeps endpoints that can take this schema as input,
+	// even when nested within other schemas.
+	CallsWithInput
+	// CallsWithoutInput drops endpoints that can take this schema as input
+	CallsWithoutInput
+	// CallsWithOutput keeps endpoints that can output this schema,
+	// even when nested within other schemas.
+	CallsWithOutput
+	// CallsWithoutOutput drops endpoints that can output this schema
+	CallsWithoutOutput
+
+	// Operation keeps endpoints with this operation identifier.
+	// Consecutive Operation criteria add up.
+	Operation
+	// SpecTag keeps endpoints tagged with this tag.
+	// Consecutive SpecTag criteria add up.
+	SpecTag
+
+	// Where keeps endpoints for which this Go template or, when it has no
+	// template actions, this JSONPath expression evaluates to True.
+	Where
+)
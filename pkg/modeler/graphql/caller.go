@@ -0,0 +1,33 @@
+package graphql
+
+import (
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+)
+
+const (
+	headerContentType = "Content-Type"
+	mimeJSON          = "application/json"
+)
+
+var _ modeler.RetryingCaller = (*caller)(nil)
+
+// caller performs GraphQL operations over HTTP
+type caller struct {
+	modeler.RetryingCaller
+}
+
+// RequestProto returns call input as used by the client
+func (c *caller) RequestProto() *fm.Clt_CallRequestRaw {
+	i := c.RetryingCaller.RequestProto()
+	if req := i.GetInput().GetHttpRequest(); req != nil {
+		body := req.GetBodyDecoded().GetStructValue().GetFields()
+		if query, ok := body["query"]; ok {
+			req.Graphql = &fm.Clt_CallRequestRaw_Input_HttpRequest_GraphQL{
+				Query:     query.GetStringValue(),
+				Variables: body["variables"],
+			}
+		}
+	}
+	return i
+}
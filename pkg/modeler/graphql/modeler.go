@@ -0,0 +1,140 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"go.starlark.net/starlark"
+)
+
+var _ modeler.Interface = (*gql)(nil)
+
+type T = gql
+
+// gql models GraphQL APIs served over HTTP: its operations are
+// described by an OpenAPIv3 model that does the calling and validation.
+type gql struct {
+	*openapiv3.T
+
+	schema, endpoint string
+}
+
+// ToProto describes the model along with its HTTP configuration for the server
+func (m *gql) ToProto() *fm.Clt_Fuzz_Model {
+	return &fm.Clt_Fuzz_Model{
+		Model: &fm.Clt_Fuzz_Model_Graphql{
+			Graphql: &fm.Clt_Fuzz_Model_GraphQL{
+				Schema:   m.schema,
+				Endpoint: m.endpoint,
+				Http:     m.T.ToProto().GetOpenapiv3(),
+			},
+		},
+	}
+}
+
+// FromProto restores a model described by ToProto
+func (m *gql) FromProto(p *fm.Clt_Fuzz_Model) error {
+	if mm := p.GetGraphql(); mm != nil {
+		m.schema, m.endpoint = mm.GetSchema(), mm.GetEndpoint()
+		m.T = &openapiv3.T{}
+		return m.T.FromProto(&fm.Clt_Fuzz_Model{
+			Model: &fm.Clt_Fuzz_Model_Openapiv3{
+				Openapiv3: mm.GetHttp(),
+			},
+		})
+	}
+	return fmt.Errorf("unexpected model type: %T", p.GetModel())
+}
+
+// NewFromKwargs accepts the HTTP kwargs of OpenAPIv3(...)
+// save for `file` and `host`, respectively replaced by `schema` and `endpoint`.
+func (m *gql) NewFromKwargs(d starlark.StringDict) (modeler.Interface, *modeler.Error) {
+	m = &gql{}
+	kwargs := make(starlark.StringDict, len(d))
+	for key, value := range d {
+		switch key {
+		case "file", "host":
+			return nil, modeler.NewError(key, "unset (see schema and endpoint)", value.Type())
+		case "schema", "endpoint":
+			str, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, "a string", value.Type())
+			}
+			if key == "schema" {
+				m.schema = str.GoString()
+			} else {
+				m.endpoint = str.GoString()
+			}
+		default:
+			kwargs[key] = value
+		}
+	}
+
+	if m.endpoint != "" {
+		u, err := url.Parse(m.endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, modeler.NewError("endpoint", "a URL such as http://localhost:4000/graphql", m.endpoint)
+		}
+		kwargs["host"] = starlark.String(u.Scheme + "://" + u.Host)
+	}
+
+	mdl, err := (&openapiv3.T{}).NewFromKwargs(kwargs)
+	if err != nil {
+		return nil, err
+	}
+	m.T = mdl.(*openapiv3.T)
+	if m.T.Headers == nil {
+		m.T.Headers = make(map[string]string, 1)
+	}
+	if _, ok := m.T.Headers[headerContentType]; !ok {
+		m.T.Headers[headerContentType] = mimeJSON
+	}
+	return m, nil
+}
+
+// Lint parses the SDL schema and maps its queries and mutations into endpoints
+func (m *gql) Lint(ctx context.Context, showSpec bool) (err error) {
+	var blob []byte
+	if blob, err = ioutil.ReadFile(m.schema); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	log.Printf("[NFO] reading schema in %dB", len(blob))
+	var doc *document
+	if doc, err = parseSDL(m.schema, string(blob)); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if showSpec {
+		for _, o := range doc.operations() {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", o.id(), o.query)
+		}
+	}
+
+	log.Println("[NFO] mapping operations to endpoints")
+	path := "/"
+	if u, e := url.Parse(m.endpoint); e == nil && u.Path != "" {
+		path = u.Path
+	}
+	var spec *openapiv3.Spec
+	if spec, err = doc.newSpec(path); err != nil {
+		return
+	}
+	return m.T.LintSpec(spec)
+}
+
+// NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
+func (m *gql) NewCaller(ctx context.Context, msg *fm.Srv_Call, showf modeler.ShowFunc) modeler.Caller {
+	return &caller{
+		RetryingCaller: m.T.NewCaller(ctx, msg, showf).(modeler.RetryingCaller),
+	}
+}
@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/modelertest"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func newPetstore(t *testing.T, endpoint string) *gql {
+	return modelertest.New(t, &gql{}, starlark.StringDict{
+		"schema":   starlark.String(filepath.Join("testdata", "petstore.graphql")),
+		"endpoint": starlark.String(endpoint),
+	}).(*gql)
+}
+
+func TestKwargs(t *testing.T) {
+	modelertest.CheckKwargs(t, &gql{}, map[string]string{
+		`{"schema": "s.graphql", "endpoint": "http://localhost:4000/graphql"}`: "",
+		`{"schema": "s.graphql", "endpoint": "localhost:4000"}`:                "(endpoint = ...) must be a URL such as http://localhost:4000/graphql, got: localhost:4000",
+		`{"schema": 42}`: "(schema = ...) must be a string, got: int",
+		`{"schema": "s.graphql", "endpoint": "http://h/g", "cookiejar": True}`:  "(cookiejar = ...) must be unset (unknown field), got: bool",
+		`{"schema": "s.graphql", "host": "http://localhost:4000"}`:              "(host = ...) must be unset (see schema and endpoint), got: string",
+		`{"schema": "s.graphql", "endpoint": "http://h/g", "cookie_jar": "no"}`: "(cookie_jar = ...) must be a bool, got: string",
+	})
+}
+
+func TestLintMapsOperationsToEndpoints(t *testing.T) {
+	m := newPetstore(t, "http://localhost:4000/graphql")
+	p := m.ToProto().GetGraphql()
+	require.Equal(t, filepath.Join("testdata", "petstore.graphql"), p.GetSchema())
+	require.Equal(t, "http://localhost:4000", p.GetHttp().GetHost())
+
+	ids := make(map[string]*fm.EndpointJSON)
+	for _, endpoint := range p.GetHttp().GetSpec().GetEndpoints() {
+		e := endpoint.GetJson()
+		ids[e.GetOperationId()] = e
+		require.Equal(t, fm.EndpointJSON_POST, e.GetMethod())
+		require.Equal(t, "/graphql", e.GetPathPartials()[0].GetPart())
+		require.Len(t, e.GetInputs(), 1)
+		require.Contains(t, e.GetOutputs(), uint32(200))
+	}
+	require.Len(t, ids, 5)
+	require.Equal(t, []string{"mutation"}, ids["Mutation.addPet"].GetTags())
+
+	// Enums and input objects are named schemas
+	require.Equal(t, 2, m.InputsCount())
+
+	mm := &gql{}
+	require.NoError(t, mm.FromProto(m.ToProto()))
+	require.Equal(t, m.ToProto(), mm.ToProto())
+}
+
+func jsonValue(t *testing.T, s string) *types.Value {
+	var v types.Value
+	require.NoError(t, jsonpb.UnmarshalString(s, &v))
+	return &v
+}
+
+func TestCallerValidatesResponses(t *testing.T) {
+	var response string
+	received := make(chan map[string]interface{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/graphql", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		blob, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(blob, &body))
+		received <- body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	m := newPetstore(t, srv.URL+"/graphql")
+	defer m.Close()
+
+	var msg *fm.Srv_Call
+	var query string
+	for EID, endpoint := range m.ToProto().GetGraphql().GetHttp().GetSpec().GetEndpoints() {
+		if endpoint.GetJson().GetOperationId() == "Query.pet" {
+			query = "query($id: ID!) { pet(id: $id) { id name tag status bornAt owner { id pets { id name tag status bornAt } } } }"
+			msg = &fm.Srv_Call{
+				EID: EID,
+				Input: &fm.Srv_Call_Input{
+					Input: &fm.Srv_Call_Input_HttpRequest_{
+						HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+							Method: "POST",
+							Url:    "/graphql",
+							Body:   jsonValue(t, `{"query": "`+query+`", "variables": {"id": "42"}}`),
+						},
+					},
+				},
+			}
+		}
+	}
+	require.NotNil(t, msg)
+
+	for name, tc := range map[string]struct {
+		response string
+		failed   bool
+	}{
+		"data":          {`{"data": {"pet": {"id": "42", "name": "Rex", "tag": null, "status": "SOLD", "bornAt": "2020-01-01", "owner": null}}}`, false},
+		"null data":     {`{"data": {"pet": null}}`, false},
+		"errors":        {`{"data": null, "errors": [{"message": "not found", "path": ["pet"]}]}`, false},
+		"bad enum":      {`{"data": {"pet": {"id": "42", "name": "Rex", "tag": null, "status": "LOST", "bornAt": null, "owner": null}}}`, true},
+		"missing field": {`{"data": {"pet": {"id": "42"}}}`, true},
+		"no message":    {`{"errors": [{"path": ["pet"]}]}`, true},
+		"neither":       {`{}`, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			response = tc.response
+			ctx := modelertest.Context()
+			c := m.NewCaller(ctx, msg, t.Logf)
+			req := c.RequestProto().GetInput().GetHttpRequest().GetGraphql()
+			require.Equal(t, query, req.GetQuery())
+			require.Equal(t, "42", req.GetVariables().GetStructValue().GetFields()["id"].GetStringValue())
+
+			c.Do(ctx)
+			body := <-received
+			require.Equal(t, query, body["query"])
+			require.Equal(t, map[string]interface{}{"id": "42"}, body["variables"])
+
+			failures := make(map[string][]string)
+			for {
+				checkName, lambda := c.NextCallerCheck()
+				if checkName == "" {
+					break
+				}
+				if _, _, f := lambda(); len(f) != 0 {
+					failures[checkName] = f
+				}
+			}
+			if tc.failed {
+				require.Contains(t, failures, "response validates schema")
+				require.Len(t, failures, 1)
+			} else {
+				require.Empty(t, failures)
+			}
+		})
+	}
+
+	_, ok := m.NewCaller(modelertest.Context(), msg, t.Logf).(modeler.RetryingCaller)
+	require.True(t, ok)
+}
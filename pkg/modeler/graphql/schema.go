@@ -0,0 +1,344 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type schemaJSON = map[string]interface{}
+type schemasJSON = map[string]schemaJSON
+
+// maxSelectionDepth bounds selection sets of (possibly recursive) object types
+const maxSelectionDepth = 3
+
+var builtinScalars = map[string]schemaJSON{
+	"Int":     {"type": []string{"integer"}, "minimum": float64(math.MinInt32), "maximum": float64(math.MaxInt32)},
+	"Float":   {"type": []string{"number"}},
+	"String":  {"type": []string{"string"}},
+	"Boolean": {"type": []string{"boolean"}},
+	"ID":      {"type": []string{"string"}},
+}
+
+// operation is a GraphQL query or mutation of a single root field
+type operation struct {
+	opType string // "query" or "mutation"
+	field  *ast.FieldDefinition
+	query  string
+	data   schemaJSON
+}
+
+func (o *operation) id() string {
+	return strings.Title(o.opType) + "." + o.field.Name
+}
+
+// operations lists the queries and mutations on each root field,
+// selecting as many fields as possible from their results.
+// Subscriptions are not supported.
+func (doc *document) operations() (ops []*operation) {
+	for _, root := range []struct {
+		opType string
+		def    *ast.Definition
+	}{
+		{"query", doc.Query},
+		{"mutation", doc.Mutation},
+	} {
+		if root.def == nil {
+			continue
+		}
+		for _, f := range root.def.Fields {
+			if isIntrospection(f) {
+				continue
+			}
+			selection, data, ok := doc.selection(f.Type, 1)
+			if !ok {
+				continue
+			}
+
+			var q strings.Builder
+			q.WriteString(root.opType)
+			if len(f.Arguments) != 0 {
+				q.WriteString("(")
+				for i, arg := range f.Arguments {
+					if i != 0 {
+						q.WriteString(", ")
+					}
+					fmt.Fprintf(&q, "$%s: %s", arg.Name, arg.Type)
+				}
+				q.WriteString(")")
+			}
+			q.WriteString(" { ")
+			q.WriteString(f.Name)
+			if len(f.Arguments) != 0 {
+				q.WriteString("(")
+				for i, arg := range f.Arguments {
+					if i != 0 {
+						q.WriteString(", ")
+					}
+					fmt.Fprintf(&q, "%s: $%s", arg.Name, arg.Name)
+				}
+				q.WriteString(")")
+			}
+			q.WriteString(selection)
+			q.WriteString(" }")
+
+			ops = append(ops, &operation{
+				opType: root.opType,
+				field:  f,
+				query:  q.String(),
+				data:   data,
+			})
+		}
+	}
+	return
+}
+
+// selection returns the selection set and JSON Schema of a field of type t.
+// Object fields requiring arguments are left out.
+func (doc *document) selection(t *ast.Type, depth int) (sel string, schema schemaJSON, ok bool) {
+	if t.Elem != nil {
+		if sel, schema, ok = doc.selection(t.Elem, depth); ok {
+			schema = nullable(!t.NonNull, schemaJSON{
+				"type":  []string{"array"},
+				"items": []schemaJSON{schema},
+			})
+		}
+		return
+	}
+
+	if scalar, isBuiltin := builtinScalars[t.NamedType]; isBuiltin {
+		return "", nullable(!t.NonNull, scalar), true
+	}
+	def := doc.Types[t.NamedType]
+	switch def.Kind {
+	case ast.Scalar:
+		return "", schemaJSON{}, true
+	case ast.Enum:
+		return "", nullable(!t.NonNull, openapiv3.SchemaRef(def.Name)), true
+	}
+	if depth > maxSelectionDepth {
+		return
+	}
+
+	switch def.Kind {
+	case ast.Object, ast.Interface:
+		var fields []string
+		properties := make(schemasJSON, len(def.Fields))
+		for _, f := range def.Fields {
+			if isIntrospection(f) || requiresArgs(f) {
+				continue
+			}
+			fsel, fschema, fok := doc.selection(f.Type, depth+1)
+			if !fok {
+				continue
+			}
+			fields = append(fields, f.Name+fsel)
+			properties[f.Name] = fschema
+		}
+		if len(fields) == 0 {
+			return
+		}
+		required := make([]string, 0, len(properties))
+		for name := range properties {
+			required = append(required, name)
+		}
+		sort.Strings(required)
+		sel = " { " + strings.Join(fields, " ") + " }"
+		schema = schemaJSON{
+			"type":       []string{"object"},
+			"properties": properties,
+			"required":   required,
+		}
+
+	case ast.Union:
+		fragments := []string{"__typename"}
+		typenames := make([]interface{}, 0, len(def.Types))
+		for _, member := range def.Types {
+			typenames = append(typenames, member)
+			if msel, _, mok := doc.selection(ast.NonNullNamedType(member, nil), depth); mok {
+				fragments = append(fragments, "... on "+member+msel)
+			}
+		}
+		sel = " { " + strings.Join(fragments, " ") + " }"
+		schema = schemaJSON{
+			"type": []string{"object"},
+			"properties": schemasJSON{
+				"__typename": {"type": []string{"string"}, "enum": typenames},
+			},
+			"required": []string{"__typename"},
+		}
+	}
+	schema, ok = nullable(!t.NonNull, schema), true
+	return
+}
+
+func requiresArgs(f *ast.FieldDefinition) bool {
+	for _, arg := range f.Arguments {
+		if isRequired(arg.Type, arg.DefaultValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// inputSchema returns the JSON Schema of an argument or input field of type t
+func (doc *document) inputSchema(t *ast.Type) schemaJSON {
+	if t.Elem != nil {
+		return nullable(!t.NonNull, schemaJSON{
+			"type":  []string{"array"},
+			"items": []schemaJSON{doc.inputSchema(t.Elem)},
+		})
+	}
+	if scalar, isBuiltin := builtinScalars[t.NamedType]; isBuiltin {
+		return nullable(!t.NonNull, scalar)
+	}
+	if doc.Types[t.NamedType].Kind == ast.Scalar {
+		// NOTE: custom scalars are most often serialized as strings
+		return nullable(!t.NonNull, schemaJSON{"type": []string{"string"}})
+	}
+	return nullable(!t.NonNull, openapiv3.SchemaRef(t.NamedType))
+}
+
+func (doc *document) inputObjectSchema(fields ast.FieldList) schemaJSON {
+	properties := make(schemasJSON, len(fields))
+	var required []string
+	for _, f := range fields {
+		properties[f.Name] = doc.inputSchema(f.Type)
+		if isRequired(f.Type, f.DefaultValue) {
+			required = append(required, f.Name)
+		}
+	}
+	schema := schemaJSON{
+		"type":       []string{"object"},
+		"properties": properties,
+	}
+	if len(required) != 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// namedSchemas returns the schemas of enums and input objects
+func (doc *document) namedSchemas() schemasJSON {
+	schemas := make(schemasJSON)
+	for _, name := range doc.names {
+		switch t := doc.Types[name]; t.Kind {
+		case ast.Enum:
+			values := make([]interface{}, 0, len(t.EnumValues))
+			for _, value := range t.EnumValues {
+				values = append(values, value.Name)
+			}
+			schemas[name] = schemaJSON{"type": []string{"string"}, "enum": values}
+		case ast.InputObject:
+			schemas[name] = doc.inputObjectSchema(t.Fields)
+		}
+	}
+	return schemas
+}
+
+func nullable(isNullable bool, schema schemaJSON) schemaJSON {
+	if !isNullable {
+		return schema
+	}
+	if _, ok := schema["$ref"]; ok {
+		return schemaJSON{"anyOf": []schemaJSON{{"type": []string{"null"}}, schema}}
+	}
+	types, ok := schema["type"].([]string)
+	if !ok {
+		return schema
+	}
+	s := make(schemaJSON, len(schema))
+	for k, v := range schema {
+		s[k] = v
+	}
+	s["type"] = append(append([]string(nil), types...), "null")
+	if enum, ok := s["enum"].([]interface{}); ok {
+		s["enum"] = append(append([]interface{}(nil), enum...), nil)
+	}
+	return s
+}
+
+// responseSchema describes GraphQL responses as per
+// https://spec.graphql.org/June2018/#sec-Response-Format
+func responseSchema(o *operation) schemaJSON {
+	return schemaJSON{
+		"type": []string{"object"},
+		"properties": schemasJSON{
+			"data": nullable(true, schemaJSON{
+				"type":       []string{"object"},
+				"properties": schemasJSON{o.field.Name: o.data},
+				"required":   []string{o.field.Name},
+			}),
+			"errors": {
+				"type":     []string{"array"},
+				"minItems": uint64(1),
+				"items": []schemaJSON{{
+					"type": []string{"object"},
+					"properties": schemasJSON{
+						"message":    {"type": []string{"string"}},
+						"locations":  {"type": []string{"array"}},
+						"path":       {"type": []string{"array"}},
+						"extensions": {"type": []string{"object"}},
+					},
+					"required": []string{"message"},
+				}},
+			},
+		},
+		"anyOf": []schemaJSON{
+			{"required": []string{"data"}},
+			{"required": []string{"errors"}},
+		},
+	}
+}
+
+// requestSchema describes the POSTed JSON body of an operation
+func (doc *document) requestSchema(o *operation) schemaJSON {
+	properties := schemasJSON{
+		"query": {"type": []string{"string"}, "enum": []interface{}{o.query}},
+	}
+	required := []string{"query"}
+	if args := o.field.Arguments; len(args) != 0 {
+		variables := make(ast.FieldList, 0, len(args))
+		for _, arg := range args {
+			variables = append(variables, &ast.FieldDefinition{
+				Name:         arg.Name,
+				Type:         arg.Type,
+				DefaultValue: arg.DefaultValue,
+			})
+		}
+		properties["variables"] = doc.inputObjectSchema(variables)
+		required = append(required, "variables")
+	}
+	return schemaJSON{
+		"type":       []string{"object"},
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+// newSpec maps each operation to an endpoint POSTing to path
+func (doc *document) newSpec(path string) (spec *openapiv3.Spec, err error) {
+	if spec, err = openapiv3.NewSpec(doc.namedSchemas()); err != nil {
+		return
+	}
+	for _, o := range doc.operations() {
+		spec.AddEndpoint(&fm.EndpointJSON{
+			Method:       fm.EndpointJSON_POST,
+			PathPartials: []*fm.PathPartial{{Pp: &fm.PathPartial_Part{Part: path}}},
+			Inputs: []*fm.ParamJSON{{
+				IsRequired: true,
+				SID:        spec.SID(doc.requestSchema(o)),
+				Kind:       fm.ParamJSON_body,
+			}},
+			Outputs:     map[uint32]uint32{200: spec.SID(responseSchema(o))},
+			OperationId: o.id(),
+			Tags:        []string{o.opType},
+		})
+	}
+	return
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// document is a schema written in GraphQL's Schema Definition Language,
+// as specified in https://spec.graphql.org/June2018/#sec-Type-System
+type document struct {
+	*ast.Schema
+
+	names []string // of types defined in the schema, in order of definition
+}
+
+// parseSDL parses then validates a schema, extensions included
+func parseSDL(file, src string) (*document, error) {
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: file, Input: src})
+	if gqlErr != nil {
+		return nil, gqlErr
+	}
+
+	if schema.Query == nil {
+		return nil, fmt.Errorf("%s: schema defines no query type", file)
+	}
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		if root != nil && root.Kind != ast.Object {
+			return nil, fmt.Errorf("%s:%d: root operation type %s must be an object type",
+				file, root.Position.Line, root.Name)
+		}
+	}
+
+	defs := make([]*ast.Definition, 0, len(schema.Types))
+	for _, def := range schema.Types {
+		if !def.BuiltIn {
+			defs = append(defs, def)
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Position.Start < defs[j].Position.Start })
+
+	doc := &document{Schema: schema, names: make([]string, 0, len(defs))}
+	for _, def := range defs {
+		doc.names = append(doc.names, def.Name)
+	}
+	return doc, nil
+}
+
+// isRequired tells whether an argument or input field must be given
+func isRequired(t *ast.Type, defaultValue *ast.Value) bool {
+	return t.NonNull && defaultValue == nil
+}
+
+// isIntrospection tells whether a field is one of the introspection
+// fields added to the query type.
+func isIntrospection(f *ast.FieldDefinition) bool {
+	return strings.HasPrefix(f.Name, "__")
+}
@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func parsePetstore(t *testing.T) *document {
+	file := filepath.Join("testdata", "petstore.graphql")
+	blob, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	doc, err := parseSDL(file, string(blob))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestParseSDL(t *testing.T) {
+	doc := parsePetstore(t)
+	require.Equal(t, []string{"DateTime", "Status", "Node", "Pet", "Owner", "SearchResult", "NewPet", "Query", "Mutation"}, doc.names)
+	require.Equal(t, "Query", doc.Query.Name)
+	require.Equal(t, "Mutation", doc.Mutation.Name)
+	require.Nil(t, doc.Subscription)
+	require.Len(t, doc.Types["Status"].EnumValues, 3)
+	require.Equal(t, "ADOPTED", doc.Types["Status"].EnumValues[1].Name)
+	require.Equal(t, []string{"Pet", "Owner"}, doc.Types["SearchResult"].Types)
+	require.Equal(t, "node", doc.Types["Query"].Fields[3].Name)
+
+	photos := doc.Types["Pet"].Fields[6]
+	require.Equal(t, "photos", photos.Name)
+	require.Equal(t, "[String!]!", photos.Type.String())
+	require.True(t, isRequired(photos.Arguments[0].Type, photos.Arguments[0].DefaultValue))
+	first := doc.Types["Owner"].Fields[1].Arguments[0]
+	require.False(t, isRequired(first.Type, first.DefaultValue))
+}
+
+func TestParseSDLErrors(t *testing.T) {
+	for sdl, expected := range map[string]string{
+		`type Query { a: Int }`:                       "",
+		`type Query { a: Int } type Query { b: Int }`: "s.graphql:1: Cannot redeclare type Query.",
+		`type Mutation { a: Int }`:                    "s.graphql: schema defines no query type",
+		`schema { query: Q } type Query { a: Int }`:   "s.graphql:1: Schema root query refers to a type Q that does not exist.",
+		`schema { query: I } input I { a: Int }`:      "s.graphql:1: root operation type I must be an object type",
+		`type Query { a: Pet }`:                       "s.graphql:1: Undefined type Pet.",
+		"type Query {\n  a(in: Query): Int\n}":        "s.graphql:2: cannot use Query as argument in because OBJECT is not a valid input type",
+		`type Query { a: Int } input I { q: Query }`:  "s.graphql:1: INPUT_OBJECT field must be one of SCALAR, ENUM, INPUT_OBJECT.",
+		`type Query { a: Int a: String }`:             "s.graphql:1: Field Query.a can only be defined once.",
+		`type Query { a: U } union U = Query | Int`:   `s.graphql:1: UNION type "Int" must be OBJECT.`,
+		`type Query implements Int { a: Int }`:        `s.graphql:1: "Int" is a non interface type SCALAR.`,
+		`type Query { a: Int } enum E {}`:             "s.graphql:1: expected at least one definition, found }",
+		`type Query { a: [Int }`:                      "s.graphql:1: Expected ], found }",
+		`type Query { a: "Int" }`:                     "s.graphql:1: Expected Name, found String",
+		`scalar String`:                               "s.graphql:1: Cannot redeclare type String.",
+		`type Query { a: Int } %`:                     "s.graphql:1: Unexpected <Invalid>",
+	} {
+		t.Run(sdl, func(t *testing.T) {
+			_, err := parseSDL("s.graphql", sdl)
+			if expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestOperations(t *testing.T) {
+	doc := parsePetstore(t)
+	queries := make(map[string]string)
+	for _, o := range doc.operations() {
+		queries[o.id()] = o.query
+	}
+	require.Equal(t, map[string]string{
+		"Query.pets":      "query($limit: Int, $status: Status) { pets(limit: $limit, status: $status) { id name tag status bornAt owner { id pets { id name tag status bornAt } } } }",
+		"Query.pet":       "query($id: ID!) { pet(id: $id) { id name tag status bornAt owner { id pets { id name tag status bornAt } } } }",
+		"Query.search":    "query($text: String!) { search(text: $text) { __typename ... on Pet { id name tag status bornAt owner { id pets { id name tag status bornAt } } } ... on Owner { id pets { id name tag status bornAt owner { id } } } } }",
+		"Query.node":      "query($id: ID!) { node(id: $id) { id } }",
+		"Mutation.addPet": "mutation($pet: NewPet!) { addPet(pet: $pet) { id name tag status bornAt owner { id pets { id name tag status bornAt } } } }",
+	}, queries)
+}
@@ -0,0 +1,256 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var _ modeler.Caller = (*caller)(nil)
+
+type namedLambda struct {
+	name   string
+	lambda modeler.CheckerFunc
+}
+
+// caller performs a unary RPC
+type caller struct {
+	showf modeler.ShowFunc
+
+	eid      uint32
+	method   rpc
+	outputID uint32
+	conn     *ggrpc.ClientConn
+	md       metadata.MD
+
+	buildRequestErr error
+	req             *dynamicpb.Message
+	reqBody         []byte
+
+	connState connectivity.State
+	status    *status.Status
+	repProto  *fm.Clt_CallResponseRaw_Output_GrpcResponse
+
+	checks []namedLambda
+}
+
+// NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
+func (m *grpcm) NewCaller(ctx context.Context, msg *fm.Srv_Call, showf modeler.ShowFunc) modeler.Caller {
+	c := &caller{
+		showf:    showf,
+		eid:      msg.GetEID(),
+		md:       metadata.MD{},
+		repProto: &fm.Clt_CallResponseRaw_Output_GrpcResponse{},
+	}
+	for _, key := range m.metadataKeys() {
+		c.md.Append(key, m.Metadata[key])
+	}
+	c.checks = []namedLambda{
+		{"connection to server", c.checkConn},
+		{"gRPC status", c.checkStatus},
+		{"response validates schema", func() (string, string, []string) {
+			return c.checkValidatesJSONSchema(m)
+		}},
+	}
+
+	method, ok := m.methods[msg.GetEID()]
+	if !ok {
+		c.buildRequestErr = fmt.Errorf("no RPC for endpoint #%d", msg.GetEID())
+		log.Println("[ERR]", c.buildRequestErr)
+		return c
+	}
+	c.method = method
+	c.req, c.reqBody, c.buildRequestErr = buildRequest(method, msg.GetInput().GetHttpRequest())
+	if c.buildRequestErr != nil {
+		return c
+	}
+	c.conn, c.buildRequestErr = m.connFor(ctx)
+	return c
+}
+
+// buildRequest decodes the generated JSON body into the RPC's input message
+func buildRequest(method rpc, input *fm.Srv_Call_Input_HttpRequest) (
+	req *dynamicpb.Message,
+	body []byte,
+	err error,
+) {
+	req = dynamicpb.NewMessage(method.Input())
+	if b := input.GetBody(); b != nil {
+		buf := &bytes.Buffer{}
+		if err = (&jsonpb.Marshaler{}).Marshal(buf, b); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(buf.Bytes(), req); err != nil {
+			err = fmt.Errorf("cannot encode %s: %v", method.Input().FullName(), err)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	// Records the message as sent
+	if body, err = protojson.Marshal(req); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+// RequestProto returns call input as used by the client
+func (c *caller) RequestProto() (i *fm.Clt_CallRequestRaw) {
+	i = &fm.Clt_CallRequestRaw{}
+	if err := c.buildRequestErr; err != nil {
+		i.Reason = strings.Split(err.Error(), "\n")
+		return
+	}
+
+	reqProto := &fm.Clt_CallRequestRaw_Input_GrpcRequest{
+		Target:   c.conn.Target(),
+		Method:   c.method.path(),
+		Metadata: make(map[string]*fm.Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues, len(c.md)),
+		Body:     c.reqBody,
+	}
+	for key, values := range c.md {
+		reqProto.Metadata[key] = &fm.Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues{
+			Values: values,
+		}
+	}
+	var x types.Value
+	if err := jsonpb.UnmarshalString(string(c.reqBody), &x); err != nil {
+		log.Println("[NFO] request body could not be decoded:", err)
+	} else {
+		reqProto.BodyDecoded = &x
+	}
+
+	i.Input = &fm.Clt_CallRequestRaw_Input{
+		Input: &fm.Clt_CallRequestRaw_Input_GrpcRequest_{
+			GrpcRequest: reqProto,
+		}}
+	return
+}
+
+// Do sends the request and waits for the response
+func (c *caller) Do(ctx context.Context) {
+	c.showf("> %s", c.method.path())
+
+	rep := dynamicpb.NewMessage(c.method.Output())
+	var header, trailer metadata.MD
+	start := time.Now()
+	err := c.conn.Invoke(metadata.NewOutgoingContext(ctx, c.md), c.method.path(), c.req, rep,
+		ggrpc.Header(&header),
+		ggrpc.Trailer(&trailer),
+	)
+	c.repProto.ElapsedNs = time.Since(start).Nanoseconds()
+	c.connState = c.conn.GetState()
+
+	c.status = status.Convert(err)
+	c.repProto.Code = uint32(c.status.Code())
+	c.repProto.Status = c.status.Code().String()
+	c.repProto.Message = c.status.Message()
+	c.repProto.Headers = metadataToProto(header)
+	c.repProto.Trailers = metadataToProto(trailer)
+	if err != nil {
+		c.repProto.Error = err.Error()
+		c.showf("< %s: %s", c.status.Code(), c.status.Message())
+		return
+	}
+	c.showf("< %s", c.status.Code())
+
+	if c.repProto.Body, err = protojson.Marshal(rep); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	var x types.Value
+	if err = jsonpb.UnmarshalString(string(c.repProto.Body), &x); err != nil {
+		log.Println("[NFO] response body could not be decoded:", err)
+		return
+	}
+	c.repProto.BodyDecoded = &x
+}
+
+func metadataToProto(md metadata.MD) map[string]*fm.Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues {
+	values := make(map[string]*fm.Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues, len(md))
+	for key, vs := range md {
+		values[key] = &fm.Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues{
+			Values: vs,
+		}
+	}
+	return values
+}
+
+// ResponseProto returns call output as received by the client
+func (c *caller) ResponseProto() *fm.Clt_CallResponseRaw {
+	return &fm.Clt_CallResponseRaw{
+		OutputId: c.outputID,
+		Output: &fm.Clt_CallResponseRaw_Output{
+			Output: &fm.Clt_CallResponseRaw_Output_GrpcResponse_{
+				GrpcResponse: c.repProto,
+			}}}
+}
+
+// NextCallerCheck returns ("",nil) when out of checks to run.
+// Otherwise it returns named checks inherent to the caller.
+func (c *caller) NextCallerCheck() (string, modeler.CheckerFunc) {
+	if len(c.checks) == 0 {
+		return "", nil
+	}
+	var nameAndLambda namedLambda
+	nameAndLambda, c.checks = c.checks[0], c.checks[1:]
+	return nameAndLambda.name, nameAndLambda.lambda
+}
+
+// checkConn fails when the call could not reach the server.
+// An Unavailable status sent by the server itself is left to checkStatus.
+func (c *caller) checkConn() (s, skipped string, f []string) {
+	if c.status.Code() == codes.Unavailable && c.connState != connectivity.Ready {
+		f = append(f, "communication with server could not be established")
+		f = append(f, c.status.Message())
+		return
+	}
+	s = "request sent"
+	return
+}
+
+// checkStatus fails on codes that indicate server errors
+// (as opposed to errors in the request)
+func (c *caller) checkStatus() (s, skipped string, f []string) {
+	switch code := c.status.Code(); code {
+	case codes.OK:
+		s = "status OK"
+	case codes.Unknown, codes.Internal, codes.Unimplemented, codes.DataLoss:
+		f = append(f, fmt.Sprintf("server error: %s", code))
+		if msg := c.status.Message(); msg != "" {
+			f = append(f, msg)
+		}
+	default:
+		s = fmt.Sprintf("no server error: %s", code)
+	}
+	return
+}
+
+func (c *caller) checkValidatesJSONSchema(m *grpcm) (s, skipped string, f []string) {
+	if code := c.status.Code(); code != codes.OK {
+		skipped = fmt.Sprintf("no response message with status %s", code)
+		return
+	}
+	SID := m.Spec.Spec.Endpoints[c.eid].GetJson().GetOutputs()[c.outputID]
+	if errs := m.Validate(SID, c.repProto.BodyDecoded); len(errs) != 0 {
+		f = errs
+		return
+	}
+	s = "response validates JSON Schema"
+	return
+}
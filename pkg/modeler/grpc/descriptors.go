@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	// Well-known types may be imported by .proto files
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// loader reads .proto files (or binary FileDescriptorSets as produced
+// by protoc --descriptor_set_out) into a registry of file descriptors.
+type loader struct {
+	files *protoregistry.Files
+	blobs map[string]string
+}
+
+func loadFiles(paths []string) (files *protoregistry.Files, blobs map[string]string, err error) {
+	l := &loader{
+		files: &protoregistry.Files{},
+		blobs: make(map[string]string, len(paths)),
+	}
+	var protos []string
+	for _, path := range paths {
+		if filepath.Ext(path) == ".proto" {
+			protos = append(protos, path)
+			continue
+		}
+		var blob []byte
+		if blob, err = l.read(path); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = l.loadDescriptorSet(path, blob); err != nil {
+			return
+		}
+	}
+	if len(protos) != 0 {
+		if err = l.parseProtos(protos); err != nil {
+			return
+		}
+	}
+	files, blobs = l.files, l.blobs
+	return
+}
+
+func (l *loader) read(path string) (blob []byte, err error) {
+	if blob, err = ioutil.ReadFile(path); err != nil {
+		return
+	}
+	l.blobs[path] = string(blob)
+	log.Printf("[NFO] reading %s in %dB", path, len(blob))
+	return
+}
+
+// parseProtos parses and links .proto files along with their imports.
+// Imports are looked up relative to the directories of the files given
+// and to each of their parents. Well-known types need not be on disk.
+func (l *loader) parseProtos(paths []string) (err error) {
+	var importPaths, names []string
+	seen := make(map[string]struct{})
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		names = append(names, filepath.Base(path))
+		for {
+			if _, ok := seen[dir]; !ok {
+				seen[dir] = struct{}{}
+				importPaths = append(importPaths, dir)
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				break
+			}
+			dir = parent
+		}
+	}
+
+	p := protoparse.Parser{
+		ImportPaths: importPaths,
+		Accessor: func(path string) (io.ReadCloser, error) {
+			blob, err := l.read(path)
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(bytes.NewReader(blob)), nil
+		},
+	}
+	var fds []*desc.FileDescriptor
+	if fds, err = p.ParseFiles(names...); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	return l.registerSet(desc.ToFileDescriptorSet(fds...))
+}
+
+func (l *loader) registerGlobal(path string) (err error) {
+	if _, e := l.files.FindFileByPath(path); e == nil {
+		return
+	}
+	var fd protoreflect.FileDescriptor
+	if fd, err = protoregistry.GlobalFiles.FindFileByPath(path); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err = l.registerGlobal(imports.Get(i).Path()); err != nil {
+			return
+		}
+	}
+	if err = l.files.RegisterFile(fd); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func (l *loader) register(fdp *descriptorpb.FileDescriptorProto) (err error) {
+	var fd protoreflect.FileDescriptor
+	if fd, err = protodesc.NewFile(fdp, l.files); err != nil {
+		err = fmt.Errorf("%s: %v", fdp.GetName(), err)
+		log.Println("[ERR]", err)
+		return
+	}
+	if err = l.files.RegisterFile(fd); err != nil {
+		err = fmt.Errorf("%s: %v", fdp.GetName(), err)
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func (l *loader) loadDescriptorSet(path string, blob []byte) (err error) {
+	var set descriptorpb.FileDescriptorSet
+	if err = proto.Unmarshal(blob, &set); err != nil {
+		err = fmt.Errorf("%s: not a .proto file nor a FileDescriptorSet: %v", path, err)
+		log.Println("[ERR]", err)
+		return
+	}
+	return l.registerSet(&set)
+}
+
+// registerSet registers files listed dependencies first,
+// using the compiled-in descriptors of well-known types.
+func (l *loader) registerSet(set *descriptorpb.FileDescriptorSet) (err error) {
+	for _, fdp := range set.GetFile() {
+		if _, e := l.files.FindFileByPath(fdp.GetName()); e == nil {
+			continue
+		}
+		if _, e := protoregistry.GlobalFiles.FindFileByPath(fdp.GetName()); e == nil {
+			if err = l.registerGlobal(fdp.GetName()); err != nil {
+				return
+			}
+			continue
+		}
+		if err = l.register(fdp); err != nil {
+			return
+		}
+	}
+	return
+}
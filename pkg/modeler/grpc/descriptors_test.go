@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestLoadFilesParsesProto(t *testing.T) {
+	files, _, err := loadFiles([]string{filepath.Join("testdata", "petstore.proto")})
+	require.NoError(t, err)
+	d, err := files.FindFileByPath("petstore.proto")
+	require.NoError(t, err)
+	fd := protodesc.ToFileDescriptorProto(d)
+	require.Equal(t, "proto3", fd.GetSyntax())
+	require.Equal(t, "petstore.v1", fd.GetPackage())
+	require.Equal(t, []string{"google/protobuf/empty.proto", "google/protobuf/timestamp.proto"}, fd.GetDependency())
+
+	require.Len(t, fd.GetService(), 1)
+	methods := fd.GetService()[0].GetMethod()
+	require.Len(t, methods, 4)
+	require.Equal(t, "ListPets", methods[2].GetName())
+	require.Equal(t, ".google.protobuf.Empty", methods[2].GetInputType())
+	require.True(t, methods[2].GetServerStreaming())
+	require.False(t, methods[2].GetClientStreaming())
+
+	require.Len(t, fd.GetMessageType(), 3)
+	pet := fd.GetMessageType()[1]
+	require.Equal(t, "Pet", pet.GetName())
+	require.Len(t, pet.GetField(), 10)
+	require.Equal(t, "bornAt", pet.GetField()[4].GetJsonName())
+
+	// map<string, string> labels
+	require.Len(t, pet.GetNestedType(), 1)
+	entry := pet.GetNestedType()[0]
+	require.Equal(t, "LabelsEntry", entry.GetName())
+	require.True(t, entry.GetOptions().GetMapEntry())
+	require.Equal(t, ".petstore.v1.Pet.LabelsEntry", pet.GetField()[6].GetTypeName())
+	require.Equal(t, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, pet.GetField()[6].GetLabel())
+
+	// oneof owner then synthetic oneof of optional tag
+	require.Len(t, pet.GetOneofDecl(), 2)
+	require.Equal(t, "owner", pet.GetOneofDecl()[0].GetName())
+	require.Equal(t, "_tag", pet.GetOneofDecl()[1].GetName())
+	require.True(t, pet.GetField()[2].GetProto3Optional())
+	require.Equal(t, int32(1), pet.GetField()[2].GetOneofIndex())
+	require.Equal(t, int32(0), pet.GetField()[7].GetOneofIndex())
+
+	require.Len(t, pet.GetEnumType(), 1)
+	require.Len(t, pet.GetEnumType()[0].GetValue(), 3)
+}
+
+func TestLoadFilesReportsErrors(t *testing.T) {
+	for src, expected := range map[string]string{
+		`syntax = "proto4";`:                           `f.proto:1:10: syntax value must be "proto2" or "proto3"`,
+		`message M { int32 a = 1 }`:                    `f.proto:1:25: syntax error: unexpected '}', expecting ';' or '['`,
+		`message M { map<float, string> m = 1; }`:      `f.proto:1:17: syntax error: unexpected "float"`,
+		`servic S {}`:                                  `f.proto:1:1: syntax error: unexpected identifier`,
+		`syntax = "proto3"; message M { Nope a = 1; }`: `f.proto:1:32: field M.a: unknown type Nope`,
+	} {
+		t.Run(src, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "f.proto")
+			err := ioutil.WriteFile(path, []byte(src), 0644)
+			require.NoError(t, err)
+			_, _, err = loadFiles([]string{path})
+			require.EqualError(t, err, expected)
+		})
+	}
+}
+
+func TestLoadFilesResolvesImports(t *testing.T) {
+	files, blobs, err := loadFiles([]string{filepath.Join("testdata", "admin.proto")})
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+
+	d, err := files.FindDescriptorByName("petstore.admin.Admin.RemovePet")
+	require.NoError(t, err)
+	method := d.(protoreflect.MethodDescriptor)
+	require.Equal(t, protoreflect.FullName("petstore.v1.GetPetRequest"), method.Input().FullName())
+	require.Equal(t, protoreflect.FullName("google.protobuf.Empty"), method.Output().FullName())
+
+	d, err = files.FindDescriptorByName("petstore.v1.Pet")
+	require.NoError(t, err)
+	pet := d.(protoreflect.MessageDescriptor)
+	require.Equal(t, protoreflect.EnumKind, pet.Fields().ByName("status").Kind())
+	require.True(t, pet.Fields().ByName("labels").IsMap())
+	require.True(t, pet.Fields().ByName("tag").HasOptionalKeyword())
+	require.Equal(t, pet, pet.Fields().ByName("parent").Message())
+}
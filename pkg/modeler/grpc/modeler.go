@@ -0,0 +1,203 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"go.starlark.net/starlark"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var _ modeler.Interface = (*grpcm)(nil)
+
+type T = grpcm
+
+// grpcm models gRPC services described by protobuf files:
+// each unary RPC is an endpoint whose input is its request message.
+type grpcm struct {
+	fm.Clt_Fuzz_Model_GRPC
+
+	*openapiv3.Spec
+
+	resetter resetter.Interface
+
+	files   map[string]string
+	methods map[uint32]rpc
+
+	conn *ggrpc.ClientConn
+}
+
+// ToProto describes the model, its spec included, for the server
+func (m *grpcm) ToProto() *fm.Clt_Fuzz_Model {
+	if m.Spec != nil {
+		m.Clt_Fuzz_Model_GRPC.Spec = m.Spec.Spec
+	}
+	return &fm.Clt_Fuzz_Model{
+		Model: &fm.Clt_Fuzz_Model_Grpc{
+			Grpc: &m.Clt_Fuzz_Model_GRPC,
+		},
+	}
+}
+
+// FromProto restores a model described by ToProto
+func (m *grpcm) FromProto(p *fm.Clt_Fuzz_Model) error {
+	if mm := p.GetGrpc(); mm != nil {
+		m.Clt_Fuzz_Model_GRPC = *mm
+		m.Spec = openapiv3.SpecFromProto(mm.GetSpec())
+		return nil
+	}
+	return fmt.Errorf("unexpected model type: %T", p.GetModel())
+}
+
+// SetResetter sets the resetter in use before each test
+func (m *grpcm) SetResetter(sr resetter.Interface) { m.resetter = sr }
+
+// GetResetter returns the resetter in use before each test
+func (m *grpcm) GetResetter() resetter.Interface { return m.resetter }
+
+// NewFromKwargs reads proto_files, target & metadata from the kwargs of GRPC(...)
+func (m *grpcm) NewFromKwargs(d starlark.StringDict) (modeler.Interface, *modeler.Error) {
+	m = &grpcm{}
+	for key, value := range d {
+		switch key {
+		case "proto_files":
+			list, ok := value.(*starlark.List)
+			if !ok || list.Len() == 0 {
+				return nil, modeler.NewError(key, "a non-empty list of paths", value.Type())
+			}
+			for i := 0; i < list.Len(); i++ {
+				path, ok := list.Index(i).(starlark.String)
+				if !ok || path == "" {
+					return nil, modeler.NewError(key, "a non-empty list of paths", list.Index(i).String())
+				}
+				m.ProtoFiles = append(m.ProtoFiles, path.GoString())
+			}
+
+		case "target":
+			target, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, "a string", value.Type())
+			}
+			m.Target = target.GoString()
+
+		case "metadata":
+			dict, ok := value.(*starlark.Dict)
+			if !ok {
+				return nil, modeler.NewError(key, "a dict of strings", value.Type())
+			}
+			m.Metadata = make(map[string]string, dict.Len())
+			for _, kv := range dict.Items() {
+				k, ok := kv[0].(starlark.String)
+				if !ok {
+					return nil, modeler.NewError(key, "a dict of strings", kv[0].Type())
+				}
+				v, ok := kv[1].(starlark.String)
+				if !ok {
+					return nil, modeler.NewError(key, "a dict of strings", kv[1].Type())
+				}
+				m.Metadata[k.GoString()] = v.GoString()
+			}
+
+		default:
+			return nil, modeler.NewError(key, "unset (unknown field)", value.Type())
+		}
+	}
+
+	if len(m.ProtoFiles) == 0 {
+		return nil, modeler.NewError("proto_files", "a non-empty list of paths", "None")
+	}
+	if m.Target == "" {
+		return nil, modeler.NewError("target", "an address such as localhost:50051", `""`)
+	}
+	return m, nil
+}
+
+// Lint reads the protobuf files and maps their unary RPCs into endpoints
+func (m *grpcm) Lint(ctx context.Context, showSpec bool) (err error) {
+	var files *protoregistry.Files
+	if files, m.files, err = loadFiles(m.ProtoFiles); err != nil {
+		return
+	}
+
+	methods := rpcs(files)
+	if len(methods) == 0 {
+		err = fmt.Errorf("no unary RPC found in %v", m.ProtoFiles)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if showSpec {
+		for _, method := range methods {
+			fmt.Fprintf(os.Stderr, "%s: %s -> %s\n", method.id(),
+				method.Input().FullName(), method.Output().FullName())
+		}
+	}
+
+	log.Println("[NFO] mapping RPCs to endpoints")
+	if m.Spec, err = newSpec(methods); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.methods = make(map[uint32]rpc, len(methods))
+	for i, method := range methods {
+		// Endpoints were added in order, starting from 1
+		m.methods[uint32(1+i)] = method
+	}
+
+	log.Println("[NFO] model is valid")
+	return
+}
+
+// Files lists the local files read by the model, keyed by path.
+func (m *grpcm) Files() map[string]string {
+	return m.files
+}
+
+// metadataKeys returns the keys of the configured metadata, sorted
+func (m *grpcm) metadataKeys() []string {
+	keys := make([]string, 0, len(m.Metadata))
+	for key := range m.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *grpcm) connFor(ctx context.Context) (*ggrpc.ClientConn, error) {
+	if m.conn != nil {
+		return m.conn, nil
+	}
+	log.Println("[NFO] dialing", m.Target)
+	conn, err := ggrpc.DialContext(ctx, m.Target,
+		ggrpc.WithInsecure(),
+		ggrpc.WithUserAgent(ctx.Value(ctxvalues.UserAgent).(string)),
+	)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	m.conn = conn
+	return conn, nil
+}
+
+// ResetCaller is called before each test
+func (m *grpcm) ResetCaller() {}
+
+// Close releases the connection held across calls
+func (m *grpcm) Close() {
+	if m.conn != nil {
+		if err := m.conn.Close(); err != nil {
+			log.Println("[ERR]", err)
+		}
+		m.conn = nil
+	}
+}
@@ -0,0 +1,292 @@
+package grpc
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/modelertest"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func newPetstore(t *testing.T, target string) *grpcm {
+	return modelertest.New(t, &grpcm{}, starlark.StringDict{
+		"proto_files": starlark.NewList([]starlark.Value{
+			starlark.String(filepath.Join("testdata", "petstore.proto")),
+			starlark.String(filepath.Join("testdata", "admin.proto")),
+		}),
+		"target":   starlark.String(target),
+		"metadata": starlark.NewDict(0),
+	}).(*grpcm)
+}
+
+func TestKwargs(t *testing.T) {
+	modelertest.CheckKwargs(t, &grpcm{}, map[string]string{
+		`{"proto_files": ["a.proto"], "target": "localhost:50051"}`:                         "",
+		`{"proto_files": ["a.proto"], "target": "localhost:50051", "metadata": {"k": "v"}}`: "",
+		`{"proto_files": ["a.proto"], "target": "localhost:50051", "meta": {"k": "v"}}`:     "(meta = ...) must be unset (unknown field), got: dict",
+		`{"proto_files": "a.proto", "target": "localhost:50051"}`:                           "(proto_files = ...) must be a non-empty list of paths, got: string",
+		`{"proto_files": [], "target": "localhost:50051"}`:                                  "(proto_files = ...) must be a non-empty list of paths, got: list",
+		`{"proto_files": [42], "target": "localhost:50051"}`:                                "(proto_files = ...) must be a non-empty list of paths, got: 42",
+		`{"target": "localhost:50051"}`:                                                     "(proto_files = ...) must be a non-empty list of paths, got: None",
+		`{"proto_files": ["a.proto"]}`:                                                      `(target = ...) must be an address such as localhost:50051, got: ""`,
+		`{"proto_files": ["a.proto"], "target": "localhost:50051", "metadata": {"k": 1}}`:   "(metadata = ...) must be a dict of strings, got: int",
+	})
+}
+
+func TestLintMapsRPCsToEndpoints(t *testing.T) {
+	m := newPetstore(t, "localhost:50051")
+	require.Len(t, m.Files(), 2)
+
+	p := m.ToProto().GetGrpc()
+	ids := make(map[string]*fm.EndpointJSON)
+	for _, endpoint := range p.GetSpec().GetEndpoints() {
+		e := endpoint.GetJson()
+		ids[e.GetOperationId()] = e
+		require.Equal(t, fm.EndpointJSON_POST, e.GetMethod())
+		require.Equal(t, "/"+e.GetOperationId(), e.GetPathPartials()[0].GetPart())
+		require.Len(t, e.GetInputs(), 1)
+		require.Contains(t, e.GetOutputs(), uint32(0))
+	}
+	// Streaming RPCs are skipped
+	require.Len(t, ids, 4)
+	require.Contains(t, ids, "petstore.admin.Admin/RemovePet")
+	require.Contains(t, ids, "petstore.v1.PetStore/GetPet")
+	require.Equal(t, []string{"PetStore"}, ids["petstore.v1.PetStore/AddPet"].GetTags())
+
+	// Messages and enums are named schemas
+	require.Equal(t, 4, m.InputsCount())
+
+	mm := &grpcm{}
+	require.NoError(t, mm.FromProto(m.ToProto()))
+	require.Equal(t, m.ToProto(), mm.ToProto())
+}
+
+func jsonValue(t *testing.T, s string) *types.Value {
+	var v types.Value
+	require.NoError(t, jsonpb.UnmarshalString(s, &v))
+	return &v
+}
+
+func TestSchemasFollowJSONMapping(t *testing.T) {
+	m := newPetstore(t, "localhost:50051")
+	var SID uint32
+	for _, endpoint := range m.Spec.Spec.Endpoints {
+		if e := endpoint.GetJson(); e.GetOperationId() == "petstore.v1.PetStore/AddPet" {
+			SID = e.GetOutputs()[0]
+		}
+	}
+	require.NotZero(t, SID)
+
+	for pet, valid := range map[string]bool{
+		`{}`: true,
+		`{"id": "42", "name": "Rex", "tag": "", "status": "SOLD", "bornAt": "2020-01-01T00:00:00Z"}`: true,
+		`{"id": 42, "photoUrls": ["a", "b"], "labels": {"a": "b"}, "shelter": "SPCA"}`:               true,
+		`{"person": {"name": "Alice", "avatar": "aGk="}, "parent": {"name": "Rexa"}}`:                true,
+		`{"id": "forty-two"}`:                           false,
+		`{"status": "LOST"}`:                            false,
+		`{"person": {"name": "Alice"}, "shelter": "x"}`: false,
+		`{"parent": {"parent": {"name": 42}}}`:          false,
+		`{"photoUrls": "a"}`:                            false,
+	} {
+		t.Run(pet, func(t *testing.T) {
+			errs := m.Validate(SID, jsonValue(t, pet))
+			if valid {
+				require.Empty(t, errs)
+			} else {
+				require.NotEmpty(t, errs)
+			}
+		})
+	}
+}
+
+// serve answers any RPC whose input and output message are known to m
+func serve(t *testing.T, m *grpcm, handle func(method string, req, rep *dynamicpb.Message) error) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	byPath := make(map[string]rpc, len(m.methods))
+	for _, method := range m.methods {
+		byPath[method.path()] = method
+	}
+
+	srv := ggrpc.NewServer(ggrpc.UnknownServiceHandler(func(_ interface{}, stream ggrpc.ServerStream) error {
+		path, _ := ggrpc.MethodFromServerStream(stream)
+		method, ok := byPath[path]
+		if !ok {
+			return status.Error(codes.Unimplemented, path)
+		}
+		req := dynamicpb.NewMessage(method.Input())
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+			stream.SetHeader(metadata.Pairs("x-seen-token", md.Get("x-token")[0]))
+		}
+		rep := dynamicpb.NewMessage(method.Output())
+		if err := handle(path, req, rep); err != nil {
+			return err
+		}
+		stream.SetTrailer(metadata.Pairs("x-trailer", "done"))
+		return stream.SendMsg(rep)
+	}))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestCallerChecksStatusAndResponses(t *testing.T) {
+	m := newPetstore(t, "localhost:0")
+	m.Metadata = map[string]string{"x-token": "s3cr3t"}
+	defer m.Close()
+
+	var response string
+	var code codes.Code
+	m.Target = serve(t, m, func(path string, req, rep *dynamicpb.Message) error {
+		require.Equal(t, "/petstore.v1.PetStore/GetPet", path)
+		id := req.Get(req.Descriptor().Fields().ByName("id")).Int()
+		require.Equal(t, int64(42), id)
+		if code != codes.OK {
+			return status.Error(code, "oops")
+		}
+		return protojson.Unmarshal([]byte(response), rep)
+	})
+
+	var msg *fm.Srv_Call
+	for EID, method := range m.methods {
+		if method.id() == "petstore.v1.PetStore/GetPet" {
+			msg = &fm.Srv_Call{
+				EID: EID,
+				Input: &fm.Srv_Call_Input{
+					Input: &fm.Srv_Call_Input_HttpRequest_{
+						HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+							Method: "POST",
+							Url:    method.path(),
+							Body:   jsonValue(t, `{"id": 42, "unknown": true}`),
+						},
+					},
+				},
+			}
+		}
+	}
+	require.NotNil(t, msg)
+
+	for name, tc := range map[string]struct {
+		code     codes.Code
+		response string
+		failed   []string
+		skipped  []string
+	}{
+		"ok":        {codes.OK, `{"id": "42", "name": "Rex", "status": "AVAILABLE"}`, nil, nil},
+		"not found": {codes.NotFound, ``, nil, []string{"response validates schema"}},
+		"internal":  {codes.Internal, ``, []string{"gRPC status"}, []string{"response validates schema"}},
+		// Sent by a reachable server so not a connection failure
+		"unavailable": {codes.Unavailable, ``, nil, []string{"response validates schema"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			code, response = tc.code, tc.response
+			ctx := modelertest.Context()
+			c := m.NewCaller(ctx, msg, t.Logf)
+
+			req := c.RequestProto()
+			require.Empty(t, req.GetReason())
+			grpcReq := req.GetInput().GetGrpcRequest()
+			require.Equal(t, "/petstore.v1.PetStore/GetPet", grpcReq.GetMethod())
+			require.Equal(t, `{"id":"42"}`, string(grpcReq.GetBody()))
+			require.Equal(t, []string{"s3cr3t"}, grpcReq.GetMetadata()["x-token"].GetValues())
+
+			c.Do(ctx)
+
+			rep := c.ResponseProto().GetOutput().GetGrpcResponse()
+			require.Equal(t, uint32(tc.code), rep.GetCode())
+			require.Equal(t, tc.code.String(), rep.GetStatus())
+			require.Equal(t, []string{"s3cr3t"}, rep.GetHeaders()["x-seen-token"].GetValues())
+			if tc.code == codes.OK {
+				require.Equal(t, "Rex", rep.GetBodyDecoded().GetStructValue().GetFields()["name"].GetStringValue())
+				require.Equal(t, []string{"done"}, rep.GetTrailers()["x-trailer"].GetValues())
+			} else {
+				require.Equal(t, "oops", rep.GetMessage())
+				require.Empty(t, rep.GetBody())
+			}
+
+			var failed, skipped []string
+			for {
+				name, check := c.NextCallerCheck()
+				if check == nil {
+					break
+				}
+				_, s, f := check()
+				if len(f) != 0 {
+					failed = append(failed, name)
+				}
+				if s != "" {
+					skipped = append(skipped, name)
+				}
+			}
+			require.Equal(t, tc.failed, failed)
+			require.Equal(t, tc.skipped, skipped)
+		})
+	}
+}
+
+func TestCallerReportsUnavailableServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	target := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	m := newPetstore(t, target)
+	defer m.Close()
+
+	var msg *fm.Srv_Call
+	for EID, method := range m.methods {
+		if method.Input().FullName() == protoreflect.FullName("google.protobuf.Empty") {
+			msg = &fm.Srv_Call{EID: EID, Input: &fm.Srv_Call_Input{
+				Input: &fm.Srv_Call_Input_HttpRequest_{HttpRequest: &fm.Srv_Call_Input_HttpRequest{}},
+			}}
+		}
+	}
+	require.NotNil(t, msg)
+
+	ctx := modelertest.Context()
+	c := m.NewCaller(ctx, msg, t.Logf)
+	require.Empty(t, c.RequestProto().GetReason())
+	c.Do(ctx)
+	require.Equal(t, "Unavailable", c.ResponseProto().GetOutput().GetGrpcResponse().GetStatus())
+
+	name, check := c.NextCallerCheck()
+	require.Equal(t, "connection to server", name)
+	_, _, f := check()
+	require.NotEmpty(t, f)
+}
+
+func TestCallerRejectsUndecodableInput(t *testing.T) {
+	m := newPetstore(t, "localhost:50051")
+	var msg *fm.Srv_Call
+	for EID, method := range m.methods {
+		if method.id() == "petstore.v1.PetStore/GetPet" {
+			msg = &fm.Srv_Call{EID: EID, Input: &fm.Srv_Call_Input{
+				Input: &fm.Srv_Call_Input_HttpRequest_{HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+					Body: jsonValue(t, `{"id": "forty-two"}`),
+				}},
+			}}
+		}
+	}
+	require.NotNil(t, msg)
+
+	ctx := modelertest.Context()
+	reason := m.NewCaller(ctx, msg, t.Logf).RequestProto().GetReason()
+	require.Len(t, reason, 1)
+	require.Contains(t, reason[0], "cannot encode petstore.v1.GetPetRequest")
+}
@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"log"
+	"math"
+	"sort"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type schemaJSON = map[string]interface{}
+type schemasJSON = map[string]schemaJSON
+
+// Integers beyond 2^53 do not survive a trip through a float64
+const maxSafeInteger = 1 << 53
+
+// Schemas follow the JSON mapping of protobuf messages, as described in
+// https://developers.google.com/protocol-buffers/docs/proto3#json
+// Note that 64-bit integers are encoded as strings but decoded from either.
+var scalarSchemas = map[protoreflect.Kind]schemaJSON{
+	protoreflect.BoolKind:     {"type": []string{"boolean"}},
+	protoreflect.Int32Kind:    int32Schema,
+	protoreflect.Sint32Kind:   int32Schema,
+	protoreflect.Sfixed32Kind: int32Schema,
+	protoreflect.Uint32Kind:   uint32Schema,
+	protoreflect.Fixed32Kind:  uint32Schema,
+	protoreflect.Int64Kind:    int64Schema,
+	protoreflect.Sint64Kind:   int64Schema,
+	protoreflect.Sfixed64Kind: int64Schema,
+	protoreflect.Uint64Kind:   uint64Schema,
+	protoreflect.Fixed64Kind:  uint64Schema,
+	protoreflect.FloatKind:    {"type": []string{"number"}, "format": "float"},
+	protoreflect.DoubleKind:   {"type": []string{"number"}, "format": "double"},
+	protoreflect.StringKind:   {"type": []string{"string"}},
+	protoreflect.BytesKind:    {"type": []string{"string"}, "format": "byte"},
+}
+
+var (
+	int32Schema = schemaJSON{
+		"type":    []string{"integer"},
+		"format":  "int32",
+		"minimum": float64(math.MinInt32),
+		"maximum": float64(math.MaxInt32),
+	}
+	uint32Schema = schemaJSON{
+		"type":    []string{"integer"},
+		"minimum": float64(0),
+		"maximum": float64(math.MaxUint32),
+	}
+	int64Schema = schemaJSON{
+		"type":    []string{"integer", "string"},
+		"format":  "int64",
+		"minimum": float64(-maxSafeInteger),
+		"maximum": float64(maxSafeInteger),
+		"pattern": `^-?[0-9]{1,18}$`,
+	}
+	uint64Schema = schemaJSON{
+		"type":    []string{"integer", "string"},
+		"minimum": float64(0),
+		"maximum": float64(maxSafeInteger),
+		"pattern": `^[0-9]{1,19}$`,
+	}
+)
+
+// wellKnownSchemas are inlined instead of being named
+var wellKnownSchemas = map[protoreflect.FullName]schemaJSON{
+	"google.protobuf.Timestamp": {"type": []string{"string"}, "format": "date-time"},
+	"google.protobuf.Duration":  {"type": []string{"string"}, "pattern": `^-?[0-9]{1,12}(\.[0-9]{1,9})?s$`},
+	"google.protobuf.FieldMask": {"type": []string{"string"}},
+	"google.protobuf.Empty":     {"type": []string{"object"}},
+	"google.protobuf.Struct":    {"type": []string{"object"}},
+	"google.protobuf.ListValue": {"type": []string{"array"}},
+	"google.protobuf.Value":     {},
+	"google.protobuf.NullValue": {"type": []string{"null"}},
+	"google.protobuf.Any": {
+		"type":       []string{"object"},
+		"properties": schemasJSON{"@type": {"type": []string{"string"}}},
+		"required":   []string{"@type"},
+	},
+	"google.protobuf.DoubleValue": scalarSchemas[protoreflect.DoubleKind],
+	"google.protobuf.FloatValue":  scalarSchemas[protoreflect.FloatKind],
+	"google.protobuf.Int64Value":  int64Schema,
+	"google.protobuf.UInt64Value": uint64Schema,
+	"google.protobuf.Int32Value":  int32Schema,
+	"google.protobuf.UInt32Value": uint32Schema,
+	"google.protobuf.BoolValue":   scalarSchemas[protoreflect.BoolKind],
+	"google.protobuf.StringValue": scalarSchemas[protoreflect.StringKind],
+	"google.protobuf.BytesValue":  scalarSchemas[protoreflect.BytesKind],
+}
+
+// rpc is a unary method of a service
+type rpc struct {
+	protoreflect.MethodDescriptor
+}
+
+// path is what the HTTP/2 request targets, e.g. /helloworld.Greeter/SayHello
+func (m rpc) path() string {
+	return "/" + string(m.Parent().FullName()) + "/" + string(m.Name())
+}
+
+// id is how grpcurl names an RPC, e.g. helloworld.Greeter/SayHello
+func (m rpc) id() string { return m.path()[1:] }
+
+// rpcs lists the unary methods of the services defined in files, sorted
+func rpcs(files *protoregistry.Files) (methods []rpc) {
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			ms := services.Get(i).Methods()
+			for j := 0; j < ms.Len(); j++ {
+				method := rpc{ms.Get(j)}
+				if method.IsStreamingClient() || method.IsStreamingServer() {
+					log.Printf("[NFO] skipping streaming RPC %s", method.id())
+					continue
+				}
+				methods = append(methods, method)
+			}
+		}
+		return true
+	})
+	sort.Slice(methods, func(i, j int) bool { return methods[i].path() < methods[j].path() })
+	return
+}
+
+// namer collects the schemas of messages and enums by full name
+type namer struct {
+	schemas schemasJSON
+}
+
+func (n *namer) message(md protoreflect.MessageDescriptor) schemaJSON {
+	name := md.FullName()
+	if schema, ok := wellKnownSchemas[name]; ok {
+		return schema
+	}
+	ref := openapiv3.SchemaRef(string(name))
+	if _, ok := n.schemas[string(name)]; ok {
+		return ref
+	}
+	schema := schemaJSON{"type": []string{"object"}}
+	// Placeholder for recursive messages
+	n.schemas[string(name)] = schema
+
+	fields := md.Fields()
+	properties := make(schemasJSON, fields.Len())
+	var required []string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = n.field(fd)
+		if fd.Cardinality() == protoreflect.Required {
+			required = append(required, fd.JSONName())
+		}
+	}
+	if len(properties) != 0 {
+		schema["properties"] = properties
+	}
+	if len(required) != 0 {
+		schema["required"] = required
+	}
+
+	// At most one field of a oneof may be set
+	var exclusive []schemaJSON
+	oneofs := md.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		of := oneofs.Get(i).Fields()
+		for j := 0; j < of.Len(); j++ {
+			for k := j + 1; k < of.Len(); k++ {
+				exclusive = append(exclusive, schemaJSON{
+					"required": []string{of.Get(j).JSONName(), of.Get(k).JSONName()},
+				})
+			}
+		}
+	}
+	if len(exclusive) != 0 {
+		schema["not"] = schemaJSON{"anyOf": exclusive}
+	}
+	return ref
+}
+
+func (n *namer) enum(ed protoreflect.EnumDescriptor) schemaJSON {
+	name := ed.FullName()
+	if schema, ok := wellKnownSchemas[name]; ok {
+		return schema
+	}
+	if _, ok := n.schemas[string(name)]; !ok {
+		values := ed.Values()
+		enum := make([]interface{}, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			enum = append(enum, string(values.Get(i).Name()))
+		}
+		n.schemas[string(name)] = schemaJSON{"type": []string{"string"}, "enum": enum}
+	}
+	return openapiv3.SchemaRef(string(name))
+}
+
+func (n *namer) field(fd protoreflect.FieldDescriptor) schemaJSON {
+	if fd.IsMap() {
+		// NOTE: keys and values are not described as additionalProperties
+		// are not supported yet
+		n.value(fd.MapValue())
+		return schemaJSON{"type": []string{"object"}}
+	}
+	if fd.IsList() {
+		return schemaJSON{
+			"type":  []string{"array"},
+			"items": []schemaJSON{n.value(fd)},
+		}
+	}
+	return n.value(fd)
+}
+
+func (n *namer) value(fd protoreflect.FieldDescriptor) schemaJSON {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return n.message(fd.Message())
+	case protoreflect.EnumKind:
+		return n.enum(fd.Enum())
+	default:
+		return scalarSchemas[fd.Kind()]
+	}
+}
+
+// newSpec maps each unary RPC to an endpoint POSTing its request message
+func newSpec(methods []rpc) (spec *openapiv3.Spec, err error) {
+	n := &namer{schemas: make(schemasJSON)}
+	type io struct{ input, output schemaJSON }
+	ios := make([]io, 0, len(methods))
+	for _, method := range methods {
+		ios = append(ios, io{
+			input:  n.message(method.Input()),
+			output: n.message(method.Output()),
+		})
+	}
+
+	if spec, err = openapiv3.NewSpec(n.schemas); err != nil {
+		return
+	}
+	for i, method := range methods {
+		spec.AddEndpoint(&fm.EndpointJSON{
+			Method:       fm.EndpointJSON_POST,
+			PathPartials: []*fm.PathPartial{{Pp: &fm.PathPartial_Part{Part: method.path()}}},
+			Inputs: []*fm.ParamJSON{{
+				IsRequired: true,
+				SID:        spec.SID(ios[i].input),
+				Kind:       fm.ParamJSON_body,
+			}},
+			Outputs:     map[uint32]uint32{0: spec.SID(ios[i].output)},
+			OperationId: method.id(),
+			Tags:        []string{string(method.Parent().Name())},
+		})
+	}
+	return
+}
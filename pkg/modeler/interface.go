@@ -10,6 +10,7 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 	"github.com/gogo/protobuf/types"
 	"go.starlark.net/starlark"
+	"golang.org/x/net/http/httpproxy"
 )
 
 var (
@@ -29,20 +30,33 @@ type Interface interface {
 	GetResetter() resetter.Interface
 
 	Lint(context.Context, bool) error
+	// Files lists local files read by the model once linted, keyed by path.
+	// Contents of secret files are redacted.
+	Files() map[string]string
 
 	InputsCount() int
 	WriteAbsoluteReferences(io.Writer)
-	FilterEndpoints([]string) ([]uint32, error)
+	FilterEndpoints(*EndpointsFilter, ShowFunc) ([]uint32, error)
 
 	ValidateAgainstSchema(string, []byte) error
 	Validate(uint32, *types.Value) []string
 
 	// NewCaller is called before making each call
 	NewCaller(context.Context, *fm.Srv_Call, ShowFunc) Caller
+	// ResetCaller is called before each test
+	ResetCaller()
+	// Close releases resources held across calls
+	Close()
 
 	// Check(...) ...
 }
 
+// ProxyConfigurer is implemented by models whose calls may go through HTTP proxies
+type ProxyConfigurer interface {
+	// SetProxyEnvs passes the proxying environment variables read by the runtime
+	SetProxyEnvs(*httpproxy.Config)
+}
+
 // ShowFunc can be used to display informational messages to the tester
 type ShowFunc func(string, ...interface{})
 
@@ -0,0 +1,47 @@
+// Package modelertest provides utilities for testing modelers.
+package modelertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+// Context returns a context holding the values callers expect
+func Context() context.Context {
+	return context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+}
+
+// New creates a model from kwargs then lints it
+func New(t *testing.T, mdlr modeler.Interface, kwargs starlark.StringDict) modeler.Interface {
+	mdl, err := mdlr.NewFromKwargs(kwargs)
+	require.Nil(t, err)
+	require.NoError(t, mdl.Lint(context.Background(), false))
+	return mdl
+}
+
+// CheckKwargs runs NewFromKwargs on each Starlark dict of kwargs
+// and compares its error to the expected one, if any.
+func CheckKwargs(t *testing.T, mdlr modeler.Interface, expectations map[string]string) {
+	for kwargs, expected := range expectations {
+		t.Run(kwargs, func(t *testing.T) {
+			v, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", kwargs, nil)
+			require.NoError(t, err)
+			d := make(starlark.StringDict)
+			for _, kv := range v.(*starlark.Dict).Items() {
+				d[string(kv[0].(starlark.String))] = kv[1]
+			}
+
+			_, merr := mdlr.NewFromKwargs(d)
+			if expected == "" {
+				require.Nil(t, merr)
+			} else {
+				require.EqualError(t, merr, expected)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ type namedLambda struct {
 
 func (m *oa3) callerChecks() []namedLambda {
 	return []namedLambda{
+		{"call within limits", m.checkLimits},
 		{"connection to server", m.checkConn},
 		{"code < 500", m.checkNot5XX},
 		//TODO: when decoupling modeler/caller move these to modeler
@@ -23,6 +24,19 @@ func (m *oa3) callerChecks() []namedLambda {
 	}
 }
 
+func (m *oa3) checkLimits() (s, skipped string, f []string) {
+	if err := m.tcap.limitErr; err != nil {
+		f = append(f, err.Error())
+		return
+	}
+	if m.RequestTimeoutNs == 0 && m.ReadTimeoutNs == 0 && m.MaxResponseBytes == 0 {
+		skipped = "no limits set"
+		return
+	}
+	s = "call completed within limits"
+	return
+}
+
 func (m *oa3) checkConn() (s, skipped string, f []string) {
 	if err := m.tcap.doErr; err != nil {
 		f = append(f, "communication with server could not be established")
@@ -53,6 +67,9 @@ func (m *oa3) checkHTTPCode() (s, skipped string, f []string) {
 }
 
 func (m *oa3) checkValidJSONResponse() (s, skipped string, f []string) {
+	if kind := m.tcap.streamKind; kind != streamNone {
+		return m.checkValidJSONEvents(kind)
+	}
 	if len(m.tcap.repProto.Body) == 0 {
 		skipped = "response body is empty"
 		return
@@ -67,11 +84,35 @@ func (m *oa3) checkValidJSONResponse() (s, skipped string, f []string) {
 	return
 }
 
+func (m *oa3) checkValidJSONEvents(kind streamKind) (s, skipped string, f []string) {
+	events := m.tcap.repProto.Events
+	if len(events) == 0 {
+		skipped = fmt.Sprintf("no %s in response", kind)
+		return
+	}
+	// Data of server-sent events need not be JSON
+	if kind == streamSSE && m.tcap.matchedSID == 0 {
+		skipped = "no JSON Schema specified for server-sent events"
+		return
+	}
+
+	if errs := m.tcap.repEventsErrs; len(errs) != 0 {
+		f = errs
+		return
+	}
+
+	s = fmt.Sprintf("all %d %s are valid JSON", len(events), kind)
+	return
+}
+
 func (m *oa3) checkValidatesJSONSchema() (s, skipped string, f []string) {
 	if m.tcap.matchedSID == 0 {
 		skipped = "no JSON Schema specified for response"
 		return
 	}
+	if kind := m.tcap.streamKind; kind != streamNone {
+		return m.checkEventsValidateJSONSchema(kind)
+	}
 	if len(m.tcap.repProto.Body) == 0 {
 		skipped = "response body is empty"
 		return
@@ -83,3 +124,21 @@ func (m *oa3) checkValidatesJSONSchema() (s, skipped string, f []string) {
 	s = "response validates JSON Schema"
 	return
 }
+
+func (m *oa3) checkEventsValidateJSONSchema(kind streamKind) (s, skipped string, f []string) {
+	events := m.tcap.repProto.Events
+	if len(events) == 0 {
+		skipped = fmt.Sprintf("no %s in response", kind)
+		return
+	}
+	for i, event := range events {
+		for _, err := range m.vald.Validate(m.tcap.matchedSID, event.DataDecoded) {
+			f = append(f, fmt.Sprintf("event #%d: %s", i, err))
+		}
+	}
+	if len(f) != 0 {
+		return
+	}
+	s = fmt.Sprintf("all %d %s validate JSON Schema", len(events), kind)
+	return
+}
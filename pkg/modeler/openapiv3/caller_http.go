@@ -4,14 +4,16 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
-	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
@@ -19,26 +21,39 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/http/httpproxy"
 )
 
 var (
 	headerAuthorization    = http.CanonicalHeaderKey("Authorization")
 	headerContentLength    = http.CanonicalHeaderKey("Content-Length")
+	headerContentType      = http.CanonicalHeaderKey("Content-Type")
 	headerHost             = http.CanonicalHeaderKey("Host")
+	headerLocation         = http.CanonicalHeaderKey("Location")
+	headerRetryAfter       = http.CanonicalHeaderKey("Retry-After")
 	headerTransferEncoding = http.CanonicalHeaderKey("Transfer-Encoding")
 	headerUserAgent        = http.CanonicalHeaderKey("User-Agent")
 )
 
 var (
-	_ modeler.Caller    = (*tCapHTTP)(nil)
-	_ http.RoundTripper = (*tCapHTTP)(nil)
+	_ modeler.Caller         = (*tCapHTTP)(nil)
+	_ modeler.RetryingCaller = (*tCapHTTP)(nil)
+	_ http.RoundTripper      = (*tCapHTTP)(nil)
 )
 
 type tCapHTTP struct {
+	m *oa3 // The model's options and its state shared across calls
+
 	showf               modeler.ShowFunc
 	buildHTTPRequestErr error
+	redactedHeaders     []string
 	doErr               error
 
+	limitErr error
+
+	retries   []string
+	throttled string
+
 	endpoint        *fm.EndpointJSON
 	matchedOutputID uint32
 	matchedSID      sid
@@ -49,6 +64,8 @@ type tCapHTTP struct {
 	httpReq          *http.Request
 	repProto         *fm.Clt_CallResponseRaw_Output_HttpResponse
 	repBodyDecodeErr error
+	streamKind       streamKind
+	repEventsErrs    []string
 
 	// TODO: pick from these
 	// %{content_type} shows the Content-Type of the requested document, if there was any.
@@ -56,13 +73,8 @@ type tCapHTTP struct {
 	// %{ftp_entry_path} shows the initial path curl ended up in when logging on to the remote FTP server.
 	// %{response_code} shows the numerical response code that was found in the last transfer.
 	// %{http_connect} shows the numerical code that was found in the last response (from a proxy) to a curl CONNECT request.
-	// %{local_ip} shows the IP address of the local end of the most recently done connection—can be either IPv4 or IPv6
-	// %{local_port} shows the local port number of the most recently made connection
 	// %{num_connects} shows the number of new connects made in the recent transfer.
-	// %{num_redirects} shows the number of redirects that were followed in the request.
 	// %{redirect_url} shows the actual URL a redirect would take you to when an HTTP request was made without -L to follow redirects.
-	// %{remote_ip} shows the remote IP address of the most recently made connection—can be either IPv4 or IPv6.
-	// %{remote_port} shows the remote port number of the most recently made connection.
 	// %{size_download} shows the total number of bytes that were downloaded.
 	// %{size_header} shows the total number of bytes of the downloaded headers.
 	// %{size_request} shows the total number of bytes that were sent in the HTTP request.
@@ -70,12 +82,8 @@ type tCapHTTP struct {
 	// %{speed_download} shows the average download speed that curl measured for the complete download in bytes per second.
 	// %{speed_upload} shows the average upload speed that curl measured for the complete upload in bytes per second.
 	// %{ssl_verify_result} shows the result of the SSL peer certificate verification that was requested. 0 means the verification was successful.
-	// %{time_appconnect} shows the time, in seconds, it took from the start until the SSL/SSH/etc connect/handshake to the remote host was completed.
-	// %{time_connect} shows the time, in seconds, it took from the start until the TCP connect to the remote host (or proxy) was completed.
-	// %{time_namelookup} shows the time, in seconds, it took from the start until the name resolving was completed.
 	// %{time_pretransfer} shows the time, in seconds, it took from the start until the file transfer was just about to begin. This includes all pre-transfer commands and negotiations that are specific to the particular protocol(s) involved.
 	// %{time_redirect} shows the time, in seconds, it took for all redirection steps including name lookup, connect, pre-transfer and transfer before the final transaction was started. time_redirect shows the complete execution time for multiple redirections.
-	// %{time_starttransfer} shows the time, in seconds, it took from the start until the first byte was just about to be transferred. This includes time_pretransfer and also the time the server needed to calculate the result.
 	// %{time_total} shows the total time, in seconds, that the full operation lasted. The time will be displayed with millisecond resolution.
 	// %{url_effective} shows the URL that was fetched last. This is particularly meaningful if you have told curl to follow Location: headers (with -L).
 }
@@ -83,11 +91,15 @@ type tCapHTTP struct {
 // NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
 func (m *oa3) NewCaller(ctx context.Context, msg *fm.Srv_Call, showf modeler.ShowFunc) modeler.Caller {
 	m.tcap = &tCapHTTP{
+		m:        m,
 		showf:    showf,
 		endpoint: m.vald.Spec.Endpoints[msg.GetEID()].GetJson(),
+		checks:   m.callerChecks(),
+	}
+	if m.CookieJar && m.jar == nil {
+		m.resetCookieJar()
 	}
 	m.tcap.httpReq, m.tcap.buildHTTPRequestErr = m.buildHTTPRequest(ctx, msg)
-	m.tcap.checks = m.callerChecks()
 	return m.tcap
 }
 
@@ -110,7 +122,17 @@ func (m *oa3) buildHTTPRequest(ctx context.Context, msg *fm.Srv_Call) (req *http
 		return
 	}
 
+	endpoint := m.vald.Spec.Endpoints[msg.GetEID()].GetJson()
+	cookies := cookieParams(endpoint)
 	for key, values := range input.GetHeaders() {
+		if _, ok := cookies[key]; ok {
+			addCookies(r, key, values.GetValues())
+			continue
+		}
+		if http.CanonicalHeaderKey(key) == headerCookie {
+			addCookies(r, headerCookie, values.GetValues())
+			continue
+		}
 		for _, value := range values.GetValues() {
 			r.Header.Add(key, value)
 		}
@@ -122,23 +144,51 @@ func (m *oa3) buildHTTPRequest(ctx context.Context, msg *fm.Srv_Call) (req *http
 
 	r.Header.Set(headerUserAgent, ctx.Value(ctxvalues.UserAgent).(string))
 
+	m.customizeRequest(r, endpoint)
+
 	if host := m.Host; host != "" {
-		var configured *url.URL
-		if configured, err = url.ParseRequestURI(host); err != nil {
+		var configured *sutHost
+		if configured, err = parseHost(host); err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
 
 		// NOTE: forces Request.Write to use URL.Host
 		r.Host = ""
-		r.URL.Scheme = configured.Scheme
-		r.URL.Host = configured.Host
+		r.URL.Scheme = configured.scheme
+		r.URL.Host = configured.host
+	}
+
+	if m.jar != nil {
+		for _, cookie := range m.jar.Cookies(r.URL) {
+			r.AddCookie(cookie)
+		}
+	}
+
+	if m.signer != nil {
+		if m.tcap.redactedHeaders, err = m.signRequest(r); err != nil {
+			return
+		}
 	}
 
 	req = r
 	return
 }
 
+// proxyFunc selects the proxy for a request, using the environment
+// variables as read by the runtime, as superseeded by the model's configuration.
+func (m *oa3) proxyFunc() func(*url.URL) (*url.URL, error) {
+	var cfg httpproxy.Config
+	if envs := m.proxyEnvs; envs != nil {
+		cfg = *envs
+	}
+	if proxy := m.Proxy; proxy != "" {
+		cfg.HTTPProxy = proxy
+		cfg.HTTPSProxy = proxy
+	}
+	return cfg.ProxyFunc()
+}
+
 // RequestProto returns call input as used by the client
 func (c *tCapHTTP) RequestProto() (i *fm.Clt_CallRequestRaw) {
 	i = &fm.Clt_CallRequestRaw{}
@@ -151,6 +201,8 @@ func (c *tCapHTTP) RequestProto() (i *fm.Clt_CallRequestRaw) {
 		i.Reason = strings.Split(err.Error(), "\n")
 		return
 	}
+	reqProto.UnixSocket = c.m.unixSocket()
+	redactHeaders(reqProto.Headers, c.redactedHeaders)
 	i.Input = &fm.Clt_CallRequestRaw_Input{
 		Input: &fm.Clt_CallRequestRaw_Input_HttpRequest_{
 			HttpRequest: reqProto,
@@ -224,9 +276,42 @@ func (c *tCapHTTP) Do(ctx context.Context) {
 		break
 	}
 
+	httpReq := c.httpReq
+	if timeout := time.Duration(c.m.RequestTimeoutNs); timeout != 0 {
+		reqCtx, cancel := context.WithTimeout(httpReq.Context(), timeout)
+		defer cancel()
+		httpReq = httpReq.WithContext(reqCtx)
+		defer func() {
+			if reqCtx.Err() == context.DeadlineExceeded && c.limitErr == nil {
+				c.limitErr = fmt.Errorf("call did not complete within request_timeout = %s", timeout)
+			}
+		}()
+	}
+
 	var rep []byte
 	var r *http.Response
-	if r, c.doErr = (&http.Client{Transport: c}).Do(c.httpReq); c.doErr != nil {
+	client := &http.Client{Transport: c}
+	for attempt := 0; ; attempt++ {
+		if c.doErr = c.m.limiterFor().acquire(httpReq.Context()); c.doErr != nil {
+			c.repProto = &fm.Clt_CallResponseRaw_Output_HttpResponse{Error: c.doErr.Error()}
+			break
+		}
+		r, c.doErr = client.Do(httpReq)
+		if c.doErr != nil || !c.retry(httpReq.Context(), r, attempt) {
+			break
+		}
+		r.Body.Close()
+		if httpReq.GetBody != nil {
+			body, err := httpReq.GetBody()
+			if err != nil {
+				log.Println("[ERR]", err)
+				break
+			}
+			httpReq = httpReq.Clone(httpReq.Context())
+			httpReq.Body = body
+		}
+	}
+	if c.doErr != nil {
 		rep = []byte(fmt.Sprintf("HTTP error: %s", c.doErr.Error()))
 	} else {
 		r.Body.Close()
@@ -243,34 +328,123 @@ func (c *tCapHTTP) Do(ctx context.Context) {
 }
 
 func (c *tCapHTTP) RoundTrip(req *http.Request) (rep *http.Response, err error) {
-	t := &http.Transport{
-		Proxy: func(req *http.Request) (*url.URL, error) {
-			// TODO: snap the envs that ProxyFromEnvironment reads
-			log.Println("[NFO] HTTP proxying is work in progress...")
-			return nil, nil
-		},
-		DialContext: (&net.Dialer{
-			Timeout:   30 * time.Second,
-			KeepAlive: 30 * time.Second,
-			DualStack: true,
-		}).DialContext,
-		// ForceAttemptHTTP2:     true,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
+	tracer := &httpTracer{}
+	var idle *idleTimeout
+	if timeout := time.Duration(c.m.ReadTimeoutNs); timeout != 0 {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		req = req.WithContext(ctx)
+		idle = &idleTimeout{d: timeout, cancel: cancel}
+		defer idle.stop()
+		tracer.onWroteRequest = idle.restart
+		defer func() {
+			if idle.expired() && !c.repProto.GetStreamCut() && c.limitErr == nil {
+				c.limitErr = fmt.Errorf("response stalled for longer than read_timeout = %s", timeout)
+			}
+		}()
+	}
+
+	jar := c.m.jar
+	if jar != nil && req.Response != nil {
+		// Following a redirect: cookies may have been set on the way
+		req = req.Clone(req.Context())
+		req.Header.Del(headerCookie)
+		for _, cookie := range jar.Cookies(req.URL) {
+			req.AddCookie(cookie)
+		}
 	}
 
 	start := time.Now()
-	rep, err = t.RoundTrip(req)
+	rep, err = c.m.httpTransport().RoundTrip(tracer.trace(req))
+	if err == nil && jar != nil {
+		jar.SetCookies(req.URL, rep.Cookies())
+	}
 	c.repProto = &fm.Clt_CallResponseRaw_Output_HttpResponse{
 		ElapsedNs: time.Since(start).Nanoseconds(),
 	}
+	c.repProto.History = redirectsToProto(req)
 	if err != nil {
 		c.repProto.Error = err.Error()
+		c.repProto.Timings = tracer.recorded()
+		return
+	}
+	idle.restart()
+	err = c.responseToProto(rep, tracer, idle)
+	return
+}
+
+// idleTimeout cancels a call once nothing was read for d.
+// It starts once the request is written and restarts on each read.
+type idleTimeout struct {
+	d      time.Duration
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	timer    *time.Timer
+	timedOut int32
+}
+
+func (it *idleTimeout) restart() {
+	if it == nil {
 		return
 	}
-	err = c.responseToProto(rep)
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.timer == nil {
+		it.timer = time.AfterFunc(it.d, func() {
+			atomic.StoreInt32(&it.timedOut, 1)
+			it.cancel()
+		})
+		return
+	}
+	it.timer.Reset(it.d)
+}
+
+func (it *idleTimeout) stop() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.timer != nil {
+		it.timer.Stop()
+	}
+}
+
+func (it *idleTimeout) expired() bool {
+	return it != nil && atomic.LoadInt32(&it.timedOut) == 1
+}
+
+// idleReader restarts an idleTimeout whenever bytes are read
+type idleReader struct {
+	r    io.Reader
+	idle *idleTimeout
+}
+
+func (ir *idleReader) Read(p []byte) (n int, err error) {
+	if n, err = ir.r.Read(p); n != 0 {
+		ir.idle.restart()
+	}
+	return
+}
+
+// Records the redirects that lead to this request, oldest first.
+func redirectsToProto(req *http.Request) (history []*fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect) {
+	for r := req.Response; r != nil && r.Request != nil; r = r.Request.Response {
+		headers := make(map[string]*fm.Clt_CallResponseRaw_Output_HttpResponse_HeaderValues, len(r.Header))
+		for key, values := range r.Header {
+			headers[key] = &fm.Clt_CallResponseRaw_Output_HttpResponse_HeaderValues{
+				Values: values,
+			}
+		}
+		history = append(history, &fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect{
+			Url:        r.Request.URL.String(),
+			StatusCode: uint32(r.StatusCode),
+			Reason:     r.Status,
+			Location:   r.Header.Get(headerLocation),
+			Headers:    headers,
+		})
+	}
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
 	return
 }
 
@@ -284,9 +458,10 @@ func (c *tCapHTTP) ResponseProto() *fm.Clt_CallResponseRaw {
 			}}}
 }
 
-func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
+func (c *tCapHTTP) responseToProto(r *http.Response, tracer *httpTracer, idle *idleTimeout) (err error) {
 	c.repProto.StatusCode = uint32(r.StatusCode)
 	c.repProto.Reason = r.Status
+	c.repProto.Connection = tracer.connection(r)
 
 	c.repProto.Headers = make(map[string]*fm.Clt_CallResponseRaw_Output_HttpResponse_HeaderValues, len(r.Header))
 	for key, headers := range r.Header {
@@ -307,23 +482,57 @@ func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
 		}
 	}
 
+	defer func() { c.repProto.Timings = tracer.recorded() }()
+
 	if r.Body != nil {
-		if c.repProto.Body, err = ioutil.ReadAll(r.Body); err != nil {
+		body := io.Reader(r.Body)
+		if idle != nil {
+			body = &idleReader{r: body, idle: idle}
+		}
+		if max := c.m.MaxResponseBytes; max != 0 {
+			body = io.LimitReader(body, int64(max)+1)
+		}
+		c.streamKind = streamKindOf(r.Header.Get(headerContentType))
+		if c.streamKind != streamNone {
+			c.repProto.Body, c.repProto.StreamCut, err = readStream(r.Body, body, c.m.streamDuration())
+			if err != nil && idle.expired() {
+				// A stream that went quiet for read_timeout is cut short, not failed
+				log.Printf("[NFO] stream cut after read_timeout = %s", idle.d)
+				c.repProto.StreamCut, err = true, nil
+			}
+		} else {
+			c.repProto.Body, err = ioutil.ReadAll(body)
+		}
+		if err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
-		if err = r.Body.Close(); err != nil {
+		tracer.transferDone()
+		if max := c.m.MaxResponseBytes; max != 0 && uint64(len(c.repProto.Body)) > max {
+			c.repProto.Body = c.repProto.Body[:max]
+			c.limitErr = fmt.Errorf("response body is larger than max_response_bytes = %d", max)
+			log.Println("[ERR]", c.limitErr)
+		}
+		if err = r.Body.Close(); err != nil && !c.repProto.StreamCut {
 			log.Println("[ERR]", err)
 			return
 		}
+		err = nil
 		r.Body = ioutil.NopCloser(bytes.NewReader(c.repProto.Body))
 
-		var x types.Value
-		if e := jsonpb.UnmarshalString(string(c.repProto.Body), &x); e != nil {
-			log.Println("[NFO] response body could not be decoded:", e)
-			c.repBodyDecodeErr = e
+		if c.streamKind != streamNone {
+			c.repProto.Events = parseStream(c.streamKind, c.repProto.Body, c.repProto.StreamCut)
+			if c.repEventsErrs = decodeEvents(c.repProto.Events); len(c.repEventsErrs) != 0 {
+				log.Println("[NFO] some events could not be decoded:", c.repEventsErrs)
+			}
 		} else {
-			c.repProto.BodyDecoded = &x
+			var x types.Value
+			if e := jsonpb.UnmarshalString(string(c.repProto.Body), &x); e != nil {
+				log.Println("[NFO] response body could not be decoded:", e)
+				c.repBodyDecodeErr = e
+			} else {
+				c.repProto.BodyDecoded = &x
+			}
 		}
 	}
 
@@ -343,17 +552,6 @@ func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
 		c.matchedHTTPCode = true
 	}()
 
-	// TODO? redirects with Response *Response
-	// Response is the redirect response which caused this request
-	// to be created. This field is only populated during client
-	// redirects.
-
-	// TODO? TLS *tls.ConnectionState
-	// TLS contains information about the TLS connection on which the
-	// response was received. It is nil for unencrypted responses.
-	// The pointer is shared between responses and should not be
-	// modified.
-
 	return
 }
 
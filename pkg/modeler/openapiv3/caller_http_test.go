@@ -0,0 +1,276 @@
+package openapiv3
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"golang.org/x/net/http/httpproxy"
+)
+
+func newPetstoreCaller(t *testing.T, host string) (*oa3, *fm.Srv_Call) {
+	m := &oa3{}
+	m.File = filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore.yaml")
+	err := m.Lint(context.TODO(), false)
+	require.NoError(t, err)
+	m.Host = host
+
+	for EID, endpoint := range m.vald.Spec.Endpoints {
+		if endpoint.GetJson().GetOperationId() == "listPets" {
+			return m, &fm.Srv_Call{
+				EID: EID,
+				Input: &fm.Srv_Call_Input{
+					Input: &fm.Srv_Call_Input_HttpRequest_{
+						HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+							Method: "GET",
+							Url:    "/v1/pets",
+						},
+					},
+				},
+			}
+		}
+	}
+	require.FailNow(t, "listPets not found")
+	return nil, nil
+}
+
+func TestCallerProxying(t *testing.T) {
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer proxy.Close()
+
+	for name, tc := range map[string]struct {
+		kwarg   string
+		envs    *httpproxy.Config
+		proxied bool
+	}{
+		"no proxy":          {"", nil, false},
+		"from kwarg":        {proxy.URL, nil, true},
+		"from envs":         {"", &httpproxy.Config{HTTPProxy: proxy.URL}, true},
+		"kwarg beats envs":  {proxy.URL, &httpproxy.Config{HTTPProxy: "http://127.0.0.1:1"}, true},
+		"envs for https":    {"", &httpproxy.Config{HTTPSProxy: proxy.URL}, false},
+		"envs and NO_PROXY": {"", &httpproxy.Config{HTTPProxy: proxy.URL, NoProxy: "sut.invalid"}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, "http://sut.invalid")
+			m.Proxy = tc.kwarg
+			m.SetProxyEnvs(tc.envs)
+
+			ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+			c := m.NewCaller(ctx, msg, t.Logf)
+			c.Do(ctx)
+			rep := c.ResponseProto().GetOutput().GetHttpResponse()
+
+			if !tc.proxied {
+				require.NotEmpty(t, rep.GetError())
+				return
+			}
+			require.Empty(t, rep.GetError())
+			require.Equal(t, "http://sut.invalid/v1/pets", <-proxied)
+			require.EqualValues(t, 200, rep.GetStatusCode())
+			require.JSONEq(t, `[]`, string(rep.GetBody()))
+		})
+	}
+}
+
+func TestProxyKwarg(t *testing.T) {
+	for proxy, ok := range map[string]bool{
+		"":                      true,
+		"http://127.0.0.1:3128": true,
+		"socks5://proxy:1080":   true,
+		"127.0.0.1:3128":        false,
+		"proxy":                 false,
+	} {
+		t.Run(proxy, func(t *testing.T) {
+			_, err := (&oa3{}).NewFromKwargs(starlark.StringDict{
+				"proxy": starlark.String(proxy),
+			})
+			if ok {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestCallerRecordsTimings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	m, msg := newPetstoreCaller(t, srv.URL)
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	c := m.NewCaller(ctx, msg, t.Logf)
+	c.Do(ctx)
+	rep := c.ResponseProto().GetOutput().GetHttpResponse()
+	require.Empty(t, rep.GetError())
+
+	timings := rep.GetTimings()
+	require.NotNil(t, timings)
+	require.Zero(t, timings.GetDnsNs()) // Host is an IP
+	require.NotZero(t, timings.GetConnectNs())
+	require.Zero(t, timings.GetTlsHandshakeNs())
+	require.NotZero(t, timings.GetTtfbNs())
+	require.GreaterOrEqual(t, timings.GetTransferNs(), (10 * time.Millisecond).Nanoseconds())
+}
+
+func TestCallerRecordsRedirectsAndConnection(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/v1/pets", http.RedirectHandler("/v2/pets", http.StatusMovedPermanently))
+	mux.Handle("/v2/pets", http.RedirectHandler("/v3/pets", http.StatusFound))
+	mux.HandleFunc("/v3/pets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	m, msg := newPetstoreCaller(t, srv.URL)
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	c := m.NewCaller(ctx, msg, t.Logf)
+	c.Do(ctx)
+	rep := c.ResponseProto().GetOutput().GetHttpResponse()
+	require.Empty(t, rep.GetError())
+	require.EqualValues(t, 200, rep.GetStatusCode())
+
+	history := rep.GetHistory()
+	require.Len(t, history, 2)
+	require.Equal(t, srv.URL+"/v1/pets", history[0].GetUrl())
+	require.EqualValues(t, 301, history[0].GetStatusCode())
+	require.Equal(t, "301 Moved Permanently", history[0].GetReason())
+	require.Equal(t, "/v2/pets", history[0].GetLocation())
+	require.Equal(t, []string{"/v2/pets"}, history[0].GetHeaders()["Location"].GetValues())
+	require.Equal(t, srv.URL+"/v2/pets", history[1].GetUrl())
+	require.EqualValues(t, 302, history[1].GetStatusCode())
+	require.Equal(t, "/v3/pets", history[1].GetLocation())
+
+	conn := rep.GetConnection()
+	require.Equal(t, "HTTP/1.1", conn.GetProtocol())
+	require.Equal(t, srv.Listener.Addr().String(), net.JoinHostPort(conn.GetRemoteIp(), strconv.Itoa(int(conn.GetRemotePort()))))
+	require.Equal(t, "127.0.0.1", conn.GetLocalIp())
+	require.NotZero(t, conn.GetLocalPort())
+	require.Nil(t, conn.GetTls())
+}
+
+func TestCallerLimits(t *testing.T) {
+	sleep := func(r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}
+	servers := map[string]*httptest.Server{
+		"hangs": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sleep(r)
+			w.Write([]byte(`[]`))
+		})),
+		"streams": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[`))
+			w.(http.Flusher).Flush()
+			sleep(r)
+			w.Write([]byte(`]`))
+		})),
+		"trickles": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[`))
+			for i := 0; i < 6; i++ {
+				w.(http.Flusher).Flush()
+				time.Sleep(50 * time.Millisecond)
+				w.Write([]byte(`1,`))
+			}
+			w.Write([]byte(`1]`))
+		})),
+		"events": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Write([]byte("data: {}\n\n"))
+			w.(http.Flusher).Flush()
+			sleep(r)
+		})),
+		"large": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`["` + strings.Repeat("x", 100) + `"]`))
+		})),
+	}
+	for _, srv := range servers {
+		defer srv.Close()
+	}
+
+	const (
+		short = 100 * time.Millisecond
+		long  = 10 * time.Second
+	)
+	for name, tc := range map[string]struct {
+		server                      string
+		requestTimeout, readTimeout time.Duration
+		maxResponseBytes            uint64
+		failure, skipped            string
+		cut                         bool
+	}{
+		"no limits":            {server: "large", skipped: "no limits set"},
+		"within limits":        {server: "large", requestTimeout: long, readTimeout: long, maxResponseBytes: 1024},
+		"hangs":                {server: "hangs", requestTimeout: short, failure: "request_timeout = 100ms"},
+		"hangs then read":      {server: "hangs", readTimeout: short, failure: "read_timeout = 100ms"},
+		"streams":              {server: "streams", requestTimeout: short, failure: "request_timeout = 100ms"},
+		"streams then read":    {server: "streams", readTimeout: short, failure: "read_timeout = 100ms"},
+		"trickles":             {server: "trickles", readTimeout: short},
+		"events go quiet":      {server: "events", readTimeout: short, cut: true},
+		"too large":            {server: "large", maxResponseBytes: 10, failure: "max_response_bytes = 10"},
+		"large within timeout": {server: "large", requestTimeout: long, maxResponseBytes: 10, failure: "max_response_bytes = 10"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, servers[tc.server].URL)
+			defer m.Close()
+			m.RequestTimeoutNs = tc.requestTimeout.Nanoseconds()
+			m.ReadTimeoutNs = tc.readTimeout.Nanoseconds()
+			m.MaxResponseBytes = tc.maxResponseBytes
+
+			ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+			c := m.NewCaller(ctx, msg, t.Logf)
+			start := time.Now()
+			c.Do(ctx)
+			require.Less(t, int64(time.Since(start)), int64(long))
+
+			s, skipped, f := m.checkLimits()
+			switch {
+			case tc.failure != "":
+				require.Len(t, f, 1)
+				require.Contains(t, f[0], tc.failure)
+			case tc.skipped != "":
+				require.Equal(t, tc.skipped, skipped)
+			default:
+				require.NotEmpty(t, s)
+				require.Empty(t, f)
+			}
+			rep := c.ResponseProto().GetOutput().GetHttpResponse()
+			require.Equal(t, tc.cut, rep.GetStreamCut())
+			if tc.cut {
+				require.Len(t, rep.GetEvents(), 1)
+			}
+			if tc.maxResponseBytes != 0 {
+				body := c.ResponseProto().GetOutput().GetHttpResponse().GetBody()
+				require.LessOrEqual(t, uint64(len(body)), tc.maxResponseBytes)
+			}
+		})
+	}
+}
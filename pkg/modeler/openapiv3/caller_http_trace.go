@@ -0,0 +1,142 @@
+package openapiv3
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+// httpTracer records the timings and connection of a single HTTP round trip
+type httpTracer struct {
+	mu      sync.Mutex
+	timings fm.Clt_CallResponseRaw_Output_HttpResponse_Timings
+	conn    fm.Clt_CallResponseRaw_Output_HttpResponse_Connection
+
+	dnsStart, connectStart, tlsStart time.Time
+	wroteRequest, firstByte          time.Time
+
+	onWroteRequest func()
+}
+
+func (ht *httpTracer) trace(req *http.Request) *http.Request {
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) { ht.start(&ht.dnsStart) },
+		DNSDone:  func(httptrace.DNSDoneInfo) { ht.done(&ht.dnsStart, &ht.timings.DnsNs) },
+
+		// NOTE: with happy eyeballs more than one connection may be attempted
+		ConnectStart: func(string, string) { ht.start(&ht.connectStart) },
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				ht.done(&ht.connectStart, &ht.timings.ConnectNs)
+			}
+		},
+
+		TLSHandshakeStart: func() { ht.start(&ht.tlsStart) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			ht.done(&ht.tlsStart, &ht.timings.TlsHandshakeNs)
+		},
+
+		GotConn: ht.gotConn,
+
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			ht.start(&ht.wroteRequest)
+			if ht.onWroteRequest != nil {
+				ht.onWroteRequest()
+			}
+		},
+		GotFirstResponseByte: func() {
+			ht.start(&ht.firstByte)
+			ht.done(&ht.wroteRequest, &ht.timings.TtfbNs)
+		},
+	}))
+}
+
+// transferDone is to be called once the response body is fully read
+func (ht *httpTracer) transferDone() {
+	ht.done(&ht.firstByte, &ht.timings.TransferNs)
+}
+
+func (ht *httpTracer) gotConn(info httptrace.GotConnInfo) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.conn.Reused = info.Reused
+	ht.conn.RemoteIp, ht.conn.RemotePort = splitAddr(info.Conn.RemoteAddr())
+	ht.conn.LocalIp, ht.conn.LocalPort = splitAddr(info.Conn.LocalAddr())
+}
+
+func splitAddr(addr net.Addr) (ip string, port uint32) {
+	host, portStr, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		// e.g. unix sockets
+		ip = addr.String()
+		return
+	}
+	ip = host
+	if p, err := strconv.ParseUint(portStr, 10, 16); err == nil {
+		port = uint32(p)
+	}
+	return
+}
+
+func (ht *httpTracer) start(t *time.Time) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	*t = time.Now()
+}
+
+func (ht *httpTracer) done(start *time.Time, ns *int64) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	if zeroTime := (time.Time{}); *start == zeroTime {
+		return
+	}
+	*ns = time.Since(*start).Nanoseconds()
+}
+
+// recorded returns a copy of the timings recorded so far
+func (ht *httpTracer) recorded() *fm.Clt_CallResponseRaw_Output_HttpResponse_Timings {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	timings := ht.timings
+	return &timings
+}
+
+// connection returns the connection the response was received on
+func (ht *httpTracer) connection(r *http.Response) *fm.Clt_CallResponseRaw_Output_HttpResponse_Connection {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	conn := ht.conn
+	conn.Protocol = r.Proto
+	if state := r.TLS; state != nil {
+		conn.Tls = &fm.Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS{
+			Version:     tlsVersionName(state.Version),
+			CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+		}
+		if certs := state.PeerCertificates; len(certs) != 0 {
+			conn.Tls.PeerSubject = certs[0].Subject.String()
+		}
+	}
+	return &conn
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return fmt.Sprintf("0x%04X", version)
+	}
+}
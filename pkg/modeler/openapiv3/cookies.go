@@ -0,0 +1,47 @@
+package openapiv3
+
+import (
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+var headerCookie = http.CanonicalHeaderKey("Cookie")
+
+// resetCookieJar drops the cookies gathered during the previous test
+func (m *oa3) resetCookieJar() {
+	if !m.CookieJar {
+		return
+	}
+	log.Println("[NFO] starting with an empty cookie jar")
+	// Only errors on bad Options
+	m.jar, _ = cookiejar.New(nil)
+}
+
+// cookieParams lists the names of an endpoint's `in: cookie` parameters
+func cookieParams(e *fm.EndpointJSON) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, param := range e.GetInputs() {
+		if param.GetKind() == fm.ParamJSON_cookie {
+			names[param.GetName()] = struct{}{}
+		}
+	}
+	return names
+}
+
+// addCookies encodes cookies into a single Cookie header.
+// Values are either "name=value; ..." pairs or the value of cookie `name`.
+func addCookies(r *http.Request, name string, values []string) {
+	for _, value := range values {
+		if name == headerCookie {
+			parsed := &http.Request{Header: http.Header{headerCookie: {value}}}
+			for _, cookie := range parsed.Cookies() {
+				r.AddCookie(cookie)
+			}
+			continue
+		}
+		r.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+}
@@ -0,0 +1,80 @@
+package openapiv3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCookieJarIsPerTest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cr3t", Path: "/"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	sentCookie := func(c *tCapHTTP) []string {
+		return c.RequestProto().GetInput().GetHttpRequest().GetHeaders()[headerCookie].GetValues()
+	}
+
+	for _, jar := range []bool{false, true} {
+		m, msg := newPetstoreCaller(t, srv.URL)
+		m.CookieJar = jar
+		defer m.Close()
+		ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+
+		m.ResetCaller()
+		c := m.NewCaller(ctx, msg, t.Logf).(*tCapHTTP)
+		require.Empty(t, sentCookie(c))
+		c.Do(ctx)
+		require.Equal(t, []string{"session=s3cr3t; Path=/"}, c.repProto.GetHeaders()["Set-Cookie"].GetValues())
+
+		c = m.NewCaller(ctx, msg, t.Logf).(*tCapHTTP)
+		if jar {
+			require.Equal(t, []string{"session=s3cr3t"}, sentCookie(c))
+		} else {
+			require.Empty(t, sentCookie(c))
+		}
+		c.Do(ctx)
+
+		m.ResetCaller()
+		c = m.NewCaller(ctx, msg, t.Logf).(*tCapHTTP)
+		require.Empty(t, sentCookie(c))
+	}
+}
+
+func TestCookieParamsAreEncoded(t *testing.T) {
+	m, msg := newPetstoreCaller(t, "http://sut.invalid")
+	endpoint := m.vald.Spec.Endpoints[msg.GetEID()].GetJson()
+	endpoint.Inputs = append(endpoint.Inputs, &fm.ParamJSON{
+		Kind: fm.ParamJSON_cookie,
+		Name: "lang",
+	})
+	msg.GetInput().GetHttpRequest().Headers = map[string]*fm.Srv_Call_Input_HttpRequest_HeaderValues{
+		"lang":   {Values: []string{"en US"}},
+		"cookie": {Values: []string{"a=1; b=2", "c=3"}},
+		"X-Some": {Values: []string{"thing"}},
+	}
+
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	c := m.NewCaller(ctx, msg, t.Logf).(*tCapHTTP)
+	require.NoError(t, c.buildHTTPRequestErr)
+
+	require.Len(t, c.httpReq.Header[headerCookie], 1)
+	require.Equal(t, []string{"thing"}, c.httpReq.Header["X-Some"])
+	var cookies []string
+	for _, cookie := range c.httpReq.Cookies() {
+		cookies = append(cookies, cookie.Name+"="+cookie.Value)
+	}
+	sort.Strings(cookies)
+	require.Equal(t, []string{"a=1", "b=2", "c=3", "lang=en US"}, cookies)
+}
@@ -0,0 +1,412 @@
+package openapiv3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+	"go.starlark.net/starlark"
+)
+
+// endpointFacts holds what endpoints can be selected on
+type endpointFacts struct {
+	line                string
+	method, path, opID  string
+	tags                []string
+	ins, outs           []string // Only first-level $refs
+	deepIns, deepOuts   []string // All reachable $refs
+	deepInsS, deepOutsS string
+}
+
+func (vald *validator) endpointFacts(e *fm.EndpointJSON) *endpointFacts {
+	const fmtMPIO = "%s\t%s\t%s ➜ %s"
+	inputs := make(sids, 0, len(e.Inputs))
+	for _, param := range e.Inputs {
+		inputs = append(inputs, param.SID)
+	}
+	outputs := make(sids, 0, len(e.Outputs))
+	for _, SID := range e.Outputs {
+		outputs = append(outputs, SID)
+	}
+
+	facts := &endpointFacts{
+		method:   e.Method.String(),
+		path:     pathToOA3(e.PathPartials),
+		opID:     e.OperationId,
+		tags:     e.Tags,
+		ins:      vald.refsFromSIDs(inputs),
+		outs:     vald.refsFromSIDs(outputs),
+		deepIns:  vald.refsReachableFromSIDs(inputs),
+		deepOuts: vald.refsReachableFromSIDs(outputs),
+	}
+	facts.deepInsS = strings.Join(facts.deepIns, " | ")
+	facts.deepOutsS = strings.Join(facts.deepOuts, " | ")
+	facts.line = fmt.Sprintf(fmtMPIO, facts.method, facts.path,
+		strings.Join(facts.ins, " | "), strings.Join(facts.outs, " | "))
+	return facts
+}
+
+// refsReachableFromSIDs walks schemas (through $refs) and collects refs on the way
+func (vald *validator) refsReachableFromSIDs(SIDs sids) []string {
+	sm := vald.Spec.Schemas.GetJson()
+	seen := make(map[sid]struct{}, len(sm))
+	found := make(map[string]struct{})
+	todo := append(sids{}, SIDs...)
+	for len(todo) != 0 {
+		var SID sid
+		SID, todo = todo[0], todo[1:]
+		if _, ok := seen[SID]; ok || SID == 0 {
+			continue
+		}
+		seen[SID] = struct{}{}
+
+		if ptr := sm[SID].GetPtr(); ptr != nil {
+			if ref := ptr.GetRef(); ref != "" {
+				found[strings.TrimPrefix(ref, oa3ComponentsSchemas)] = struct{}{}
+			}
+			todo = append(todo, ptr.GetSID())
+			continue
+		}
+
+		schema := sm[SID].GetSchema()
+		todo = append(todo, schema.GetItems()...)
+		for _, propSID := range schema.GetProperties() {
+			todo = append(todo, propSID)
+		}
+		todo = append(todo, schema.GetAdditionalProperties().GetSID())
+		todo = append(todo, schema.GetAllOf()...)
+		todo = append(todo, schema.GetAnyOf()...)
+		todo = append(todo, schema.GetOneOf()...)
+		todo = append(todo, schema.GetNot())
+	}
+
+	refs := make([]string, 0, len(found))
+	for ref := range found {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+func (vald *validator) FilterEndpoints(filter *modeler.EndpointsFilter, showf modeler.ShowFunc) (eids []eid, err error) {
+	total := len(vald.Spec.Endpoints)
+	all := make(map[eid]*endpointFacts, total)
+	for eid := range vald.Spec.Endpoints {
+		all[eid] = vald.endpointFacts(vald.Spec.Endpoints[eid].GetJson())
+	}
+
+	line := func(e *endpointFacts) string { return e.line }
+	deepIns := func(e *endpointFacts) string { return e.deepInsS }
+	deepOuts := func(e *endpointFacts) string { return e.deepOutsS }
+	criteria := filter.Criteria
+	for i := 0; i < len(criteria); i++ {
+		c := criteria[i]
+		switch c.Kind {
+		case modeler.Only:
+			err = filterEndpoints(all, true, c.Value, line)
+		case modeler.Except:
+			err = filterEndpoints(all, false, c.Value, line)
+		case modeler.CallsWithInput:
+			err = filterEndpoints(all, true, c.Value, deepIns)
+		case modeler.CallsWithoutInput:
+			err = filterEndpoints(all, false, c.Value, deepIns)
+		case modeler.CallsWithOutput:
+			err = filterEndpoints(all, true, c.Value, deepOuts)
+		case modeler.CallsWithoutOutput:
+			err = filterEndpoints(all, false, c.Value, deepOuts)
+		case modeler.Operation:
+			var opIDs []string
+			opIDs, i = consecutiveValues(criteria, i)
+			err = filterOperations(all, opIDs)
+		case modeler.SpecTag:
+			var specTags []string
+			specTags, i = consecutiveValues(criteria, i)
+			err = filterSpecTags(all, specTags)
+		case modeler.Where:
+			if strings.Contains(c.Value, "{{") {
+				err = filterWhere(all, c.Value)
+			} else {
+				err = filterJSONPath(all, c.Value)
+			}
+		default:
+			err = fmt.Errorf("unexpected endpoints criterion kind %d", c.Kind)
+			log.Println("[ERR]", err)
+		}
+		if err != nil {
+			// Error printed in main
+			return
+		}
+	}
+
+	selected := uint32(len(all))
+	e := fmt.Sprintf("%d of %d endpoints selected for testing", selected, total)
+	if selected == 0 {
+		err = errors.New(e)
+		log.Println("[ERR]", err)
+		// Error printed in main
+		return
+	}
+
+	log.Println("[NFO]", e)
+	as.ColorNFO.Println(e)
+	eids = make([]eid, 0, selected)
+	for eid := range all {
+		eids = append(eids, eid)
+	}
+	sort.Slice(eids, func(i, j int) bool { return eids[i] < eids[j] })
+	for _, eid := range eids {
+		showf("%s", all[eid].line)
+	}
+	return
+}
+
+// consecutiveValues collects the values of the criteria of the same kind
+// as criteria[i] that directly follow it, returning the last such index.
+func consecutiveValues(criteria []modeler.EndpointsCriterion, i int) ([]string, int) {
+	values := []string{criteria[i].Value}
+	for i+1 < len(criteria) && criteria[i+1].Kind == criteria[i].Kind {
+		i++
+		values = append(values, criteria[i].Value)
+	}
+	return values, i
+}
+
+func filterEndpoints(all map[eid]*endpointFacts, only bool, pattern string, field func(*endpointFacts) string) (err error) {
+	var re *regexp.Regexp
+	if re, err = regexp.Compile(pattern); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	onlyMatched := false
+	for eid, e := range all {
+		if re.MatchString(field(e)) {
+			log.Println("[DBG]", pattern, "matched", e.line)
+			onlyMatched = true
+			if !only {
+				delete(all, eid)
+			}
+		} else if only {
+			delete(all, eid)
+		}
+	}
+	if only && !onlyMatched {
+		// Fail if any `only` is not there
+		err = fmt.Errorf("%s did not match any endpoints", pattern)
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func filterOperations(all map[eid]*endpointFacts, opIDs []string) (err error) {
+	if len(opIDs) == 0 {
+		return
+	}
+
+	wanted := make(map[string]bool, len(opIDs))
+	for _, opID := range opIDs {
+		wanted[opID] = false
+	}
+	for eid, e := range all {
+		if _, ok := wanted[e.opID]; ok {
+			log.Println("[DBG] operation", e.opID, "matched", e.line)
+			wanted[e.opID] = true
+		} else {
+			delete(all, eid)
+		}
+	}
+	for _, opID := range opIDs {
+		if !wanted[opID] {
+			err = fmt.Errorf("operation %q did not match any endpoints", opID)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	return
+}
+
+func filterSpecTags(all map[eid]*endpointFacts, specTags []string) (err error) {
+	if len(specTags) == 0 {
+		return
+	}
+
+	wanted := make(map[string]bool, len(specTags))
+	for _, specTag := range specTags {
+		wanted[specTag] = false
+	}
+	for eid, e := range all {
+		tagged := false
+		for _, specTag := range e.tags {
+			if _, ok := wanted[specTag]; ok {
+				log.Println("[DBG] tag", specTag, "matched", e.line)
+				wanted[specTag] = true
+				tagged = true
+			}
+		}
+		if !tagged {
+			delete(all, eid)
+		}
+	}
+	for _, specTag := range specTags {
+		if !wanted[specTag] {
+			err = fmt.Errorf("tag %q did not match any endpoints", specTag)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	return
+}
+
+// filterWhere renders a Go template for each endpoint then evaluates
+// the result as a Starlark expression. Fields are rendered as Starlark
+// literals so that e.g. `{{.Method}} == "GET"` and `"pets" in {{.Tags}}`
+// as well as `{{eq .Method "GET"}}` work.
+func filterWhere(all map[eid]*endpointFacts, where string) (err error) {
+	var tmpl *template.Template
+	if tmpl, err = template.New("where").Option("missingkey=error").Parse(where); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	th := &starlark.Thread{Name: "where"}
+	matched := false
+	for eid, e := range all {
+		var rendered bytes.Buffer
+		if err = tmpl.Execute(&rendered, e.templateData()); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		var keep bool
+		if keep, err = evalWhere(th, rendered.String()); err != nil {
+			err = fmt.Errorf("bad --where=%q: %v", where, err)
+			log.Println("[ERR]", err)
+			return
+		}
+		if !keep {
+			delete(all, eid)
+			continue
+		}
+		log.Println("[DBG]", where, "matched", e.line)
+		matched = true
+	}
+	if !matched {
+		err = fmt.Errorf("%s did not match any endpoints", where)
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func evalWhere(th *starlark.Thread, expr string) (bool, error) {
+	switch expr = strings.TrimSpace(expr); expr {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+
+	v, err := starlark.Eval(th, "where", expr, nil)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(starlark.Bool)
+	if !ok {
+		return false, fmt.Errorf("expected a bool, got (%s) %s", v.Type(), v.String())
+	}
+	return bool(b), nil
+}
+
+var jsonPathLanguage = gval.Full(jsonpath.Language())
+
+// filterJSONPath evaluates a JSONPath expression against each endpoint's
+// fields, e.g. `$.Method == "GET"` or `$.Tags[?(@ == "pets")]`, and keeps
+// endpoints for which it is true or non-empty.
+func filterJSONPath(all map[eid]*endpointFacts, where string) (err error) {
+	var eval gval.Evaluable
+	if eval, err = jsonPathLanguage.NewEvaluable(where); err != nil {
+		err = fmt.Errorf("bad --where=%q: %v", where, err)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	matched := false
+	for eid, e := range all {
+		var v interface{}
+		if v, err = eval(context.Background(), e.jsonData()); err != nil {
+			err = fmt.Errorf("bad --where=%q: %v", where, err)
+			log.Println("[ERR]", err)
+			return
+		}
+
+		var keep bool
+		switch v := v.(type) {
+		case nil:
+		case bool:
+			keep = v
+		case []interface{}:
+			keep = len(v) != 0
+		default:
+			err = fmt.Errorf("bad --where=%q: expected a bool or a list, got %T %v", where, v, v)
+			log.Println("[ERR]", err)
+			return
+		}
+		if !keep {
+			delete(all, eid)
+			continue
+		}
+		log.Println("[DBG]", where, "matched", e.line)
+		matched = true
+	}
+	if !matched {
+		err = fmt.Errorf("%s did not match any endpoints", where)
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func (e *endpointFacts) jsonData() map[string]interface{} {
+	list := func(strs []string) []interface{} {
+		values := make([]interface{}, 0, len(strs))
+		for _, str := range strs {
+			values = append(values, str)
+		}
+		return values
+	}
+	return map[string]interface{}{
+		"Method":      e.method,
+		"Path":        e.path,
+		"OperationID": e.opID,
+		"Tags":        list(e.tags),
+		"Inputs":      list(e.deepIns),
+		"Outputs":     list(e.deepOuts),
+	}
+}
+
+func (e *endpointFacts) templateData() map[string]starlark.Value {
+	list := func(strs []string) *starlark.List {
+		values := make([]starlark.Value, 0, len(strs))
+		for _, str := range strs {
+			values = append(values, starlark.String(str))
+		}
+		return starlark.NewList(values)
+	}
+	return map[string]starlark.Value{
+		"Method":      starlark.String(e.method),
+		"Path":        starlark.String(e.path),
+		"OperationID": starlark.String(e.opID),
+		"Tags":        list(e.tags),
+		"Inputs":      list(e.deepIns),
+		"Outputs":     list(e.deepOuts),
+	}
+}
@@ -0,0 +1,145 @@
+package openapiv3
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/stretchr/testify/require"
+)
+
+func criteria(kvs ...interface{}) *modeler.EndpointsFilter {
+	filter := &modeler.EndpointsFilter{}
+	for i := 0; i < len(kvs); i += 2 {
+		filter.Criteria = append(filter.Criteria, modeler.EndpointsCriterion{
+			Kind:  kvs[i].(modeler.EndpointsCriterionKind),
+			Value: kvs[i+1].(string),
+		})
+	}
+	return filter
+}
+
+func TestFilterEndpoints(t *testing.T) {
+	m := &oa3{}
+	m.File = filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore.yaml")
+	err := m.Lint(context.TODO(), false)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		filter   *modeler.EndpointsFilter
+		selected int
+		fails    bool
+	}{
+		"no filter":              {criteria(), 3, false},
+		"only":                   {criteria(modeler.Only, "/pets/"), 1, false},
+		"except":                 {criteria(modeler.Except, "POST"), 2, false},
+		"nested output":          {criteria(modeler.CallsWithOutput, `\bPet\b`), 2, false},
+		"without nested output":  {criteria(modeler.CallsWithoutOutput, `\bPet\b`), 1, false},
+		"operation":              {criteria(modeler.Operation, "showPetById"), 1, false},
+		"operations":             {criteria(modeler.Operation, "showPetById", modeler.Operation, "listPets"), 2, false},
+		"unknown operation":      {criteria(modeler.Operation, "showPetById", modeler.Operation, "nope"), 0, true},
+		"spec tag":               {criteria(modeler.SpecTag, "pets"), 3, false},
+		"unknown spec tag":       {criteria(modeler.SpecTag, "cats"), 0, true},
+		"where":                  {criteria(modeler.Where, `{{.Method}} == "GET"`), 2, false},
+		"where template funcs":   {criteria(modeler.Where, `{{eq .OperationID "createPets"}}`), 1, false},
+		"where starlark":         {criteria(modeler.Where, `"Pets" in {{.Outputs}} or {{.Path}}.endswith("}")`), 2, false},
+		"where not a bool":       {criteria(modeler.Where, `{{.Path}}`), 0, true},
+		"where unknown field":    {criteria(modeler.Where, `{{.Nope}}`), 0, true},
+		"where matching nothing": {criteria(modeler.Where, `{{.Method}} == "PATCH"`), 0, true},
+		"where jsonpath":         {criteria(modeler.Where, `$.Method == "GET"`), 2, false},
+		"where jsonpath in":      {criteria(modeler.Where, `"Pets" in $.Outputs && $.OperationID != "listPets"`), 1, false},
+		"where jsonpath filter":  {criteria(modeler.Where, `$.Tags[?(@ == "pets")]`), 3, false},
+		"where jsonpath empty":   {criteria(modeler.Where, `$.Inputs[?(@ == "Nope")]`), 0, true},
+		"where jsonpath string":  {criteria(modeler.Where, `$.Path`), 0, true},
+		"where jsonpath unknown": {criteria(modeler.Where, `$.Nope`), 0, true},
+		"where jsonpath syntax":  {criteria(modeler.Where, `$.Method ==`), 0, true},
+		"everything": {criteria(
+			modeler.Only, "/pets",
+			modeler.SpecTag, "pets",
+			modeler.Operation, "listPets",
+			modeler.Operation, "createPets",
+			modeler.Where, `{{.Method}} != "POST"`,
+		), 1, false},
+		"operations then except": {criteria(
+			modeler.Operation, "listPets",
+			modeler.Operation, "showPetById",
+			modeler.Except, "/pets/",
+		), 1, false},
+		"except then operations": {criteria(
+			modeler.Except, "/pets/",
+			modeler.Operation, "listPets",
+			modeler.Operation, "showPetById",
+		), 0, true},
+		"separate operations narrow down": {criteria(
+			modeler.Operation, "listPets",
+			modeler.Where, "true",
+			modeler.Operation, "showPetById",
+		), 0, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var shown []string
+			showf := func(format string, s ...interface{}) { shown = append(shown, fmt.Sprintf(format, s...)) }
+			eids, err := m.FilterEndpoints(tc.filter, showf)
+			if tc.fails {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, eids, tc.selected)
+			require.Len(t, shown, tc.selected)
+		})
+	}
+}
+
+func TestFilterEndpointsThroughAdditionalProperties(t *testing.T) {
+	spec := filepath.Join(t.TempDir(), "openapi.yaml")
+	err := ioutil.WriteFile(spec, []byte(`
+openapi: 3.0.0
+info:
+  title: Stock
+  version: 1.0.0
+paths:
+  /stock:
+    get:
+      responses:
+        '200':
+          description: Items by name
+          content:
+            application/json:
+              schema:
+                type: object
+                additionalProperties:
+                  $ref: '#/components/schemas/Item'
+  /count:
+    get:
+      responses:
+        '200':
+          description: Items count
+          content:
+            application/json:
+              schema:
+                type: integer
+components:
+  schemas:
+    Item:
+      type: object
+      properties:
+        price:
+          $ref: '#/components/schemas/Price'
+    Price:
+      type: number
+`), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{}
+	m.File = spec
+	err = m.Lint(context.TODO(), false)
+	require.NoError(t, err)
+
+	eids, err := m.FilterEndpoints(criteria(modeler.CallsWithOutput, `\bPrice\b`), func(string, ...interface{}) {})
+	require.NoError(t, err)
+	require.Len(t, eids, 1)
+}
@@ -88,6 +88,8 @@ func (vald *validator) endpointsFromOA3(basePath string, docPaths openapi3.Paths
 						PathPartials: pathFromOA3(basePath, path),
 						Inputs:       inputs,
 						Outputs:      outputs,
+						OperationId:  docOp.OperationID,
+						Tags:         docOp.Tags,
 					},
 				},
 			}
@@ -172,7 +174,12 @@ func (vald *validator) outputsFromOA3(docResponses openapi3.Responses) (
 		}
 		//FIXME: handle .Ref
 		for mime, ct := range responseRef.Value.Content {
-			if mime == mimeJSON {
+			// NOTE: schemas of streamed responses describe each event
+			isStream := streamKindOf(mime) != streamNone
+			if _, ok := outputs[xxx]; ok && isStream {
+				continue
+			}
+			if mime == mimeJSON || isStream {
 				docSchema := ct.Schema
 				if docSchema == nil {
 					outputs[xxx] = 0
@@ -296,9 +303,12 @@ func (vald *validator) schemaFromOA3(s *openapi3.Schema) (schema schemaJSON) {
 		}
 		schema["properties"] = properties
 	}
-	//FIXME: "additionalProperties"
+	// "additionalProperties"
 	if sAddProps := s.AdditionalPropertiesAllowed; sAddProps != nil {
-		schema["additionalProperties"] = sAddProps
+		schema["additionalProperties"] = *sAddProps
+	}
+	if sAddProps := s.AdditionalProperties; sAddProps != nil {
+		schema["additionalProperties"] = vald.schemaOrRefFromOA3(sAddProps)
 	}
 
 	// "allOf"
@@ -135,6 +135,8 @@ func (sm schemap) endpointsToOA3(doc *openapi3.T, es map[eid]*fm.Endpoint) {
 		reqBody := sm.inputBodyToOA3(inputs)
 		params := sm.inputsToOA3(inputs)
 		op := &openapi3.Operation{
+			OperationID: endpoint.GetOperationId(),
+			Tags:        endpoint.GetTags(),
 			RequestBody: reqBody,
 			Parameters:  params,
 			Responses:   sm.outputsToOA3(endpoint.GetOutputs()),
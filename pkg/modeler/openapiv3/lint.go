@@ -54,10 +54,25 @@ func (m *oa3) Lint(ctx context.Context, showSpec bool) (err error) {
 		return
 	}
 
+	if err = m.lintConfig(); err != nil {
+		return
+	}
+
 	log.Println("[NFO] model is valid")
 	return
 }
 
+func (m *oa3) lintConfig() (err error) {
+	log.Println("[NFO] checking overrides")
+	if err = m.lintOverrides(); err != nil {
+		return
+	}
+
+	log.Println("[NFO] checking TLS configuration")
+	err = m.lintTLS()
+	return
+}
+
 func validateAndPretty(docPath string, blob []byte, showSpec bool) (err error) {
 	log.Println("[NFO] parsing whole spec")
 	doc, err := openapi_v3.ParseDocument(blob)
@@ -1,14 +1,21 @@
 package openapiv3
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 	"github.com/gogo/protobuf/types"
 	"go.starlark.net/starlark"
+	"golang.org/x/net/http/httpproxy"
 )
 
 var _ modeler.Interface = (*oa3)(nil)
@@ -22,6 +29,14 @@ type oa3 struct {
 
 	vald *validator
 
+	proxyEnvs *httpproxy.Config
+	tlsConfig *tls.Config
+	transport *http.Transport
+	jar       http.CookieJar
+	limiter   *rateLimiter
+	signer    signer
+	files     map[string]string
+
 	tcap *tCapHTTP
 }
 
@@ -51,19 +66,137 @@ func (m *oa3) SetResetter(sr resetter.Interface) { m.resetter = sr }
 // GetResetter TODO
 func (m *oa3) GetResetter() resetter.Interface { return m.resetter }
 
+// SetProxyEnvs passes the proxying environment variables read by the runtime
+func (m *oa3) SetProxyEnvs(envs *httpproxy.Config) { m.proxyEnvs = envs }
+
+// kwargs lists the fields of OpenAPIv3(...) read by NewFromKwargs
+var kwargs = map[string]struct{}{
+	"file":                       {},
+	"host":                       {},
+	"header_authorization":       {},
+	"proxy":                      {},
+	"tls_ca_file":                {},
+	"tls_cert_file":              {},
+	"tls_key_file":               {},
+	"tls_insecure_skip_verify":   {},
+	"tls_server_name":            {},
+	"http2":                      {},
+	"max_conns_per_host":         {},
+	"max_idle_conns_per_host":    {},
+	"fresh_connections_per_test": {},
+	"request_timeout":            {},
+	"read_timeout":               {},
+	"max_response_bytes":         {},
+	"cookie_jar":                 {},
+	"stream_duration":            {},
+	"max_calls_per_second":       {},
+	"honor_retry_after":          {},
+	"max_retry_wait":             {},
+	"headers":                    {},
+	"query":                      {},
+	"overrides":                  {},
+	"signer":                     {},
+}
+
 func (m *oa3) NewFromKwargs(d starlark.StringDict) (modeler.Interface, *modeler.Error) {
 	m = &oa3{}
 	var err *modeler.Error
 
+	for key, value := range d {
+		if _, ok := kwargs[key]; !ok {
+			return nil, modeler.NewError(key, "unset (unknown field)", value.Type())
+		}
+	}
+
 	if m.File, err = slGetString(d, "file"); err != nil {
 		return nil, err
 	}
 	if m.Host, err = slGetString(d, "host"); err != nil {
 		return nil, err
 	}
+	if host := m.Host; host != "" {
+		if _, e := parseHost(host); e != nil {
+			return nil, modeler.NewError("host", "a URL such as http://localhost:3000 or unix:///run/app.sock", host)
+		}
+	}
 	if m.HeaderAuthorization, err = slGetString(d, "header_authorization"); err != nil {
 		return nil, err
 	}
+	if m.Proxy, err = slGetString(d, "proxy"); err != nil {
+		return nil, err
+	}
+	if proxy := m.Proxy; proxy != "" {
+		if u, e := url.Parse(proxy); e != nil || u.Scheme == "" || u.Host == "" {
+			return nil, modeler.NewError("proxy", "a URL such as http://127.0.0.1:3128", proxy)
+		}
+	}
+	if m.TlsCaFile, err = slGetString(d, "tls_ca_file"); err != nil {
+		return nil, err
+	}
+	if m.TlsCertFile, err = slGetString(d, "tls_cert_file"); err != nil {
+		return nil, err
+	}
+	if m.TlsKeyFile, err = slGetString(d, "tls_key_file"); err != nil {
+		return nil, err
+	}
+	if m.TlsInsecureSkipVerify, err = slGetBool(d, "tls_insecure_skip_verify"); err != nil {
+		return nil, err
+	}
+	if m.TlsServerName, err = slGetString(d, "tls_server_name"); err != nil {
+		return nil, err
+	}
+	if m.Http2, err = slGetBool(d, "http2"); err != nil {
+		return nil, err
+	}
+	if m.MaxConnsPerHost, err = slGetUint32(d, "max_conns_per_host"); err != nil {
+		return nil, err
+	}
+	if m.MaxIdleConnsPerHost, err = slGetUint32(d, "max_idle_conns_per_host"); err != nil {
+		return nil, err
+	}
+	if m.FreshConnectionsPerTest, err = slGetBool(d, "fresh_connections_per_test"); err != nil {
+		return nil, err
+	}
+	if m.RequestTimeoutNs, err = slGetDuration(d, "request_timeout"); err != nil {
+		return nil, err
+	}
+	if m.ReadTimeoutNs, err = slGetDuration(d, "read_timeout"); err != nil {
+		return nil, err
+	}
+	if m.MaxResponseBytes, err = slGetUint64(d, "max_response_bytes"); err != nil {
+		return nil, err
+	}
+	if m.CookieJar, err = slGetBool(d, "cookie_jar"); err != nil {
+		return nil, err
+	}
+	if m.StreamDurationNs, err = slGetDuration(d, "stream_duration"); err != nil {
+		return nil, err
+	}
+	if m.MaxCallsPerSecond, err = slGetUint32(d, "max_calls_per_second"); err != nil {
+		return nil, err
+	}
+	if m.HonorRetryAfter, err = slGetBool(d, "honor_retry_after"); err != nil {
+		return nil, err
+	}
+	if m.MaxRetryWaitNs, err = slGetDuration(d, "max_retry_wait"); err != nil {
+		return nil, err
+	}
+	if m.Headers, err = slGetStringDict(d, "headers"); err != nil {
+		return nil, err
+	}
+	if m.Query, err = slGetStringDict(d, "query"); err != nil {
+		return nil, err
+	}
+	if m.Overrides, err = slGetOverrides(d, "overrides"); err != nil {
+		return nil, err
+	}
+	if v, found := d["signer"]; found {
+		var ok bool
+		if m.signer, ok = signerFromValue(v); !ok {
+			return nil, modeler.NewError("signer", "hmac(...), sigv4(...) or a function", v.Type())
+		}
+		m.Signer = m.signer.String()
+	}
 
 	return m, nil
 }
@@ -83,12 +216,77 @@ func slGetString(d starlark.StringDict, field string) (str string, err *modeler.
 	return
 }
 
+func slGetBool(d starlark.StringDict, field string) (b bool, err *modeler.Error) {
+	var (
+		found bool
+		val   starlark.Value
+	)
+	if val, found = d[field]; found && val.Type() != "bool" {
+		err = modeler.NewError(field, "a bool", val.Type())
+		return
+	}
+	if found {
+		b = bool(val.(starlark.Bool))
+	}
+	return
+}
+
+func slGetUint32(d starlark.StringDict, field string) (u uint32, err *modeler.Error) {
+	var u64 uint64
+	if u64, err = slGetUint64(d, field); err != nil {
+		return
+	}
+	if u64 > math.MaxUint32 {
+		err = modeler.NewError(field, "a smaller int", strconv.FormatUint(u64, 10))
+		return
+	}
+	u = uint32(u64)
+	return
+}
+
+func slGetUint64(d starlark.StringDict, field string) (u uint64, err *modeler.Error) {
+	val, found := d[field]
+	if !found {
+		return
+	}
+	i, ok := val.(starlark.Int)
+	if !ok {
+		err = modeler.NewError(field, "a non-negative int", val.Type())
+		return
+	}
+	if u, ok = i.Uint64(); !ok {
+		err = modeler.NewError(field, "a non-negative int", i.String())
+	}
+	return
+}
+
+func slGetDuration(d starlark.StringDict, field string) (ns int64, err *modeler.Error) {
+	var str string
+	if str, err = slGetString(d, field); err != nil || str == "" {
+		return
+	}
+	const want = `a positive duration such as "1.5s" or "2m"`
+	duration, e := time.ParseDuration(str)
+	if e != nil || duration <= 0 {
+		err = modeler.NewError(field, want, str)
+		return
+	}
+	ns = duration.Nanoseconds()
+	return
+}
+
+// Files lists the local files read by the model, keyed by path.
+// Contents of private keys are redacted.
+func (m *oa3) Files() map[string]string {
+	return m.files
+}
+
 func (m *oa3) InputsCount() int {
 	return m.vald.InputsCount()
 }
 
-func (m *oa3) FilterEndpoints(args []string) ([]eid, error) {
-	return m.vald.FilterEndpoints(args)
+func (m *oa3) FilterEndpoints(filter *modeler.EndpointsFilter, showf modeler.ShowFunc) ([]eid, error) {
+	return m.vald.FilterEndpoints(filter, showf)
 }
 
 func (m *oa3) Validate(SID sid, data *types.Value) []string {
@@ -0,0 +1,184 @@
+package openapiv3
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"go.starlark.net/starlark"
+)
+
+type override = fm.Clt_Fuzz_Model_OpenAPIv3_Override
+
+func slGetStringDict(d starlark.StringDict, field string) (m map[string]string, err *modeler.Error) {
+	val, found := d[field]
+	if !found {
+		return
+	}
+	return stringDict(field, val)
+}
+
+func stringDict(field string, val starlark.Value) (m map[string]string, err *modeler.Error) {
+	const want = "a dict of strings to strings"
+	dict, ok := val.(*starlark.Dict)
+	if !ok {
+		err = modeler.NewError(field, want, val.Type())
+		return
+	}
+	m = make(map[string]string, dict.Len())
+	for _, kv := range dict.Items() {
+		key, ok := kv[0].(starlark.String)
+		if !ok {
+			err = modeler.NewError(field, want, kv[0].Type()+" key")
+			return
+		}
+		value, ok := kv[1].(starlark.String)
+		if !ok {
+			err = modeler.NewError(field, want, kv[1].Type()+" value")
+			return
+		}
+		m[key.GoString()] = value.GoString()
+	}
+	return
+}
+
+func slGetOverrides(d starlark.StringDict, field string) (overrides map[string]*override, err *modeler.Error) {
+	val, found := d[field]
+	if !found {
+		return
+	}
+	const want = `a dict such as {"listPets": {"headers": {...}, "query": {...}}}`
+	dict, ok := val.(*starlark.Dict)
+	if !ok {
+		err = modeler.NewError(field, want, val.Type())
+		return
+	}
+	overrides = make(map[string]*override, dict.Len())
+	for _, kv := range dict.Items() {
+		key, ok := kv[0].(starlark.String)
+		if !ok {
+			err = modeler.NewError(field, want, kv[0].Type()+" key")
+			return
+		}
+		pattern := key.GoString()
+		if isPathPattern(pattern) {
+			if _, e := path.Match(pattern, ""); e != nil {
+				err = modeler.NewError(field, "valid path patterns", pattern)
+				return
+			}
+		}
+		fields, ok := kv[1].(*starlark.Dict)
+		if !ok {
+			err = modeler.NewError(field, want, kv[1].Type()+" value")
+			return
+		}
+
+		o := &override{}
+		for _, fkv := range fields.Items() {
+			name, _ := fkv[0].(starlark.String)
+			subfield := fmt.Sprintf("%s[%s][%s]", field, key.String(), fkv[0].String())
+			switch name {
+			case "headers":
+				o.Headers, err = stringDict(subfield, fkv[1])
+			case "query":
+				o.Query, err = stringDict(subfield, fkv[1])
+			default:
+				err = modeler.NewError(field, `"headers" or "query" keys`, fkv[0].String())
+			}
+			if err != nil {
+				return
+			}
+		}
+		overrides[pattern] = o
+	}
+	return
+}
+
+func isPathPattern(key string) bool { return strings.HasPrefix(key, "/") }
+
+// matchingOverrides returns the overrides that apply to an endpoint:
+// path patterns first (sorted), then the operationId's.
+func (m *oa3) matchingOverrides(e *fm.EndpointJSON) (matching []*override) {
+	pathTemplate := pathToOA3(e.GetPathPartials())
+	patterns := make([]string, 0, len(m.Overrides))
+	for pattern := range m.Overrides {
+		if isPathPattern(pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, pathTemplate); ok {
+			matching = append(matching, m.Overrides[pattern])
+		}
+	}
+	if opID := e.GetOperationId(); opID != "" {
+		if o, ok := m.Overrides[opID]; ok {
+			matching = append(matching, o)
+		}
+	}
+	return
+}
+
+// customizeRequest sets configured headers and query params on r,
+// the more specific ones taking precedence.
+func (m *oa3) customizeRequest(r *http.Request, e *fm.EndpointJSON) {
+	headers := []map[string]string{m.Headers}
+	query := []map[string]string{m.Query}
+	for _, o := range m.matchingOverrides(e) {
+		headers = append(headers, o.GetHeaders())
+		query = append(query, o.GetQuery())
+	}
+
+	for _, kvs := range headers {
+		for key, value := range kvs {
+			r.Header.Set(key, value)
+		}
+	}
+
+	q := r.URL.Query()
+	modified := false
+	for _, kvs := range query {
+		for key, value := range kvs {
+			q.Set(key, value)
+			modified = true
+		}
+	}
+	if modified {
+		r.URL.RawQuery = q.Encode()
+	}
+}
+
+// lintOverrides ensures each override applies to at least one endpoint
+func (m *oa3) lintOverrides() (err error) {
+	patterns := make([]string, 0, len(m.Overrides))
+	for pattern := range m.Overrides {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		matched := false
+		for _, endpoint := range m.vald.Spec.Endpoints {
+			e := endpoint.GetJson()
+			if isPathPattern(pattern) {
+				matched, _ = path.Match(pattern, pathToOA3(e.GetPathPartials()))
+			} else {
+				matched = e.GetOperationId() == pattern
+			}
+			if matched {
+				break
+			}
+		}
+		if !matched {
+			err = fmt.Errorf("overrides[%q] does not match any operationId or path", pattern)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	return
+}
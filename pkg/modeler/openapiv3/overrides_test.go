@@ -0,0 +1,116 @@
+package openapiv3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestOverridesKwargs(t *testing.T) {
+	for expr, expected := range map[string]string{
+		`{"headers": {"X-Tenant-ID": "acme"}}`:                           "",
+		`{"headers": {"X-Tenant-ID": 42}}`:                               "(headers = ...) must be a dict of strings to strings, got: int value",
+		`{"query": ["api-version"]}`:                                     "(query = ...) must be a dict of strings to strings, got: list",
+		`{"overrides": {"listPets": {"query": {"limit": "2"}}}}`:         "",
+		`{"overrides": {"/pets/*": {"headers": {"Accept": "*/*"}}}}`:     "",
+		`{"overrides": {"/pets/[": {}}}`:                                 "(overrides = ...) must be valid path patterns, got: /pets/[",
+		`{"overrides": {"listPets": {"body": {}}}}`:                      `(overrides = ...) must be "headers" or "query" keys, got: "body"`,
+		`{"overrides": {"listPets": {"query": {"limit": 2}}}}`:           `(overrides["listPets"]["query"] = ...) must be a dict of strings to strings, got: int value`,
+		`{"overrides": {"listPets": {"headers": {"X": "y"}}, 42: None}}`: `(overrides = ...) must be a dict such as {"listPets": {"headers": {...}, "query": {...}}}, got: int key`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			kwargs, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", expr, nil)
+			require.NoError(t, err)
+			d := make(starlark.StringDict)
+			for _, kv := range kwargs.(*starlark.Dict).Items() {
+				d[string(kv[0].(starlark.String))] = kv[1]
+			}
+
+			_, merr := (&oa3{}).NewFromKwargs(d)
+			if expected == "" {
+				require.Nil(t, merr)
+				return
+			}
+			require.EqualError(t, merr, expected)
+		})
+	}
+}
+
+func TestLintOverrides(t *testing.T) {
+	m, _ := newPetstoreCaller(t, "http://localhost")
+	for pattern, ok := range map[string]bool{
+		"listPets":     true,
+		"showPetById":  true,
+		"/v1/pets":     true,
+		"/v1/pets/*":   true,
+		"/*/*":         true,
+		"deletePets":   false,
+		"/v1/pets/*/x": false,
+	} {
+		m.Overrides = map[string]*override{pattern: {}}
+		if ok {
+			require.NoError(t, m.lintOverrides(), pattern)
+		} else {
+			require.Error(t, m.lintOverrides(), pattern)
+		}
+	}
+}
+
+func TestCallerCustomizesRequests(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	m, msg := newPetstoreCaller(t, srv.URL)
+	defer m.Close()
+	msg.GetInput().GetHttpRequest().Url = "/v1/pets?limit=10"
+	m.Headers = map[string]string{
+		"X-Tenant-ID":     "acme",
+		"Accept-Language": "fr",
+	}
+	m.Query = map[string]string{
+		"api-version": "2021-01-01",
+		"limit":       "3",
+	}
+	m.Overrides = map[string]*override{
+		"/v1/pets": {
+			Headers: map[string]string{"Accept-Language": "en"},
+		},
+		"listPets": {
+			Headers: map[string]string{"Accept-Language": "de"},
+			Query:   map[string]string{"limit": "2"},
+		},
+		"/v1/pets/*": {
+			Headers: map[string]string{"X-Tenant-ID": "not me"},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	c := m.NewCaller(ctx, msg, t.Logf)
+	c.Do(ctx)
+	r := <-received
+
+	require.Equal(t, "acme", r.Header.Get("X-Tenant-ID"))
+	require.Equal(t, "de", r.Header.Get("Accept-Language"))
+	require.Equal(t, url.Values{
+		"api-version": {"2021-01-01"},
+		"limit":       {"2"},
+	}, r.URL.Query())
+
+	req := c.RequestProto().GetInput().GetHttpRequest()
+	require.Equal(t, []string{"acme"}, req.GetHeaders()["X-Tenant-Id"].GetValues())
+	require.Equal(t, []string{"de"}, req.GetHeaders()["Accept-Language"].GetValues())
+	u, err := url.Parse(req.GetUrl())
+	require.NoError(t, err)
+	require.Equal(t, r.URL.Query(), u.Query())
+}
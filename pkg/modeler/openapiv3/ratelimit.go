@@ -0,0 +1,128 @@
+package openapiv3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxRetryWait = 30 * time.Second
+	maxRetries          = 3
+)
+
+// maxRetryWait is the longest Retry-After honored
+func (m *oa3) maxRetryWait() time.Duration {
+	if d := m.MaxRetryWaitNs; d != 0 {
+		return time.Duration(d)
+	}
+	return defaultMaxRetryWait
+}
+
+// rateLimiter is shared across calls so that the SUT sees at most
+// max_calls_per_second calls.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// limiterFor returns the model's rate limiter or nil if calls are not limited.
+func (m *oa3) limiterFor() *rateLimiter {
+	if m.MaxCallsPerSecond == 0 {
+		return nil
+	}
+	if m.limiter == nil {
+		m.limiter = &rateLimiter{interval: time.Second / time.Duration(m.MaxCallsPerSecond)}
+	}
+	return m.limiter
+}
+
+// acquire blocks until a call may be sent
+func (l *rateLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleep(ctx, wait)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryAfter returns how long the server asked to wait before retrying
+func retryAfter(r *http.Response) (wait time.Duration, ok bool) {
+	if code := r.StatusCode; code != http.StatusTooManyRequests && code != http.StatusServiceUnavailable {
+		return
+	}
+	value := strings.TrimSpace(r.Header.Get(headerRetryAfter))
+	if value == "" {
+		return
+	}
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait = time.Until(date); wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return
+}
+
+// Retries describes the retries honoring Retry-After that happened during Do,
+// and why retrying stopped if the server was still throttling the call.
+func (c *tCapHTTP) Retries() ([]string, string) { return c.retries, c.throttled }
+
+// retry decides whether to retry the call after r, waiting as asked if so.
+func (c *tCapHTTP) retry(ctx context.Context, r *http.Response, attempt int) bool {
+	if !c.m.HonorRetryAfter {
+		return false
+	}
+	wait, ok := retryAfter(r)
+	if !ok {
+		return false
+	}
+
+	status := fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode))
+	switch {
+	case attempt == maxRetries:
+		c.throttled = fmt.Sprintf("%s: server kept rate limiting after %d retries", status, maxRetries)
+		return false
+	case wait > c.m.maxRetryWait():
+		c.throttled = fmt.Sprintf("%s: Retry-After of %s exceeds max_retry_wait = %s", status, wait, c.m.maxRetryWait())
+		return false
+	}
+
+	c.retries = append(c.retries, fmt.Sprintf("%s: retrying after %s", status, wait))
+	c.showf("  %s", c.retries[len(c.retries)-1])
+	if err := sleep(ctx, wait); err != nil {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package openapiv3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		code  int
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		"seconds":         {429, "2", 2 * time.Second, true},
+		"unavailable":     {503, "0", 0, true},
+		"past date":       {503, "Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+		"not throttled":   {500, "2", 0, false},
+		"no header":       {429, "", 0, false},
+		"garbage":         {429, "soon", 0, false},
+		"negative amount": {429, "-1", 0, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := &http.Response{StatusCode: tc.code, Header: http.Header{}}
+			if tc.value != "" {
+				r.Header.Set(headerRetryAfter, tc.value)
+			}
+			wait, ok := retryAfter(r)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.wait, wait)
+		})
+	}
+}
+
+func TestCallerHonorsRetryAfter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		honor      bool
+		throttles  int32
+		retryAfter string
+		code       uint32
+		calls      int32
+		retries    int
+		throttled  string
+	}{
+		"not honored":     {false, 2, "0", 429, 1, 0, ""},
+		"honored":         {true, 2, "0", 200, 3, 2, ""},
+		"too many":        {true, 10, "0", 429, maxRetries + 1, maxRetries, "429 Too Many Requests: server kept rate limiting after 3 retries"},
+		"waits too long":  {true, 1, "3600", 429, 1, 0, "429 Too Many Requests: Retry-After of 1h0m0s exceeds max_retry_wait = 30s"},
+		"not rate limits": {true, 0, "0", 200, 1, 0, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) <= tc.throttles {
+					w.Header().Set(headerRetryAfter, tc.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`[]`))
+			}))
+			defer srv.Close()
+
+			m, msg := newPetstoreCaller(t, srv.URL)
+			defer m.Close()
+			m.HonorRetryAfter = tc.honor
+
+			ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+			c := m.NewCaller(ctx, msg, t.Logf)
+			c.Do(ctx)
+			rep := c.ResponseProto().GetOutput().GetHttpResponse()
+			require.Empty(t, rep.GetError())
+			require.Equal(t, tc.code, rep.GetStatusCode())
+
+			// Sent once then retried at most max_retries times
+			require.Equal(t, tc.calls, atomic.LoadInt32(&calls))
+			retries, throttled := m.tcap.Retries()
+			require.Len(t, retries, tc.retries)
+			require.Equal(t, tc.throttled, throttled)
+		})
+	}
+}
+
+func TestCallerRateLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	m, msg := newPetstoreCaller(t, srv.URL)
+	defer m.Close()
+	m.MaxCallsPerSecond = 20
+
+	start := time.Now()
+	for range make([]struct{}, 5) {
+		doCall(t, m, msg)
+	}
+	// First call is immediate then one every 50ms
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+}
@@ -0,0 +1,430 @@
+package openapiv3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// redactedHeaderValue replaces values of headers that must not leave this machine
+const redactedHeaderValue = "<redacted>"
+
+// signer adds signature headers to requests once they are built
+type signer interface {
+	// sign signs r, whose body is given, and returns names of headers to redact
+	sign(r *http.Request, body []byte) ([]string, error)
+	// String describes the signer without revealing secrets
+	String() string
+}
+
+var (
+	_ signer         = (*hmacSigner)(nil)
+	_ signer         = (*sigv4Signer)(nil)
+	_ signer         = (*callableSigner)(nil)
+	_ starlark.Value = (*hmacSigner)(nil)
+	_ starlark.Value = (*sigv4Signer)(nil)
+)
+
+func signerFromValue(v starlark.Value) (signer, bool) {
+	switch s := v.(type) {
+	case *hmacSigner:
+		return s, true
+	case *sigv4Signer:
+		return s, true
+	case starlark.Callable:
+		return &callableSigner{fn: s}, true
+	default:
+		return nil, false
+	}
+}
+
+// signRequest signs r and returns the names of headers to redact
+func (m *oa3) signRequest(r *http.Request) (redacted []string, err error) {
+	var body []byte
+	if r.GetBody != nil {
+		var rc io.ReadCloser
+		if rc, err = r.GetBody(); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		defer rc.Close()
+		if body, err = ioutil.ReadAll(rc); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	if redacted, err = m.signer.sign(r, body); err != nil {
+		err = fmt.Errorf("signing request with %s: %v", m.signer, err)
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+// redactHeaders hides values of headers such as signatures
+func redactHeaders(headers map[string]*fm.Clt_CallRequestRaw_Input_HttpRequest_HeaderValues, redacted []string) {
+	for _, key := range redacted {
+		values, ok := headers[http.CanonicalHeaderKey(key)]
+		if !ok {
+			continue
+		}
+		for i := range values.Values {
+			values.Values[i] = redactedHeaderValue
+		}
+	}
+}
+
+type hmacSigner struct {
+	key, keyID, algorithm string
+	headers               []string
+	hash                  func() hash.Hash
+	now                   func() time.Time
+}
+
+// HMAC is a Starlark builtin creating a signer that authenticates requests
+// with an HMAC over some of their parts, as described by
+// https://tools.ietf.org/html/draft-cavage-http-signatures-12
+func HMAC(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var key, keyID starlark.String
+	algorithm := starlark.String("sha256")
+	headers := starlark.NewList([]starlark.Value{
+		starlark.String("(request-target)"),
+		starlark.String("date"),
+		starlark.String("digest"),
+	})
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"key", &key,
+		"key_id?", &keyID,
+		"headers?", &headers,
+		"algorithm?", &algorithm,
+	); err != nil {
+		return nil, err
+	}
+
+	s := &hmacSigner{
+		key:       key.GoString(),
+		keyID:     keyID.GoString(),
+		algorithm: algorithm.GoString(),
+		now:       time.Now,
+	}
+	if s.key == "" {
+		return nil, fmt.Errorf("%s: key must not be empty", b.Name())
+	}
+	switch s.algorithm {
+	case "sha256":
+		s.hash = sha256.New
+	case "sha512":
+		s.hash = sha512.New
+	default:
+		return nil, fmt.Errorf("%s: unsupported algorithm %q (expected sha256 or sha512)", b.Name(), s.algorithm)
+	}
+	var err error
+	if s.headers, err = stringList(b.Name(), "headers", headers); err != nil {
+		return nil, err
+	}
+	if len(s.headers) == 0 {
+		return nil, fmt.Errorf("%s: headers must not be empty", b.Name())
+	}
+	for i := range s.headers {
+		s.headers[i] = strings.ToLower(s.headers[i])
+	}
+	return s, nil
+}
+
+func (s *hmacSigner) Type() string          { return "hmac" }
+func (s *hmacSigner) Freeze()               {}
+func (s *hmacSigner) Truth() starlark.Bool  { return starlark.True }
+func (s *hmacSigner) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", s.Type()) }
+func (s *hmacSigner) String() string {
+	return fmt.Sprintf("hmac(key_id=%q, headers=%q, algorithm=%q)", s.keyID, s.headers, s.algorithm)
+}
+
+func (s *hmacSigner) sign(r *http.Request, body []byte) ([]string, error) {
+	lines := make([]string, 0, len(s.headers))
+	for _, name := range s.headers {
+		switch name {
+		case "(request-target)":
+			lines = append(lines, fmt.Sprintf("%s: %s %s", name, strings.ToLower(r.Method), r.URL.RequestURI()))
+			continue
+		case "host":
+			lines = append(lines, name+": "+r.URL.Host)
+			continue
+		case "date":
+			if r.Header.Get("Date") == "" {
+				r.Header.Set("Date", s.now().UTC().Format(http.TimeFormat))
+			}
+		case "digest":
+			sum := sha256.Sum256(body)
+			r.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sum[:]))
+		}
+		values := r.Header.Values(name)
+		if len(values) == 0 {
+			return nil, fmt.Errorf("cannot sign over missing header %q", name)
+		}
+		lines = append(lines, name+": "+strings.Join(values, ", "))
+	}
+
+	mac := hmac.New(s.hash, []byte(s.key))
+	mac.Write([]byte(strings.Join(lines, "\n")))
+
+	var params []string
+	if s.keyID != "" {
+		params = append(params, fmt.Sprintf("keyId=%q", s.keyID))
+	}
+	params = append(params,
+		fmt.Sprintf("algorithm=%q", "hmac-"+s.algorithm),
+		fmt.Sprintf("headers=%q", strings.Join(s.headers, " ")),
+		fmt.Sprintf("signature=%q", base64.StdEncoding.EncodeToString(mac.Sum(nil))),
+	)
+	r.Header.Set(headerAuthorization, "Signature "+strings.Join(params, ","))
+	return []string{headerAuthorization}, nil
+}
+
+type sigv4Signer struct {
+	accessKeyID, secretAccessKey, sessionToken string
+	region, service                            string
+	now                                        func() time.Time
+}
+
+// SigV4 is a Starlark builtin creating a signer implementing
+// https://docs.aws.amazon.com/general/latest/gr/signature-version-4.html
+func SigV4(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var accessKeyID, secretAccessKey, region, service, sessionToken starlark.String
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"access_key_id", &accessKeyID,
+		"secret_access_key", &secretAccessKey,
+		"region", &region,
+		"service", &service,
+		"session_token?", &sessionToken,
+	); err != nil {
+		return nil, err
+	}
+
+	s := &sigv4Signer{
+		accessKeyID:     accessKeyID.GoString(),
+		secretAccessKey: secretAccessKey.GoString(),
+		sessionToken:    sessionToken.GoString(),
+		region:          region.GoString(),
+		service:         service.GoString(),
+		now:             time.Now,
+	}
+	for _, f := range []struct{ field, value string }{
+		{"access_key_id", s.accessKeyID},
+		{"secret_access_key", s.secretAccessKey},
+		{"region", s.region},
+		{"service", s.service},
+	} {
+		if f.value == "" {
+			return nil, fmt.Errorf("%s: %s must not be empty", b.Name(), f.field)
+		}
+	}
+	return s, nil
+}
+
+func (s *sigv4Signer) Type() string          { return "sigv4" }
+func (s *sigv4Signer) Freeze()               {}
+func (s *sigv4Signer) Truth() starlark.Bool  { return starlark.True }
+func (s *sigv4Signer) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", s.Type()) }
+func (s *sigv4Signer) String() string {
+	return fmt.Sprintf("sigv4(access_key_id=%q, region=%q, service=%q)", s.accessKeyID, s.region, s.service)
+}
+
+func (s *sigv4Signer) sign(r *http.Request, body []byte) ([]string, error) {
+	const algorithm = "AWS4-HMAC-SHA256"
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := hexSHA256(body)
+
+	r.Header.Set("X-Amz-Date", amzDate)
+	if s.service == "s3" {
+		r.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	}
+	redacted := []string{headerAuthorization}
+	if token := s.sessionToken; token != "" {
+		r.Header.Set("X-Amz-Security-Token", token)
+		redacted = append(redacted, "X-Amz-Security-Token")
+	}
+
+	canonicalHeaders := map[string]string{"host": r.URL.Host}
+	for key, values := range r.Header {
+		key = strings.ToLower(key)
+		if key == "content-type" || strings.HasPrefix(key, "x-amz-") {
+			trimmed := make([]string, 0, len(values))
+			for _, value := range values {
+				trimmed = append(trimmed, strings.Join(strings.Fields(value), " "))
+			}
+			canonicalHeaders[key] = strings.Join(trimmed, ",")
+		}
+	}
+	signedHeaders := make([]string, 0, len(canonicalHeaders))
+	for key := range canonicalHeaders {
+		signedHeaders = append(signedHeaders, key)
+	}
+	sort.Strings(signedHeaders)
+	var headerLines strings.Builder
+	for _, key := range signedHeaders {
+		headerLines.WriteString(key + ":" + canonicalHeaders[key] + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		sigv4Path(r.URL),
+		sigv4Query(r.URL),
+		headerLines.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{dateStamp, s.region, s.service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		algorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := []byte("AWS4" + s.secretAccessKey)
+	for _, part := range []string{dateStamp, s.region, s.service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	r.Header.Set(headerAuthorization, fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, s.accessKeyID, scope, strings.Join(signedHeaders, ";"), signature))
+	return redacted, nil
+}
+
+func sigv4Path(u *url.URL) string {
+	path := u.Path
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = sigv4Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sigv4Query(u *url.URL) string {
+	query := u.Query()
+	pairs := make([]string, 0, len(query))
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, sigv4Escape(key)+"="+sigv4Escape(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// sigv4Escape percent-encodes all but RFC 3986 unreserved characters
+func sigv4Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// callableSigner lets a Starlark function compute headers to set on requests.
+// The function is given the request and returns a dict of header names to values,
+// all of which are redacted.
+type callableSigner struct {
+	fn starlark.Callable
+}
+
+func (s *callableSigner) String() string { return s.fn.String() }
+
+func (s *callableSigner) sign(r *http.Request, body []byte) ([]string, error) {
+	headers := starlark.NewDict(len(r.Header))
+	for key, values := range r.Header {
+		if err := headers.SetKey(starlark.String(key), starlark.String(strings.Join(values, ","))); err != nil {
+			return nil, err
+		}
+	}
+	req := &starlarkstruct.Module{
+		Name: "request",
+		Members: starlark.StringDict{
+			"method":  starlark.String(r.Method),
+			"url":     starlark.String(r.URL.String()),
+			"path":    starlark.String(r.URL.EscapedPath()),
+			"query":   starlark.String(r.URL.RawQuery),
+			"headers": headers,
+			"content": starlark.String(body),
+		},
+	}
+	req.Freeze()
+
+	th := &starlark.Thread{Name: "signer"}
+	v, err := starlark.Call(th, s.fn, starlark.Tuple{req}, nil)
+	if err != nil {
+		return nil, err
+	}
+	set, ok := v.(*starlark.Dict)
+	if !ok {
+		return nil, fmt.Errorf("%s must return a dict of headers, got (%s) %s", s.fn.Name(), v.Type(), v.String())
+	}
+
+	redacted := make([]string, 0, set.Len())
+	for _, kv := range set.Items() {
+		key, ok := kv[0].(starlark.String)
+		if !ok {
+			return nil, errors.New("header names must be strings, got " + kv[0].Type())
+		}
+		value, ok := kv[1].(starlark.String)
+		if !ok {
+			return nil, fmt.Errorf("value of header %q must be a string, got %s", key.GoString(), kv[1].Type())
+		}
+		r.Header.Set(key.GoString(), value.GoString())
+		redacted = append(redacted, key.GoString())
+	}
+	return redacted, nil
+}
+
+func stringList(fname, field string, l *starlark.List) ([]string, error) {
+	strs := make([]string, 0, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		str, ok := l.Index(i).(starlark.String)
+		if !ok {
+			return nil, fmt.Errorf("%s: %s must contain strings, got %s", fname, field, l.Index(i).Type())
+		}
+		strs = append(strs, str.GoString())
+	}
+	return strs, nil
+}
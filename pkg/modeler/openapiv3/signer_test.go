@@ -0,0 +1,159 @@
+package openapiv3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func tryExecSigner(expr string) (starlark.Value, error) {
+	th := &starlark.Thread{Name: "test"}
+	predeclared := starlark.StringDict{
+		"hmac":  starlark.NewBuiltin("hmac", HMAC),
+		"sigv4": starlark.NewBuiltin("sigv4", SigV4),
+	}
+	globals, err := starlark.ExecFile(th, "test.star", "signer = "+expr, predeclared)
+	return globals["signer"], err
+}
+
+func execSigner(t *testing.T, expr string) starlark.Value {
+	s, err := tryExecSigner(expr)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSignerBuiltins(t *testing.T) {
+	for expr, expected := range map[string]string{
+		`hmac(key = "k")`:                     `hmac(key_id="", headers=["(request-target)" "date" "digest"], algorithm="sha256")`,
+		`hmac(key = "k", headers = ["Host"])`: `hmac(key_id="", headers=["host"], algorithm="sha256")`,
+		`hmac(key = "k", algorithm = "md5")`:  "unsupported algorithm",
+		`hmac(key = "")`:                      "key must not be empty",
+		`hmac(key = "k", headers = [])`:       "headers must not be empty",
+		`hmac(key = "k", headers = [1])`:      "headers must contain strings",
+		`sigv4(access_key_id = "AKID", secret_access_key = "s", region = "r", service = "s")`: `sigv4(access_key_id="AKID", region="r", service="s")`,
+		`sigv4(access_key_id = "AKID", secret_access_key = "", region = "r", service = "s")`:  "secret_access_key must not be empty",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			s, err := tryExecSigner(expr)
+			if !strings.Contains(expected, "(") {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), expected)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, s.String())
+		})
+	}
+}
+
+func TestHMACSigner(t *testing.T) {
+	s := execSigner(t, `hmac(key = "s3cr3t", key_id = "monkey", headers = ["(request-target)", "host", "date", "digest"])`).(*hmacSigner)
+	s.now = func() time.Time { return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC) }
+
+	body := []byte(`{"a":1}`)
+	r, err := http.NewRequest("POST", "http://example.com/foo?bar=baz", nil)
+	require.NoError(t, err)
+	redacted, err := s.sign(r, body)
+	require.NoError(t, err)
+	require.Equal(t, []string{headerAuthorization}, redacted)
+
+	require.Equal(t, "Sun, 30 Aug 2015 12:36:00 GMT", r.Header.Get("Date"))
+	sum := sha256.Sum256(body)
+	digest := "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+	require.Equal(t, digest, r.Header.Get("Digest"))
+
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write([]byte(strings.Join([]string{
+		"(request-target): post /foo?bar=baz",
+		"host: example.com",
+		"date: Sun, 30 Aug 2015 12:36:00 GMT",
+		"digest: " + digest,
+	}, "\n")))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	require.Equal(t,
+		`Signature keyId="monkey",algorithm="hmac-sha256",headers="(request-target) host date digest",signature="`+signature+`"`,
+		r.Header.Get(headerAuthorization))
+
+	s = execSigner(t, `hmac(key = "s3cr3t", headers = ["x-missing"])`).(*hmacSigner)
+	_, err = s.sign(r, body)
+	require.EqualError(t, err, `cannot sign over missing header "x-missing"`)
+}
+
+func TestSigV4Signer(t *testing.T) {
+	// get-vanilla from AWS' Signature Version 4 test suite
+	s := execSigner(t, `sigv4(
+    access_key_id = "AKIDEXAMPLE",
+    secret_access_key = "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+    region = "us-east-1",
+    service = "service",
+)`).(*sigv4Signer)
+	s.now = func() time.Time { return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC) }
+
+	r, err := http.NewRequest("GET", "http://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+	redacted, err := s.sign(r, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{headerAuthorization}, redacted)
+	require.Equal(t, "20150830T123600Z", r.Header.Get("X-Amz-Date"))
+	require.Equal(t,
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		r.Header.Get(headerAuthorization))
+
+	s.sessionToken = "t0k3n"
+	redacted, err = s.sign(r, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{headerAuthorization, "X-Amz-Security-Token"}, redacted)
+	require.Contains(t, r.Header.Get(headerAuthorization), "SignedHeaders=host;x-amz-date;x-amz-security-token,")
+}
+
+func TestCallerSignsRequests(t *testing.T) {
+	received := make(chan http.Header, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	for name, tc := range map[string]struct {
+		expr     string
+		redacted []string
+	}{
+		"hmac":     {`hmac(key = "s3cr3t")`, []string{headerAuthorization}},
+		"callable": {`lambda req: {"X-Sig": req.method + " " + req.path, "X-Other": "1"}`, []string{"X-Sig", "X-Other"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, srv.URL)
+			defer m.Close()
+			var ok bool
+			m.signer, ok = signerFromValue(execSigner(t, tc.expr))
+			require.True(t, ok)
+
+			ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+			c := m.NewCaller(ctx, msg, t.Logf)
+			c.Do(ctx)
+			headers := <-received
+
+			req := c.RequestProto().GetInput().GetHttpRequest()
+			for _, key := range tc.redacted {
+				require.NotEmpty(t, headers.Get(key))
+				require.NotEqual(t, redactedHeaderValue, headers.Get(key))
+				require.Equal(t, []string{redactedHeaderValue}, req.GetHeaders()[key].GetValues())
+			}
+			require.Equal(t, []string{"monkeh"}, req.GetHeaders()[headerUserAgent].GetValues())
+		})
+	}
+
+	_, err := (&oa3{}).NewFromKwargs(starlark.StringDict{"signer": starlark.String("s3cr3t")})
+	require.EqualError(t, err, `(signer = ...) must be hmac(...), sigv4(...) or a function, got: string`)
+}
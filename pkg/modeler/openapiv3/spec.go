@@ -0,0 +1,70 @@
+package openapiv3
+
+import (
+	"log"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Spec holds the endpoints and JSON Schemas of an API that is not described
+// by an OpenAPIv3 document (e.g. a GraphQL schema or protobuf services).
+// Schemas are expressed as this package's intermediate representation:
+// "type" holds a []string, "items" and "anyOf" a []map[string]interface{},
+// "properties" a map[string]map[string]interface{}, "enum" a []interface{}...
+type Spec struct {
+	*validator
+}
+
+// SchemaRef returns a schema referencing one given to NewSpec.
+func SchemaRef(name string) map[string]interface{} {
+	return schemaJSON{"$ref": oa3ComponentsSchemas + name}
+}
+
+// SchemaFromOA3 converts a JSON Schema as decoded by kin-openapi.
+// References are kept as is.
+func SchemaFromOA3(s *openapi3.SchemaRef) map[string]interface{} {
+	return (&validator{}).schemaOrRefFromOA3(s)
+}
+
+// NewSpec seeds a spec with named schemas.
+func NewSpec(schemas map[string]map[string]interface{}) (*Spec, error) {
+	vald := newValidator(0, len(schemas))
+	if err := vald.seed(oa3ComponentsSchemas, schemas); err != nil {
+		return nil, err
+	}
+	return &Spec{vald}, nil
+}
+
+// SpecFromProto uses a spec built previously.
+func SpecFromProto(spec *fm.SpecIR) *Spec {
+	return &Spec{&validator{Spec: spec}}
+}
+
+// SID maps a schema to the spec and returns its identifier.
+func (s *Spec) SID(schema map[string]interface{}) uint32 {
+	ref, _ := schema["$ref"].(string)
+	return s.ensureMapped(ref, schema)
+}
+
+// AddEndpoint appends an endpoint whose schemas were mapped with SID.
+func (s *Spec) AddEndpoint(e *fm.EndpointJSON) {
+	EID := eid(1 + len(s.Spec.Endpoints))
+	s.Spec.Endpoints[EID] = &fm.Endpoint{
+		Endpoint: &fm.Endpoint_Json{
+			Json: e,
+		},
+	}
+}
+
+// LintSpec uses the endpoints of s in place of reading an OpenAPIv3 file
+// then checks the rest of the model's configuration.
+func (m *oa3) LintSpec(s *Spec) (err error) {
+	m.vald = s.validator
+	if err = m.lintConfig(); err != nil {
+		return
+	}
+
+	log.Println("[NFO] model is valid")
+	return
+}
@@ -0,0 +1,181 @@
+package openapiv3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	mimeSSE = "text/event-stream"
+
+	defaultStreamDuration = 10 * time.Second
+)
+
+// streamDuration is how long streamed responses are read for
+func (m *oa3) streamDuration() time.Duration {
+	if d := m.StreamDurationNs; d != 0 {
+		return time.Duration(d)
+	}
+	return defaultStreamDuration
+}
+
+type streamKind int
+
+const (
+	streamNone streamKind = iota
+	streamSSE
+	streamNDJSON
+)
+
+var mimesNDJSON = map[string]struct{}{
+	"application/x-ndjson":    {},
+	"application/ndjson":      {},
+	"application/jsonl":       {},
+	"application/x-jsonlines": {},
+	"application/stream+json": {},
+}
+
+func streamKindOf(contentType string) streamKind {
+	mediatype, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return streamNone
+	}
+	if mediatype == mimeSSE {
+		return streamSSE
+	}
+	if _, ok := mimesNDJSON[mediatype]; ok {
+		return streamNDJSON
+	}
+	return streamNone
+}
+
+func (k streamKind) String() string {
+	switch k {
+	case streamSSE:
+		return "server-sent events"
+	case streamNDJSON:
+		return "NDJSON records"
+	default:
+		return ""
+	}
+}
+
+// readStream reads from r until the stream ends or until d elapsed,
+// in which case body is closed and the stream is reported as cut.
+func readStream(body io.Closer, r io.Reader, d time.Duration) (data []byte, cut bool, err error) {
+	var timedOut int32
+	timer := time.AfterFunc(d, func() {
+		atomic.StoreInt32(&timedOut, 1)
+		body.Close()
+	})
+	data, err = ioutil.ReadAll(r)
+	timer.Stop()
+	if err != nil && atomic.LoadInt32(&timedOut) == 1 {
+		log.Printf("[NFO] stream cut after %s", d)
+		cut, err = true, nil
+	}
+	return
+}
+
+func parseStream(kind streamKind, data []byte, cut bool) []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event {
+	switch kind {
+	case streamSSE:
+		return parseSSE(data)
+	case streamNDJSON:
+		return parseNDJSON(data, cut)
+	default:
+		return nil
+	}
+}
+
+// parseSSE follows https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+// An event that was not terminated by a blank line is dropped.
+func parseSSE(data []byte) (events []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	data = bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+
+	var lastID, event string
+	var buf strings.Builder
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if !strings.HasSuffix(line, "\n") {
+			// Incomplete line: stream ended or was cut
+			break
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		if line == "" {
+			if buf.Len() != 0 {
+				events = append(events, &fm.Clt_CallResponseRaw_Output_HttpResponse_Event{
+					Id:    lastID,
+					Event: event,
+					Data:  strings.TrimSuffix(buf.String(), "\n"),
+				})
+			}
+			event = ""
+			buf.Reset()
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i != -1 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			buf.WriteString(value)
+			buf.WriteByte('\n')
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				lastID = value
+			}
+		}
+	}
+	return
+}
+
+// parseNDJSON splits data into newline-delimited records.
+// A trailing record of a cut stream is dropped as it may be incomplete.
+func parseNDJSON(data []byte, cut bool) (events []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event) {
+	lines := strings.Split(string(data), "\n")
+	if cut {
+		lines = lines[:len(lines)-1]
+	}
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		events = append(events, &fm.Clt_CallResponseRaw_Output_HttpResponse_Event{
+			Data: line,
+		})
+	}
+	return
+}
+
+// decodeEvents decodes events' data as JSON, returning decoding errors.
+func decodeEvents(events []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event) (errs []string) {
+	for i, event := range events {
+		var x types.Value
+		if err := jsonpb.UnmarshalString(event.Data, &x); err != nil {
+			errs = append(errs, fmt.Sprintf("event #%d: %v", i, err))
+			continue
+		}
+		event.DataDecoded = &x
+	}
+	return
+}
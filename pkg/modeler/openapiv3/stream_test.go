@@ -0,0 +1,131 @@
+package openapiv3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamKindOf(t *testing.T) {
+	for contentType, kind := range map[string]streamKind{
+		"text/event-stream":                streamSSE,
+		"text/event-stream; charset=utf-8": streamSSE,
+		"application/x-ndjson":             streamNDJSON,
+		"application/jsonl":                streamNDJSON,
+		"application/json":                 streamNone,
+		"":                                 streamNone,
+	} {
+		require.Equal(t, kind, streamKindOf(contentType), contentType)
+	}
+}
+
+func TestParseSSE(t *testing.T) {
+	data := ": comment\r\n" +
+		"id: 1\n" +
+		"event: created\n" +
+		"data: {\"a\":\n" +
+		"data:1}\n" +
+		"\n" +
+		"retry: 1000\n" +
+		"\n" +
+		"data\n" +
+		"\n" +
+		"data: incomplete\n"
+	events := parseSSE([]byte(data))
+	require.Len(t, events, 2)
+	require.Equal(t, "1", events[0].GetId())
+	require.Equal(t, "created", events[0].GetEvent())
+	require.Equal(t, "{\"a\":\n1}", events[0].GetData())
+	// Last event ID is carried over
+	require.Equal(t, "1", events[1].GetId())
+	require.Equal(t, "", events[1].GetEvent())
+	require.Equal(t, "", events[1].GetData())
+}
+
+func TestParseNDJSON(t *testing.T) {
+	data := []byte("{\"a\":1}\r\n\n[2]\n{\"b\"")
+	require.Len(t, parseNDJSON(data, false), 3)
+	require.Len(t, parseNDJSON(data, true), 2)
+	require.Equal(t, "[2]", parseNDJSON(data, true)[1].GetData())
+}
+
+func TestCallerReadsStreams(t *testing.T) {
+	const (
+		pets   = `[{"id":1,"name":"kitty"}]`
+		notPet = `[{"name":"kitty"}]`
+	)
+	write := func(w http.ResponseWriter, contentType string, lines ...string) {
+		w.Header().Set("Content-Type", contentType)
+		for _, line := range lines {
+			w.Write([]byte(line))
+			w.(http.Flusher).Flush()
+		}
+	}
+	servers := map[string]*httptest.Server{
+		"sse": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			write(w, mimeSSE, "id: 1\ndata: "+pets+"\n\n", "id: 2\ndata: "+pets+"\n\n")
+		})),
+		"sse forever": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			write(w, mimeSSE, "data: "+pets+"\n\n", "data: [")
+			<-r.Context().Done()
+		})),
+		"sse invalid": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			write(w, mimeSSE, "data: "+pets+"\n\n", "data: "+notPet+"\n\n")
+		})),
+		"ndjson": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			write(w, "application/x-ndjson", pets+"\n", pets+"\n", pets)
+		})),
+		"ndjson not json": httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			write(w, "application/x-ndjson", pets+"\n", "nope\n")
+		})),
+	}
+	for _, srv := range servers {
+		defer srv.Close()
+	}
+
+	for name, tc := range map[string]struct {
+		events        int
+		cut           bool
+		jsonFailure   string
+		schemaFailure string
+	}{
+		"sse":             {events: 2},
+		"sse forever":     {events: 1, cut: true},
+		"sse invalid":     {events: 2, schemaFailure: "event #1: "},
+		"ndjson":          {events: 3},
+		"ndjson not json": {events: 2, jsonFailure: "event #1: "},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, servers[name].URL)
+			defer m.Close()
+			m.StreamDurationNs = (200 * time.Millisecond).Nanoseconds()
+
+			start := time.Now()
+			rep := doCall(t, m, msg)
+			require.Less(t, int64(time.Since(start)), int64(5*time.Second))
+			require.Len(t, rep.GetEvents(), tc.events)
+			require.Equal(t, tc.cut, rep.GetStreamCut())
+			require.Nil(t, rep.GetBodyDecoded())
+
+			_, _, f := m.checkValidJSONResponse()
+			if tc.jsonFailure != "" {
+				require.Len(t, f, 1)
+				require.Contains(t, f[0], tc.jsonFailure)
+				return
+			}
+			require.Empty(t, f)
+
+			s, _, f := m.checkValidatesJSONSchema()
+			if tc.schemaFailure != "" {
+				require.NotEmpty(t, f)
+				require.Contains(t, f[0], tc.schemaFailure)
+				return
+			}
+			require.Empty(t, f)
+			require.Contains(t, s, "validate JSON Schema")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package openapiv3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"time"
+)
+
+// redactedFileContents replaces contents of files that must not leave this machine
+const redactedFileContents = "<redacted>"
+
+// lintTLS checks the TLS files and builds the client's TLS configuration
+func (m *oa3) lintTLS() (err error) {
+	m.files = make(map[string]string, 3)
+	if m.TlsCaFile == "" && m.TlsCertFile == "" && m.TlsKeyFile == "" &&
+		!m.TlsInsecureSkipVerify && m.TlsServerName == "" {
+		m.tlsConfig = nil
+		return
+	}
+
+	cfg := &tls.Config{
+		InsecureSkipVerify: m.TlsInsecureSkipVerify,
+		ServerName:         m.TlsServerName,
+	}
+	if m.TlsInsecureSkipVerify {
+		log.Println("[WRN] server certificates will not be verified")
+	}
+
+	if caFile := m.TlsCaFile; caFile != "" {
+		var pems []byte
+		if pems, err = ioutil.ReadFile(caFile); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = lintCertificates(caFile, pems); err != nil {
+			return
+		}
+
+		if cfg.RootCAs, err = x509.SystemCertPool(); err != nil {
+			log.Println("[NFO] no system certificates:", err)
+			cfg.RootCAs, err = x509.NewCertPool(), nil
+		}
+		cfg.RootCAs.AppendCertsFromPEM(pems)
+		m.files[caFile] = string(pems)
+	}
+
+	switch certFile, keyFile := m.TlsCertFile, m.TlsKeyFile; {
+	case certFile == "" && keyFile == "":
+	case certFile == "" || keyFile == "":
+		err = errors.New("tls_cert_file and tls_key_file must be set together")
+		log.Println("[ERR]", err)
+		return
+	default:
+		var pems []byte
+		if pems, err = ioutil.ReadFile(certFile); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = lintCertificates(certFile, pems); err != nil {
+			return
+		}
+
+		var cert tls.Certificate
+		if cert, err = tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			err = fmt.Errorf("bad client certificate or key: %v", err)
+			log.Println("[ERR]", err)
+			return
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+		m.files[certFile] = string(pems)
+		m.files[keyFile] = redactedFileContents
+	}
+
+	m.tlsConfig = cfg
+	return
+}
+
+// lintCertificates ensures a PEM file only holds valid certificates
+func lintCertificates(file string, pems []byte) (err error) {
+	now := time.Now()
+	count := 0
+	for rest := pems; ; {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			err = fmt.Errorf("unexpected PEM block %q in %q", block.Type, file)
+			log.Println("[ERR]", err)
+			return
+		}
+
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err != nil {
+			err = fmt.Errorf("bad certificate in %q: %v", file, err)
+			log.Println("[ERR]", err)
+			return
+		}
+		if now.After(cert.NotAfter) {
+			err = fmt.Errorf("certificate %q in %q expired on %s", cert.Subject, file, cert.NotAfter)
+			log.Println("[ERR]", err)
+			return
+		}
+		if now.Before(cert.NotBefore) {
+			err = fmt.Errorf("certificate %q in %q is not valid before %s", cert.Subject, file, cert.NotBefore)
+			log.Println("[ERR]", err)
+			return
+		}
+		count++
+	}
+
+	if count == 0 {
+		err = fmt.Errorf("no certificates found in %q", file)
+		log.Println("[ERR]", err)
+		return
+	}
+	log.Printf("[NFO] found %d certificate(s) in %q", count, file)
+	return
+}
@@ -0,0 +1,182 @@
+package openapiv3
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSelfSigned writes a PEM certificate & key pair to dir
+func writeSelfSigned(t *testing.T, dir, name string, notBefore, notAfter time.Time) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	require.NoError(t, err)
+	keyFile = filepath.Join(dir, name+".key")
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	require.NoError(t, err)
+	return
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "monkey-tls-")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestLintTLS(t *testing.T) {
+	dir := tempDir(t)
+	now := time.Now()
+	certFile, keyFile := writeSelfSigned(t, dir, "client", now.Add(-time.Hour), now.Add(time.Hour))
+	expiredFile, _ := writeSelfSigned(t, dir, "expired", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	_, otherKeyFile := writeSelfSigned(t, dir, "other", now.Add(-time.Hour), now.Add(time.Hour))
+	garbageFile := filepath.Join(dir, "garbage.pem")
+	err := ioutil.WriteFile(garbageFile, []byte("not a certificate"), 0600)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		caFile, certFile, keyFile string
+		insecure                  bool
+		err                       string
+	}{
+		"nothing":            {},
+		"insecure":           {insecure: true},
+		"CA":                 {caFile: certFile},
+		"expired CA":         {caFile: expiredFile, err: "expired on"},
+		"garbage CA":         {caFile: garbageFile, err: "no certificates found"},
+		"key as CA":          {caFile: keyFile, err: `unexpected PEM block "EC PRIVATE KEY"`},
+		"missing CA":         {caFile: filepath.Join(dir, "nope.pem"), err: "no such file"},
+		"client cert":        {certFile: certFile, keyFile: keyFile},
+		"expired cert":       {certFile: expiredFile, keyFile: keyFile, err: "expired on"},
+		"cert without key":   {certFile: certFile, err: "must be set together"},
+		"key without cert":   {keyFile: keyFile, err: "must be set together"},
+		"mismatched key":     {certFile: certFile, keyFile: otherKeyFile, err: "bad client certificate or key"},
+		"CA and client cert": {caFile: certFile, certFile: certFile, keyFile: keyFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := &oa3{}
+			m.TlsCaFile = tc.caFile
+			m.TlsCertFile = tc.certFile
+			m.TlsKeyFile = tc.keyFile
+			m.TlsInsecureSkipVerify = tc.insecure
+			err := m.lintTLS()
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			for path, contents := range m.Files() {
+				if path == tc.keyFile {
+					require.Equal(t, redactedFileContents, contents)
+				} else {
+					require.Contains(t, contents, "BEGIN CERTIFICATE")
+					require.NotContains(t, contents, "PRIVATE KEY")
+				}
+			}
+			if tc.keyFile != "" {
+				require.Contains(t, m.Files(), tc.keyFile)
+			}
+		})
+	}
+}
+
+func TestCallerTLS(t *testing.T) {
+	dir := tempDir(t)
+	now := time.Now()
+	certFile, keyFile := writeSelfSigned(t, dir, "client", now.Add(-time.Hour), now.Add(time.Hour))
+	clientCAs := x509.NewCertPool()
+	clientPEM, err := ioutil.ReadFile(certFile)
+	require.NoError(t, err)
+	require.True(t, clientCAs.AppendCertsFromPEM(clientPEM))
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	caFile := filepath.Join(dir, "server.crt")
+	err = ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600)
+	require.NoError(t, err)
+	// Server certificate is valid for example.com and 127.0.0.1
+	localhost := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	for name, tc := range map[string]struct {
+		host, caFile, certFile, keyFile, serverName string
+		insecure                                    bool
+		ok                                          bool
+	}{
+		"unknown CA":             {host: srv.URL},
+		"no client cert":         {host: srv.URL, caFile: caFile},
+		"mTLS":                   {host: srv.URL, caFile: caFile, certFile: certFile, keyFile: keyFile, ok: true},
+		"insecure":               {host: srv.URL, insecure: true, certFile: certFile, keyFile: keyFile, ok: true},
+		"wrong server name":      {host: localhost, caFile: caFile, certFile: certFile, keyFile: keyFile},
+		"superseded server name": {host: localhost, caFile: caFile, certFile: certFile, keyFile: keyFile, serverName: "example.com", ok: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, tc.host)
+			m.TlsCaFile = tc.caFile
+			m.TlsCertFile = tc.certFile
+			m.TlsKeyFile = tc.keyFile
+			m.TlsInsecureSkipVerify = tc.insecure
+			m.TlsServerName = tc.serverName
+			err := m.lintTLS()
+			require.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+			c := m.NewCaller(ctx, msg, t.Logf)
+			c.Do(ctx)
+			rep := c.ResponseProto().GetOutput().GetHttpResponse()
+			if !tc.ok {
+				require.NotEmpty(t, rep.GetError())
+				return
+			}
+			require.Empty(t, rep.GetError())
+			require.EqualValues(t, 200, rep.GetStatusCode())
+			require.NotZero(t, rep.GetTimings().GetTlsHandshakeNs())
+			conn := rep.GetConnection()
+			require.NotEmpty(t, conn.GetTls().GetVersion())
+			require.NotEmpty(t, conn.GetTls().GetCipherSuite())
+			require.Equal(t, "O=Acme Co", conn.GetTls().GetPeerSubject())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package openapiv3
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// httpTransport returns the model's HTTP transport, which is shared across
+// calls so that connections are kept alive.
+func (m *oa3) httpTransport() *http.Transport {
+	if m.transport != nil {
+		return m.transport
+	}
+
+	proxy := m.proxyFunc()
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		DualStack: true,
+	}
+	t := &http.Transport{
+		Proxy: func(req *http.Request) (u *url.URL, err error) {
+			if u, err = proxy(req.URL); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			if u != nil {
+				log.Printf("[NFO] proxying %s through %s", req.URL.Host, u.Redacted())
+			}
+			return
+		},
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     m.Http2,
+		MaxIdleConns:          100,
+		MaxConnsPerHost:       int(m.MaxConnsPerHost),
+		MaxIdleConnsPerHost:   int(m.MaxIdleConnsPerHost),
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if m.tlsConfig != nil {
+		t.TLSClientConfig = m.tlsConfig.Clone()
+	}
+	if socket := m.unixSocket(); socket != "" {
+		// Proxies are not involved when talking to a local socket
+		t.Proxy = nil
+		t.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+	}
+
+	m.transport = t
+	return t
+}
+
+// ResetCaller is called before each test
+func (m *oa3) ResetCaller() {
+	if m.FreshConnectionsPerTest && m.transport != nil {
+		log.Println("[NFO] closing kept-alive connections")
+		m.transport.CloseIdleConnections()
+	}
+	m.resetCookieJar()
+}
+
+// Close releases connections held across calls
+func (m *oa3) Close() {
+	if m.transport != nil {
+		m.transport.CloseIdleConnections()
+		m.transport = nil
+	}
+}
@@ -0,0 +1,100 @@
+package openapiv3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func doCall(t *testing.T, m *oa3, msg *fm.Srv_Call) *fm.Clt_CallResponseRaw_Output_HttpResponse {
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	c := m.NewCaller(ctx, msg, t.Logf)
+	c.Do(ctx)
+	rep := c.ResponseProto().GetOutput().GetHttpResponse()
+	require.Empty(t, rep.GetError())
+	require.EqualValues(t, 200, rep.GetStatusCode())
+	return rep
+}
+
+func TestTransportIsSharedAcrossCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	for _, fresh := range []bool{false, true} {
+		m, msg := newPetstoreCaller(t, srv.URL)
+		m.FreshConnectionsPerTest = fresh
+		defer m.Close()
+
+		m.ResetCaller()
+		rep := doCall(t, m, msg)
+		require.False(t, rep.GetConnection().GetReused())
+		require.NotZero(t, rep.GetTimings().GetConnectNs())
+
+		rep = doCall(t, m, msg)
+		require.True(t, rep.GetConnection().GetReused())
+		require.Zero(t, rep.GetTimings().GetConnectNs())
+
+		m.ResetCaller()
+		rep = doCall(t, m, msg)
+		require.Equal(t, !fresh, rep.GetConnection().GetReused())
+	}
+}
+
+func TestTransportHTTP2(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	for http2, proto := range map[bool]string{
+		false: "HTTP/1.1",
+		true:  "HTTP/2.0",
+	} {
+		m, msg := newPetstoreCaller(t, srv.URL)
+		m.TlsInsecureSkipVerify = true
+		m.Http2 = http2
+		err := m.lintTLS()
+		require.NoError(t, err)
+		defer m.Close()
+
+		rep := doCall(t, m, msg)
+		require.Equal(t, proto, rep.GetConnection().GetProtocol())
+	}
+}
+
+func TestConnectionKwargs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		kwargs starlark.StringDict
+		ok     bool
+	}{
+		"http2":                  {starlark.StringDict{"http2": starlark.True}, true},
+		"http2 not a bool":       {starlark.StringDict{"http2": starlark.MakeInt(1)}, false},
+		"max conns":              {starlark.StringDict{"max_conns_per_host": starlark.MakeInt(4)}, true},
+		"max conns negative":     {starlark.StringDict{"max_conns_per_host": starlark.MakeInt(-1)}, false},
+		"max conns not an int":   {starlark.StringDict{"max_conns_per_host": starlark.String("4")}, false},
+		"max idle conns":         {starlark.StringDict{"max_idle_conns_per_host": starlark.MakeInt(0)}, true},
+		"max idle conns too big": {starlark.StringDict{"max_idle_conns_per_host": starlark.MakeInt64(1 << 40)}, false},
+		"fresh connections":      {starlark.StringDict{"fresh_connections_per_test": starlark.False}, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&oa3{}).NewFromKwargs(tc.kwargs)
+			if tc.ok {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
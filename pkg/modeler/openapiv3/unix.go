@@ -0,0 +1,72 @@
+package openapiv3
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// unixSocketHost is the Host of requests sent over Unix domain sockets
+const unixSocketHost = "localhost"
+
+// sutHost is where calls are sent
+type sutHost struct {
+	scheme, host string
+	unixSocket   string // Path to a Unix domain socket, if any
+}
+
+// parseHost accepts scheme://host[:port] URLs as well as Unix domain sockets
+// given as unix:///path/to.sock or http+unix://%2Fpath%2Fto.sock (or https+unix).
+func parseHost(host string) (h *sutHost, err error) {
+	for _, scheme := range []string{"http", "https"} {
+		prefix := scheme + "+unix://"
+		if !strings.HasPrefix(host, prefix) {
+			continue
+		}
+		encoded := strings.TrimPrefix(host, prefix)
+		if i := strings.IndexByte(encoded, '/'); i != -1 {
+			encoded = encoded[:i]
+		}
+		var socket string
+		if socket, err = url.PathUnescape(encoded); err != nil {
+			return
+		}
+		if socket == "" {
+			err = fmt.Errorf("%s host lacks a socket path: %q", prefix, host)
+			return
+		}
+		h = &sutHost{scheme: scheme, host: unixSocketHost, unixSocket: socket}
+		return
+	}
+
+	var u *url.URL
+	if u, err = url.ParseRequestURI(host); err != nil {
+		return
+	}
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			err = fmt.Errorf("unix:// host lacks a socket path: %q", host)
+			return
+		}
+		h = &sutHost{scheme: "http", host: unixSocketHost, unixSocket: u.Path}
+	case "":
+		err = errors.New("host lacks a scheme: " + host)
+	default:
+		h = &sutHost{scheme: u.Scheme, host: u.Host}
+	}
+	return
+}
+
+// unixSocket returns the path to the SUT's Unix domain socket, if any
+func (m *oa3) unixSocket() string {
+	if m.Host == "" {
+		return ""
+	}
+	h, err := parseHost(m.Host)
+	if err != nil {
+		return ""
+	}
+	return h.unixSocket
+}
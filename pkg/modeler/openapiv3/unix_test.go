@@ -0,0 +1,77 @@
+package openapiv3
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestParseHost(t *testing.T) {
+	for host, expected := range map[string]*sutHost{
+		"http://localhost:3000":            {scheme: "http", host: "localhost:3000"},
+		"https://example.com/ignored/path": {scheme: "https", host: "example.com"},
+		"unix:///run/app.sock":             {scheme: "http", host: unixSocketHost, unixSocket: "/run/app.sock"},
+		"http+unix://%2Frun%2Fapp.sock":    {scheme: "http", host: unixSocketHost, unixSocket: "/run/app.sock"},
+		"https+unix://%2Frun%2Fapp.sock/":  {scheme: "https", host: unixSocketHost, unixSocket: "/run/app.sock"},
+		"unix://":                          nil,
+		"http+unix://":                     nil,
+		"/no/scheme":                       nil,
+	} {
+		t.Run(host, func(t *testing.T) {
+			h, err := parseHost(host)
+			if expected == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, h)
+		})
+	}
+}
+
+func TestHostKwarg(t *testing.T) {
+	_, err := (&oa3{}).NewFromKwargs(starlark.StringDict{"host": starlark.String("unix:///run/app.sock")})
+	require.Nil(t, err)
+
+	_, err = (&oa3{}).NewFromKwargs(starlark.StringDict{"host": starlark.String("unix://")})
+	require.Equal(t, modeler.NewError("host", "a URL such as http://localhost:3000 or unix:///run/app.sock", "unix://"), err)
+}
+
+func TestCallerOverUnixSocket(t *testing.T) {
+	socket := filepath.Join(tempDir(t), "sut.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, unixSocketHost, r.Host)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	srv.Listener = l
+	srv.Start()
+	defer srv.Close()
+
+	for _, host := range []string{
+		"unix://" + socket,
+		"http+unix://" + url.PathEscape(socket),
+	} {
+		t.Run(host, func(t *testing.T) {
+			m, msg := newPetstoreCaller(t, host)
+			defer m.Close()
+			// Proxies are ignored
+			m.Proxy = "http://127.0.0.1:1"
+
+			rep := doCall(t, m, msg)
+			require.Equal(t, socket, rep.GetConnection().GetRemoteIp())
+			req := m.tcap.RequestProto().GetInput().GetHttpRequest()
+			require.Equal(t, socket, req.GetUnixSocket())
+			require.Equal(t, "http://"+unixSocketHost+"/v1/pets", req.GetUrl())
+		})
+	}
+}
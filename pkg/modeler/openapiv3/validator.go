@@ -2,11 +2,9 @@ package openapiv3
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"log"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -238,7 +236,24 @@ func (vald *validator) fromGo(s schemaJSON) (schema fm.Schema_JSON) {
 			}
 		}
 	}
-	//FIXME: "additionalProperties"
+	// "additionalProperties"
+	if v, ok := s["additionalProperties"]; ok {
+		switch addProps := v.(type) {
+		case bool:
+			schema.AdditionalProperties = &fm.Schema_JSON_AdditionalProperties{
+				AddProps: &fm.Schema_JSON_AdditionalProperties_AlwaysSucceed{
+					AlwaysSucceed: addProps}}
+		case schemaJSON:
+			var ref string
+			if v, ok := addProps["$ref"]; ok {
+				ref = v.(string)
+			}
+			schema.AdditionalProperties = &fm.Schema_JSON_AdditionalProperties{
+				AddProps: &fm.Schema_JSON_AdditionalProperties_SID{
+					SID: vald.ensureMapped(ref, addProps)}}
+		}
+		schema.HasAdditionalProperties = true
+	}
 
 	// "allOf"
 	if v, ok := s["allOf"]; ok {
@@ -402,6 +417,15 @@ func (sm schemap) toGo(SID sid) (s schemaJSON) {
 		}
 		s["properties"] = props
 	}
+	// "additionalProperties"
+	if schema.GetHasAdditionalProperties() {
+		addProps := schema.GetAdditionalProperties()
+		if SID := addProps.GetSID(); SID != 0 {
+			s["additionalProperties"] = sm.toGo(SID)
+		} else {
+			s["additionalProperties"] = addProps.GetAlwaysSucceed()
+		}
+	}
 
 	// "allOf"
 	if schemaAllOf := schema.GetAllOf(); len(schemaAllOf) != 0 {
@@ -466,127 +490,6 @@ func formatToGo(format fm.Schema_JSON_Format) string {
 	}
 }
 
-func (vald *validator) FilterEndpoints(args []string) (eids []eid, err error) {
-	// TODO? filter on 2nd, 3rd, ... -level schemas
-	// instead of just first level (ref A references B & C)
-
-	// TODO: use Go templates to filter on very specific fields. See:
-	// https://github.com/kubernetes/kubernetes/blob/c0d9a0728ce5920f97fecab977be15636e57126b/staging/src/k8s.io/cli-runtime/pkg/genericclioptions/printers/jsonpath.go#L143
-	// https://github.com/kubernetes/kubernetes/blob/103813057c5ef6cc416e6fdb71515e90d98cd3a9/staging/src/k8s.io/cli-runtime/pkg/genericclioptions/printers/template.go#L85
-
-	const fmtMPIO = "%s\t%s\t%s ➜ %s"
-	total := len(vald.Spec.Endpoints)
-	all := make(map[eid]string, total)
-	for eid := range vald.Spec.Endpoints {
-		e := vald.Spec.Endpoints[eid].GetJson()
-		path := pathToOA3(e.PathPartials)
-		inputs := make([]sid, 0, len(e.Inputs))
-		for _, param := range e.Inputs {
-			inputs = append(inputs, param.SID)
-		}
-		ins := strings.Join(vald.refsFromSIDs(inputs), " | ")
-		outputs := make([]sid, 0, len(e.Outputs))
-		for _, SID := range e.Outputs {
-			outputs = append(outputs, SID)
-		}
-		outs := strings.Join(vald.refsFromSIDs(outputs), " | ")
-		all[eid] = fmt.Sprintf(fmtMPIO, e.Method, path, ins, outs)
-	}
-
-	{
-		argz := make([]string, 0, len(args))
-	outter:
-		for i := 0; i < len(args); i++ {
-			arg := args[i]
-			for _, p := range []string{"--only=", "--except=",
-				"--calls-with-input=", "--calls-without-input=",
-				"--calls-with-output=", "--calls-without-output=",
-			} {
-				l := len(p)
-				if len(arg) > l && p == arg[0:l] {
-					argz = append(argz, []string{p[0 : l-1], arg[l:]}...)
-					break outter
-				}
-			}
-			argz = append(argz, arg)
-		}
-		args = argz
-	}
-
-	for i := 0; i < len(args); i++ {
-		cmd := args[i]
-		i++
-		switch cmd {
-		case "--only":
-			err = filterEndpoints(all, true, args[i])
-		case "--except":
-			err = filterEndpoints(all, false, args[i])
-		case "--calls-with-input":
-			err = filterEndpoints(all, true, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
-		case "--calls-without-input":
-			err = filterEndpoints(all, false, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
-		case "--calls-with-output":
-			err = filterEndpoints(all, true, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
-		case "--calls-without-output":
-			err = filterEndpoints(all, false, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
-		default:
-			i--
-		}
-		if err != nil {
-			// Error printed in main
-			return
-		}
-	}
-
-	selected := uint32(len(all))
-	e := fmt.Sprintf("%d of %d endpoints selected for testing", selected, total)
-	if selected == 0 {
-		err = errors.New(e)
-		log.Println("[ERR]", err)
-		// Error printed in main
-		return
-	}
-
-	log.Println("[NFO]", e)
-	as.ColorNFO.Println(e)
-	eids = make([]eid, 0, selected)
-	for eid := range all {
-		eids = append(eids, eid)
-	}
-	sort.Slice(eids, func(i, j int) bool { return eids[i] < eids[j] })
-	for _, eid := range eids {
-		fmt.Println(all[eid])
-	}
-	return
-}
-
-func filterEndpoints(all map[eid]string, only bool, pattern string) (err error) {
-	var re *regexp.Regexp
-	if re, err = regexp.Compile(pattern); err != nil {
-		log.Println("[ERR]", err)
-		return
-	}
-
-	onlyMatched := false
-	for eid, e := range all {
-		if re.MatchString(e) {
-			log.Println("[DBG]", pattern, "matched", e)
-			onlyMatched = true
-			if !only {
-				delete(all, eid)
-			}
-		} else if only {
-			delete(all, eid)
-		}
-	}
-	if only && !onlyMatched {
-		// Fail if any `only` is not there
-		err = fmt.Errorf("%s did not match any endpoints", pattern)
-		log.Println("[ERR]", err)
-	}
-	return
-}
-
 func (vald *validator) InputsCount() int {
 	return len(vald.Refs)
 }
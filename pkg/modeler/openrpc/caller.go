@@ -0,0 +1,167 @@
+package openrpc
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/gogo/protobuf/types"
+)
+
+// Error codes defined by https://www.jsonrpc.org/specification#error_object
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+
+	codeServerErrorMin = -32099
+	codeServerErrorMax = -32000
+
+	codeReservedMin = -32768
+	codeReservedMax = -32000
+)
+
+func isReserved(code int64) bool {
+	return codeReservedMin <= code && code <= codeReservedMax
+}
+
+var _ modeler.RetryingCaller = (*caller)(nil)
+
+type namedLambda struct {
+	name   string
+	lambda modeler.CheckerFunc
+}
+
+// caller performs JSON-RPC calls over HTTP
+type caller struct {
+	modeler.RetryingCaller
+
+	rpc   *rpc
+	reqID *types.Value
+
+	checks []namedLambda
+}
+
+// RequestProto returns call input as used by the client
+func (c *caller) RequestProto() *fm.Clt_CallRequestRaw {
+	i := c.RetryingCaller.RequestProto()
+	if req := i.GetInput().GetHttpRequest(); req != nil {
+		c.reqID = req.GetBodyDecoded().GetStructValue().GetFields()["id"]
+	}
+	return i
+}
+
+// NextCallerCheck returns ("",nil) when out of checks to run.
+// Otherwise it returns named checks inherent to the caller.
+func (c *caller) NextCallerCheck() (string, modeler.CheckerFunc) {
+	if name, check := c.RetryingCaller.NextCallerCheck(); check != nil {
+		return name, check
+	}
+	if len(c.checks) == 0 {
+		return "", nil
+	}
+	var nameAndLambda namedLambda
+	nameAndLambda, c.checks = c.checks[0], c.checks[1:]
+	return nameAndLambda.name, nameAndLambda.lambda
+}
+
+// response returns the fields of the response object, if any
+func (c *caller) response() map[string]*types.Value {
+	rep := c.RetryingCaller.ResponseProto().GetOutput().GetHttpResponse()
+	return rep.GetBodyDecoded().GetStructValue().GetFields()
+}
+
+// checkID fails unless the response id is the request's.
+// As per the spec, id is null when the request could not be read.
+func (c *caller) checkID() (s, skipped string, f []string) {
+	fields := c.response()
+	if fields == nil {
+		skipped = "no JSON-RPC response object"
+		return
+	}
+	id := fields["id"]
+	if _, isNull := id.GetKind().(*types.Value_NullValue); isNull {
+		if code, ok := errorCode(fields); ok && (code == codeParseError || code == codeInvalidRequest) {
+			s = fmt.Sprintf("response id is null along with error code %d", code)
+			return
+		}
+	}
+	if !c.reqID.Equal(id) {
+		f = append(f, fmt.Sprintf("response id %s does not match request id %s",
+			valueString(id), valueString(c.reqID)))
+		return
+	}
+	s = "response id matches request id"
+	return
+}
+
+// checkErrorCode fails on codes that indicate server errors or that are not documented.
+// Params are generated from the spec so the server rejecting them is a failure too.
+func (c *caller) checkErrorCode() (s, skipped string, f []string) {
+	fields := c.response()
+	if fields == nil {
+		skipped = "no JSON-RPC response object"
+		return
+	}
+	errObj := fields["error"].GetStructValue().GetFields()
+	if errObj == nil {
+		s = "no error"
+		return
+	}
+	code, ok := errorCode(fields)
+	if !ok {
+		skipped = "error has no integer code"
+		return
+	}
+	msg := errObj["message"].GetStringValue()
+
+	switch {
+	case code == codeParseError, code == codeInvalidRequest:
+		f = append(f, fmt.Sprintf("server rejected a valid request: %d %s", code, msg))
+	case code == codeMethodNotFound:
+		f = append(f, fmt.Sprintf("method %q not found: %d %s", c.rpc.name, code, msg))
+	case code == codeInvalidParams:
+		f = append(f, fmt.Sprintf("server rejected params valid against the spec: %d %s", code, msg))
+	case code == codeInternalError:
+		f = append(f, fmt.Sprintf("internal error: %d %s", code, msg))
+	case codeServerErrorMin <= code && code <= codeServerErrorMax:
+		f = append(f, fmt.Sprintf("server error: %d %s", code, msg))
+	case isReserved(code):
+		f = append(f, fmt.Sprintf("error code %d is reserved by JSON-RPC", code))
+	default:
+		if _, ok := c.rpc.errors[code]; !ok && len(c.rpc.errors) != 0 {
+			f = append(f, fmt.Sprintf("undocumented error code %d: %s", code, msg))
+			return
+		}
+		s = fmt.Sprintf("application error: %d %s", code, msg)
+	}
+	return
+}
+
+// errorCode returns the code of the response's error object, if any
+func errorCode(fields map[string]*types.Value) (code int64, ok bool) {
+	errObj := fields["error"].GetStructValue().GetFields()
+	codeValue, ok := errObj["code"].GetKind().(*types.Value_NumberValue)
+	if !ok || codeValue.NumberValue != math.Trunc(codeValue.NumberValue) {
+		return 0, false
+	}
+	return int64(codeValue.NumberValue), true
+}
+
+func valueString(v *types.Value) string {
+	switch x := v.GetKind().(type) {
+	case nil:
+		return "(missing)"
+	case *types.Value_NullValue:
+		return "null"
+	case *types.Value_NumberValue:
+		return fmt.Sprintf("%v", x.NumberValue)
+	case *types.Value_StringValue:
+		return fmt.Sprintf("%q", x.StringValue)
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,368 @@
+package openrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+)
+
+type schemaJSON = map[string]interface{}
+type schemasJSON = map[string]schemaJSON
+
+const (
+	componentsSchemas     = "#/components/schemas/"
+	componentsDescriptors = "#/components/contentDescriptors/"
+	componentsErrors      = "#/components/errors/"
+
+	jsonrpcVersion = "2.0"
+)
+
+// document is the part of an OpenRPC 1.x document describing methods:
+// https://spec.open-rpc.org
+type document struct {
+	OpenRPC string `json:"openrpc"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Methods    []*method `json:"methods"`
+	Components struct {
+		Schemas            map[string]*openapi3.SchemaRef `json:"schemas"`
+		ContentDescriptors map[string]*contentDescriptor  `json:"contentDescriptors"`
+		Errors             map[string]*rpcError           `json:"errors"`
+	} `json:"components"`
+}
+
+type method struct {
+	Name string `json:"name"`
+	Tags []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	// ParamStructure is one of "by-name", "by-position" or "either"
+	ParamStructure string               `json:"paramStructure"`
+	Params         []*contentDescriptor `json:"params"`
+	Result         *contentDescriptor   `json:"result"`
+	Errors         []*rpcError          `json:"errors"`
+}
+
+type contentDescriptor struct {
+	Ref      string              `json:"$ref"`
+	Name     string              `json:"name"`
+	Required bool                `json:"required"`
+	Schema   *openapi3.SchemaRef `json:"schema"`
+}
+
+type rpcError struct {
+	Ref     string `json:"$ref"`
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func parseDocument(blob []byte) (doc *document, err error) {
+	// NOTE: YAML is a superset of JSON
+	var js []byte
+	if js, err = yaml.YAMLToJSON(blob); err != nil {
+		return
+	}
+	doc = &document{}
+	if err = json.Unmarshal(js, doc); err != nil {
+		return
+	}
+	if !strings.HasPrefix(doc.OpenRPC, "1.") {
+		err = fmt.Errorf("unsupported OpenRPC version %q (want 1.x.y)", doc.OpenRPC)
+	}
+	return
+}
+
+// defaultURL is the URL of the first HTTP server
+func (doc *document) defaultURL() string {
+	for _, s := range doc.Servers {
+		if u, err := url.Parse(s.URL); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			return s.URL
+		}
+	}
+	return ""
+}
+
+// schemas converts components' schemas
+func (doc *document) schemas() schemasJSON {
+	schemas := make(schemasJSON, len(doc.Components.Schemas))
+	for name, schema := range doc.Components.Schemas {
+		schemas[name] = openapiv3.SchemaFromOA3(schema)
+	}
+	return schemas
+}
+
+func (doc *document) resolveDescriptor(cd *contentDescriptor) (*contentDescriptor, error) {
+	if ref := cd.Ref; ref != "" {
+		name := strings.TrimPrefix(ref, componentsDescriptors)
+		if found, ok := doc.Components.ContentDescriptors[name]; ok && name != ref && found.Ref == "" {
+			return found, nil
+		}
+		return nil, fmt.Errorf("no such content descriptor %q", ref)
+	}
+	return cd, nil
+}
+
+func (doc *document) resolveError(e *rpcError) (*rpcError, error) {
+	if ref := e.Ref; ref != "" {
+		name := strings.TrimPrefix(ref, componentsErrors)
+		if found, ok := doc.Components.Errors[name]; ok && name != ref && found.Ref == "" {
+			return found, nil
+		}
+		return nil, fmt.Errorf("no such error %q", ref)
+	}
+	return e, nil
+}
+
+func descriptorSchema(cd *contentDescriptor) schemaJSON {
+	if cd.Schema == nil {
+		// Any JSON value
+		return schemaJSON{}
+	}
+	return openapiv3.SchemaFromOA3(cd.Schema)
+}
+
+// rpc is a linted method of a document
+type rpc struct {
+	name      string
+	tags      []string
+	params    schemaJSON
+	hasParams bool
+	result    schemaJSON
+	// errors are the application-defined error codes documented
+	errors map[int64]string
+}
+
+// rpcs lints methods then returns them in document order
+func (doc *document) rpcs() (rpcs []*rpc, err error) {
+	schemas := doc.schemas()
+	seen := make(map[string]bool, len(doc.Methods))
+	for _, m := range doc.Methods {
+		if m.Name == "" {
+			err = fmt.Errorf("method #%d has no name", 1+len(rpcs))
+			return
+		}
+		if seen[m.Name] {
+			err = fmt.Errorf("method %q is defined twice", m.Name)
+			return
+		}
+		seen[m.Name] = true
+
+		var r *rpc
+		if r, err = doc.rpc(m); err != nil {
+			err = fmt.Errorf("method %q: %v", m.Name, err)
+			return
+		}
+		for _, schema := range []schemaJSON{r.params, r.result} {
+			if err = checkRefs(schemas, schema); err != nil {
+				err = fmt.Errorf("method %q: %v", m.Name, err)
+				return
+			}
+		}
+		rpcs = append(rpcs, r)
+	}
+
+	for name, schema := range schemas {
+		if err = checkRefs(schemas, schema); err != nil {
+			err = fmt.Errorf("schema %q: %v", name, err)
+			return
+		}
+	}
+	return
+}
+
+func (doc *document) rpc(m *method) (r *rpc, err error) {
+	r = &rpc{
+		name:      m.Name,
+		hasParams: len(m.Params) != 0,
+		result:    schemaJSON{},
+		errors:    make(map[int64]string, len(m.Errors)),
+	}
+	for _, tag := range m.Tags {
+		r.tags = append(r.tags, tag.Name)
+	}
+
+	params := make([]*contentDescriptor, 0, len(m.Params))
+	for _, p := range m.Params {
+		if p, err = doc.resolveDescriptor(p); err != nil {
+			return
+		}
+		params = append(params, p)
+	}
+	switch m.ParamStructure {
+	case "", "either", "by-name":
+		r.params = paramsByName(params)
+	case "by-position":
+		r.params = paramsByPosition(params)
+	default:
+		err = fmt.Errorf("unsupported paramStructure %q", m.ParamStructure)
+		return
+	}
+
+	if m.Result != nil {
+		var result *contentDescriptor
+		if result, err = doc.resolveDescriptor(m.Result); err != nil {
+			return
+		}
+		r.result = descriptorSchema(result)
+	}
+
+	for _, e := range m.Errors {
+		if e, err = doc.resolveError(e); err != nil {
+			return
+		}
+		if isReserved(e.Code) {
+			err = fmt.Errorf("error code %d is reserved by JSON-RPC", e.Code)
+			return
+		}
+		r.errors[e.Code] = e.Message
+	}
+	return
+}
+
+func paramsByName(params []*contentDescriptor) schemaJSON {
+	properties := make(schemasJSON, len(params))
+	var required []string
+	for _, p := range params {
+		properties[p.Name] = descriptorSchema(p)
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	schema := schemaJSON{
+		"type":       []string{"object"},
+		"properties": properties,
+	}
+	if len(required) != 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func paramsByPosition(params []*contentDescriptor) schemaJSON {
+	items := make([]schemaJSON, 0, len(params))
+	// Only trailing params may be omitted
+	var minItems uint64
+	for i, p := range params {
+		items = append(items, descriptorSchema(p))
+		if p.Required {
+			minItems = uint64(1 + i)
+		}
+	}
+	return schemaJSON{
+		"type":     []string{"array"},
+		"items":    items,
+		"minItems": minItems,
+		"maxItems": uint64(len(params)),
+	}
+}
+
+// checkRefs ensures all references point to components' schemas
+func checkRefs(schemas schemasJSON, schema schemaJSON) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		if name := strings.TrimPrefix(ref, componentsSchemas); name == ref || schemas[name] == nil {
+			return fmt.Errorf("no such schema %q", ref)
+		}
+		return nil
+	}
+	for _, key := range []string{"items", "allOf", "anyOf", "oneOf"} {
+		if of, ok := schema[key].([]schemaJSON); ok {
+			for _, s := range of {
+				if err := checkRefs(schemas, s); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if properties, ok := schema["properties"].(schemasJSON); ok {
+		for _, s := range properties {
+			if err := checkRefs(schemas, s); err != nil {
+				return err
+			}
+		}
+	}
+	if not, ok := schema["not"].(schemaJSON); ok {
+		return checkRefs(schemas, not)
+	}
+	return nil
+}
+
+// requestSchema describes the POSTed request object as per
+// https://www.jsonrpc.org/specification#request_object
+func requestSchema(r *rpc) schemaJSON {
+	properties := schemasJSON{
+		"jsonrpc": {"type": []string{"string"}, "enum": []interface{}{jsonrpcVersion}},
+		"method":  {"type": []string{"string"}, "enum": []interface{}{r.name}},
+		// Notifications (requests without an id) get no response: always set one
+		"id": {
+			"type":    []string{"integer"},
+			"minimum": float64(1),
+			"maximum": float64(math.MaxInt32),
+		},
+	}
+	required := []string{"jsonrpc", "method", "id"}
+	if r.hasParams {
+		properties["params"] = r.params
+		required = append(required, "params")
+	}
+	return schemaJSON{
+		"type":       []string{"object"},
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+// responseSchema describes response objects as per
+// https://www.jsonrpc.org/specification#response_object
+func responseSchema(r *rpc) schemaJSON {
+	return schemaJSON{
+		"type": []string{"object"},
+		"properties": schemasJSON{
+			"jsonrpc": {"type": []string{"string"}, "enum": []interface{}{jsonrpcVersion}},
+			"id":      {"type": []string{"integer", "string", "null"}},
+			"result":  r.result,
+			"error": {
+				"type": []string{"object"},
+				"properties": schemasJSON{
+					"code":    {"type": []string{"integer"}},
+					"message": {"type": []string{"string"}},
+				},
+				"required": []string{"code", "message"},
+			},
+		},
+		"required": []string{"jsonrpc", "id"},
+		"oneOf": []schemaJSON{
+			{"required": []string{"result"}},
+			{"required": []string{"error"}},
+		},
+	}
+}
+
+// newSpec maps each method to an endpoint POSTing to path
+func newSpec(doc *document, rpcs []*rpc, path string) (spec *openapiv3.Spec, err error) {
+	if spec, err = openapiv3.NewSpec(doc.schemas()); err != nil {
+		return
+	}
+	for _, r := range rpcs {
+		spec.AddEndpoint(&fm.EndpointJSON{
+			Method:       fm.EndpointJSON_POST,
+			PathPartials: []*fm.PathPartial{{Pp: &fm.PathPartial_Part{Part: path}}},
+			Inputs: []*fm.ParamJSON{{
+				IsRequired: true,
+				SID:        spec.SID(requestSchema(r)),
+				Kind:       fm.ParamJSON_body,
+			}},
+			Outputs:     map[uint32]uint32{200: spec.SID(responseSchema(r))},
+			OperationId: r.name,
+			Tags:        r.tags,
+		})
+	}
+	return
+}
@@ -0,0 +1,209 @@
+package openrpc
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"go.starlark.net/starlark"
+)
+
+var _ modeler.Interface = (*orpc)(nil)
+
+type T = orpc
+
+const (
+	headerContentType = "Content-Type"
+	mimeJSON          = "application/json"
+)
+
+// orpc models JSON-RPC 2.0 APIs served over HTTP: its methods are
+// described by an OpenAPIv3 model that does the calling and validation.
+type orpc struct {
+	*openapiv3.T
+
+	file, host string
+	blob       string
+
+	rpcs map[uint32]*rpc
+}
+
+// ToProto describes the model along with its HTTP configuration for the server
+func (m *orpc) ToProto() *fm.Clt_Fuzz_Model {
+	return &fm.Clt_Fuzz_Model{
+		Model: &fm.Clt_Fuzz_Model_Openrpc{
+			Openrpc: &fm.Clt_Fuzz_Model_OpenRPC{
+				File: m.file,
+				Host: m.host,
+				Http: m.T.ToProto().GetOpenapiv3(),
+			},
+		},
+	}
+}
+
+// FromProto restores a model described by ToProto
+func (m *orpc) FromProto(p *fm.Clt_Fuzz_Model) error {
+	if mm := p.GetOpenrpc(); mm != nil {
+		m.file, m.host = mm.GetFile(), mm.GetHost()
+		m.T = &openapiv3.T{}
+		return m.T.FromProto(&fm.Clt_Fuzz_Model{
+			Model: &fm.Clt_Fuzz_Model_Openapiv3{
+				Openapiv3: mm.GetHttp(),
+			},
+		})
+	}
+	return fmt.Errorf("unexpected model type: %T", p.GetModel())
+}
+
+// NewFromKwargs accepts the HTTP kwargs of OpenAPIv3(...)
+// with `file` pointing to an OpenRPC document and `host` to the JSON-RPC endpoint.
+func (m *orpc) NewFromKwargs(d starlark.StringDict) (modeler.Interface, *modeler.Error) {
+	m = &orpc{}
+	kwargs := make(starlark.StringDict, len(d))
+	for key, value := range d {
+		switch key {
+		case "file", "host":
+			str, ok := value.(starlark.String)
+			if !ok {
+				return nil, modeler.NewError(key, "a string", value.Type())
+			}
+			if key == "file" {
+				m.file = str.GoString()
+			} else {
+				m.host = str.GoString()
+			}
+		default:
+			kwargs[key] = value
+		}
+	}
+
+	if m.file == "" {
+		return nil, modeler.NewError("file", "a path to an OpenRPC document", `""`)
+	}
+	if m.host != "" {
+		if _, err := parseEndpoint(m.host); err != nil {
+			return nil, modeler.NewError("host", "a URL such as http://localhost:8545/rpc", m.host)
+		}
+	}
+
+	mdl, err := (&openapiv3.T{}).NewFromKwargs(kwargs)
+	if err != nil {
+		return nil, err
+	}
+	m.T = mdl.(*openapiv3.T)
+	if m.T.Headers == nil {
+		m.T.Headers = make(map[string]string, 1)
+	}
+	if _, ok := m.T.Headers[headerContentType]; !ok {
+		m.T.Headers[headerContentType] = mimeJSON
+	}
+	return m, nil
+}
+
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err == nil && ((u.Scheme != "http" && u.Scheme != "https") || u.Host == "") {
+		err = fmt.Errorf("not an HTTP URL: %q", endpoint)
+	}
+	return u, err
+}
+
+// Lint parses the OpenRPC document and maps its methods into endpoints
+func (m *orpc) Lint(ctx context.Context, showSpec bool) (err error) {
+	var blob []byte
+	if blob, err = ioutil.ReadFile(m.file); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.blob = string(blob)
+
+	log.Printf("[NFO] reading document in %dB", len(blob))
+	var doc *document
+	if doc, err = parseDocument(blob); err != nil {
+		err = fmt.Errorf("%s: %v", m.file, err)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if m.host == "" {
+		if m.host = doc.defaultURL(); m.host == "" {
+			err = fmt.Errorf("%s: no http:// or https:// server found, please set host", m.file)
+			log.Println("[ERR]", err)
+			return
+		}
+		log.Println("[NFO] using server", m.host)
+	}
+	var u *url.URL
+	if u, err = parseEndpoint(m.host); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.T.Host = u.Scheme + "://" + u.Host
+	path := "/"
+	if u.Path != "" {
+		path = u.Path
+	}
+
+	log.Println("[NFO] checking methods")
+	var rpcs []*rpc
+	if rpcs, err = doc.rpcs(); err != nil {
+		err = fmt.Errorf("%s: %v", m.file, err)
+		log.Println("[ERR]", err)
+		return
+	}
+	if len(rpcs) == 0 {
+		err = fmt.Errorf("%s: no method found", m.file)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if showSpec {
+		for _, r := range rpcs {
+			fmt.Fprintf(os.Stderr, "%s: POST %s\n", r.name, path)
+		}
+	}
+
+	log.Println("[NFO] mapping methods to endpoints")
+	var spec *openapiv3.Spec
+	if spec, err = newSpec(doc, rpcs, path); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	m.rpcs = make(map[uint32]*rpc, len(rpcs))
+	for i, r := range rpcs {
+		// Endpoints were added in order, starting from 1
+		m.rpcs[uint32(1+i)] = r
+	}
+	return m.T.LintSpec(spec)
+}
+
+// Files lists the local files read by the model, keyed by path.
+func (m *orpc) Files() map[string]string {
+	files := make(map[string]string, 1+len(m.T.Files()))
+	for path, contents := range m.T.Files() {
+		files[path] = contents
+	}
+	if m.blob != "" {
+		files[m.file] = m.blob
+	}
+	return files
+}
+
+// NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
+func (m *orpc) NewCaller(ctx context.Context, msg *fm.Srv_Call, showf modeler.ShowFunc) modeler.Caller {
+	c := &caller{
+		RetryingCaller: m.T.NewCaller(ctx, msg, showf).(modeler.RetryingCaller),
+		rpc:            m.rpcs[msg.GetEID()],
+	}
+	c.checks = []namedLambda{
+		{"JSON-RPC id", c.checkID},
+		{"JSON-RPC error code", c.checkErrorCode},
+	}
+	return c
+}
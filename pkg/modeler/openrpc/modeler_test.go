@@ -0,0 +1,209 @@
+package openrpc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/modelertest"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func newPetstore(t *testing.T, host string) *orpc {
+	kwargs := starlark.StringDict{
+		"file": starlark.String(filepath.Join("testdata", "petstore.json")),
+	}
+	if host != "" {
+		kwargs["host"] = starlark.String(host)
+	}
+	return modelertest.New(t, &orpc{}, kwargs).(*orpc)
+}
+
+func TestKwargs(t *testing.T) {
+	modelertest.CheckKwargs(t, &orpc{}, map[string]string{
+		`{"file": "a.json", "host": "http://localhost:8545/rpc"}`: "",
+		`{"file": "a.json"}`:                "",
+		`{"host": "http://localhost:8545"}`: `(file = ...) must be a path to an OpenRPC document, got: ""`,
+		`{"file": 42}`:                      "(file = ...) must be a string, got: int",
+		`{"file": "a.json", "http2": True, "proxi": "http://p:3128"}`:    "(proxi = ...) must be unset (unknown field), got: string",
+		`{"file": "a.json", "host": "localhost:8545"}`:                   "(host = ...) must be a URL such as http://localhost:8545/rpc, got: localhost:8545",
+		`{"file": "a.json", "host": "http://h/rpc", "cookie_jar": "no"}`: "(cookie_jar = ...) must be a bool, got: string",
+	})
+}
+
+func jsonValue(t *testing.T, s string) *types.Value {
+	var v types.Value
+	require.NoError(t, jsonpb.UnmarshalString(s, &v))
+	return &v
+}
+
+func TestLintMapsMethodsToEndpoints(t *testing.T) {
+	m := newPetstore(t, "")
+	require.Contains(t, m.Files(), filepath.Join("testdata", "petstore.json"))
+
+	p := m.ToProto().GetOpenrpc()
+	require.Equal(t, "http://localhost:8545/rpc", p.GetHost())
+	require.Equal(t, "http://localhost:8545", p.GetHttp().GetHost())
+
+	endpoints := p.GetHttp().GetSpec().GetEndpoints()
+	require.Len(t, endpoints, 3)
+	for EID, name := range map[uint32]string{1: "get_pet", 2: "add_pet", 3: "ping"} {
+		e := endpoints[EID].GetJson()
+		require.Equal(t, name, e.GetOperationId())
+		require.Equal(t, fm.EndpointJSON_POST, e.GetMethod())
+		require.Equal(t, "/rpc", e.GetPathPartials()[0].GetPart())
+		require.Contains(t, e.GetOutputs(), uint32(200))
+	}
+	require.Equal(t, []string{"pets"}, endpoints[1].GetJson().GetTags())
+
+	getPet := endpoints[1].GetJson()
+	for js, valid := range map[string]bool{
+		`{"jsonrpc": "2.0", "method": "get_pet", "params": {"id": 42}, "id": 1}`:   true,
+		`{"jsonrpc": "2.0", "method": "get_pet", "params": {"id": 0}, "id": 1}`:    false,
+		`{"jsonrpc": "2.0", "method": "get_pet", "params": {}, "id": 1}`:           false,
+		`{"jsonrpc": "2.0", "method": "add_pet", "params": {"id": 42}, "id": 1}`:   false,
+		`{"jsonrpc": "1.0", "method": "get_pet", "params": {"id": 42}, "id": 1}`:   false,
+		`{"jsonrpc": "2.0", "method": "get_pet", "params": {"id": 42}}`:            false,
+		`{"jsonrpc": "2.0", "method": "get_pet", "params": {"id": 42}, "id": "1"}`: false,
+	} {
+		errs := m.Validate(getPet.GetInputs()[0].GetSID(), jsonValue(t, js))
+		require.Equal(t, valid, len(errs) == 0, js)
+	}
+
+	addPet := endpoints[2].GetJson()
+	for js, valid := range map[string]bool{
+		`{"jsonrpc": "2.0", "method": "add_pet", "params": ["Rex"], "id": 1}`:           true,
+		`{"jsonrpc": "2.0", "method": "add_pet", "params": ["Rex", "dog"], "id": 1}`:    true,
+		`{"jsonrpc": "2.0", "method": "add_pet", "params": [], "id": 1}`:                false,
+		`{"jsonrpc": "2.0", "method": "add_pet", "params": ["Rex", "a", "b"], "id": 1}`: false,
+	} {
+		errs := m.Validate(addPet.GetInputs()[0].GetSID(), jsonValue(t, js))
+		require.Equal(t, valid, len(errs) == 0, js)
+	}
+
+	SID := getPet.GetOutputs()[200]
+	for js, valid := range map[string]bool{
+		`{"jsonrpc": "2.0", "id": 1, "result": {"id": 42, "name": "Rex"}}`:                                      true,
+		`{"jsonrpc": "2.0", "id": 1, "error": {"code": 404, "message": "pet not found"}}`:                       true,
+		`{"jsonrpc": "2.0", "id": null, "error": {"code": -32700, "message": "parse error"}}`:                   true,
+		`{"jsonrpc": "2.0", "id": 1, "result": {"id": 42}}`:                                                     false,
+		`{"jsonrpc": "2.0", "id": 1, "error": {"code": 404}}`:                                                   false,
+		`{"jsonrpc": "2.0", "id": 1}`:                                                                           false,
+		`{"jsonrpc": "2.0", "id": 1, "result": {"id": 42, "name": "Rex"}, "error": {"code": 1, "message": ""}}`: false,
+	} {
+		errs := m.Validate(SID, jsonValue(t, js))
+		require.Equal(t, valid, len(errs) == 0, js)
+	}
+}
+
+func TestLintErrors(t *testing.T) {
+	for doc, expected := range map[string]string{
+		`{"openrpc": "2.0.0"}`:                                            `unsupported OpenRPC version "2.0.0" (want 1.x.y)`,
+		`{"openrpc": "1.2.6", "methods": []}`:                             `no method found`,
+		`{"openrpc": "1.2.6", "methods": [{"name": "a"}, {"name": "a"}]}`: `method "a" is defined twice`,
+		`{"openrpc": "1.2.6", "methods": [{"name": "a", "params": [{"$ref": "#/components/contentDescriptors/P"}]}]}`:             `method "a": no such content descriptor "#/components/contentDescriptors/P"`,
+		`{"openrpc": "1.2.6", "methods": [{"name": "a", "result": {"name": "r", "schema": {"$ref": "#/components/schemas/S"}}}]}`: `method "a": no such schema "#/components/schemas/S"`,
+		`{"openrpc": "1.2.6", "methods": [{"name": "a", "errors": [{"code": -32001, "message": "m"}]}]}`:                          `method "a": error code -32001 is reserved by JSON-RPC`,
+		`{"openrpc": "1.2.6", "methods": [{"name": "a", "paramStructure": "by-magic"}]}`:                                          `method "a": unsupported paramStructure "by-magic"`,
+	} {
+		t.Run(doc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "doc.json")
+			require.NoError(t, ioutil.WriteFile(path, []byte(doc), 0644))
+			mdl, merr := (&orpc{}).NewFromKwargs(starlark.StringDict{
+				"file": starlark.String(path),
+				"host": starlark.String("http://localhost:8545"),
+			})
+			require.Nil(t, merr)
+			err := mdl.Lint(context.Background(), false)
+			require.EqualError(t, err, path+": "+expected)
+		})
+	}
+}
+
+func TestCallerChecksResponses(t *testing.T) {
+	var response string
+	received := make(chan map[string]interface{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rpc", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		blob, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(blob, &body))
+		received <- body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	m := newPetstore(t, srv.URL+"/rpc")
+	defer m.Close()
+
+	msg := &fm.Srv_Call{
+		EID: 1,
+		Input: &fm.Srv_Call_Input{
+			Input: &fm.Srv_Call_Input_HttpRequest_{
+				HttpRequest: &fm.Srv_Call_Input_HttpRequest{
+					Method: "POST",
+					Url:    "/rpc",
+					Body:   jsonValue(t, `{"jsonrpc": "2.0", "method": "get_pet", "params": {"id": 42}, "id": 7}`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		response string
+		failed   []string
+	}{
+		"result":           {`{"jsonrpc": "2.0", "id": 7, "result": {"id": 42, "name": "Rex"}}`, nil},
+		"documented error": {`{"jsonrpc": "2.0", "id": 7, "error": {"code": 404, "message": "pet not found"}}`, nil},
+		"invalid params":   {`{"jsonrpc": "2.0", "id": 7, "error": {"code": -32602, "message": "invalid params"}}`, []string{"JSON-RPC error code"}},
+		"wrong id":         {`{"jsonrpc": "2.0", "id": 8, "result": {"id": 42, "name": "Rex"}}`, []string{"JSON-RPC id"}},
+		"null id":          {`{"jsonrpc": "2.0", "id": null, "error": {"code": -32700, "message": "parse error"}}`, []string{"JSON-RPC error code"}},
+		"invalid request":  {`{"jsonrpc": "2.0", "id": null, "error": {"code": -32600, "message": "invalid request"}}`, []string{"JSON-RPC error code"}},
+		"null id only":     {`{"jsonrpc": "2.0", "id": null, "error": {"code": -32603, "message": "oops"}}`, []string{"JSON-RPC id", "JSON-RPC error code"}},
+		"not found":        {`{"jsonrpc": "2.0", "id": 7, "error": {"code": -32601, "message": "method not found"}}`, []string{"JSON-RPC error code"}},
+		"internal":         {`{"jsonrpc": "2.0", "id": 7, "error": {"code": -32603, "message": "oops"}}`, []string{"JSON-RPC error code"}},
+		"server error":     {`{"jsonrpc": "2.0", "id": 7, "error": {"code": -32042, "message": "oops"}}`, []string{"JSON-RPC error code"}},
+		"reserved":         {`{"jsonrpc": "2.0", "id": 7, "error": {"code": -32500, "message": "oops"}}`, []string{"JSON-RPC error code"}},
+		"undocumented":     {`{"jsonrpc": "2.0", "id": 7, "error": {"code": 418, "message": "teapot"}}`, []string{"JSON-RPC error code"}},
+		"invalid result":   {`{"jsonrpc": "2.0", "id": 7, "result": {"id": 42}}`, []string{"response validates schema"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			response = tc.response
+			ctx := modelertest.Context()
+			c := m.NewCaller(ctx, msg, t.Logf)
+			require.Empty(t, c.RequestProto().GetReason())
+
+			c.Do(ctx)
+			body := <-received
+			require.Equal(t, "get_pet", body["method"])
+			require.Equal(t, float64(7), body["id"])
+
+			var failed []string
+			for {
+				checkName, lambda := c.NextCallerCheck()
+				if checkName == "" {
+					break
+				}
+				if _, _, f := lambda(); len(f) != 0 {
+					failed = append(failed, checkName)
+				}
+			}
+			require.Equal(t, tc.failed, failed)
+		})
+	}
+
+	_, ok := m.NewCaller(modelertest.Context(), msg, t.Logf).(modeler.RetryingCaller)
+	require.True(t, ok)
+}
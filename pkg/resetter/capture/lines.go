@@ -0,0 +1,57 @@
+package capture
+
+import (
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+// DefaultMaxLines bounds how many lines are kept in memory
+const DefaultMaxLines = 10000
+
+// Lines keeps the last lines output by the System Under Test, timestamped
+type Lines struct {
+	mu    sync.Mutex
+	max   int
+	lines []line
+}
+
+type line struct {
+	at     time.Time
+	source string
+	text   string
+}
+
+// NewLines returns an empty ring of at most max lines
+func NewLines(max int) *Lines {
+	return &Lines{max: max}
+}
+
+// Add records a line from source as read now
+func (ls *Lines) Add(source, text string) {
+	at := time.Now()
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.lines = append(ls.lines, line{at: at, source: source, text: text}); len(ls.lines) > ls.max {
+		// Drop the oldest fifth so that trimming is amortized
+		ls.lines = append(ls.lines[:0], ls.lines[len(ls.lines)-ls.max*4/5:]...)
+	}
+}
+
+// Window returns lines read between since and until, offset from since
+func (ls *Lines) Window(since, until time.Time) (logs []*fm.Clt_CallResponseRaw_LogLine) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	for _, l := range ls.lines {
+		if l.at.Before(since) || l.at.After(until) {
+			continue
+		}
+		logs = append(logs, &fm.Clt_CallResponseRaw_LogLine{
+			Source:   l.source,
+			Line:     l.text,
+			OffsetNs: int64(l.at.Sub(since)),
+		})
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package capture
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindow(t *testing.T) {
+	ls := NewLines(DefaultMaxLines)
+	ls.Add("STDOUT", "before")
+	time.Sleep(time.Millisecond)
+	since := time.Now()
+	ls.Add("STDOUT", "during")
+	ls.Add("STDERR", "oops")
+	until := time.Now()
+	time.Sleep(time.Millisecond)
+	ls.Add("STDOUT", "after")
+
+	logs := ls.Window(since, until)
+	require.Len(t, logs, 2)
+	require.Equal(t, "STDOUT", logs[0].GetSource())
+	require.Equal(t, "during", logs[0].GetLine())
+	require.Equal(t, "STDERR", logs[1].GetSource())
+	require.Equal(t, "oops", logs[1].GetLine())
+	require.True(t, logs[0].GetOffsetNs() >= 0)
+	require.True(t, logs[0].GetOffsetNs() <= logs[1].GetOffsetNs())
+}
+
+func TestKeepsLastLines(t *testing.T) {
+	ls := NewLines(10)
+	since := time.Now()
+	for i := 0; i < 25; i++ {
+		ls.Add("STDOUT", strconv.Itoa(i))
+	}
+	logs := ls.Window(since, time.Now())
+	require.True(t, len(logs) <= 10)
+	require.Equal(t, "24", logs[len(logs)-1].GetLine())
+	for i := 1; i < len(logs); i++ {
+		prev, _ := strconv.Atoi(logs[i-1].GetLine())
+		cur, _ := strconv.Atoi(logs[i].GetLine())
+		require.Equal(t, prev+1, cur)
+	}
+}
@@ -0,0 +1,120 @@
+package capture
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const tailPollInterval = 100 * time.Millisecond
+
+// Tail follows a log file, adding the lines appended to it.
+// Truncated or replaced (e.g. rotated) files are read from their start.
+type Tail struct {
+	path  string
+	lines *Lines
+
+	mu      sync.Mutex
+	last    os.FileInfo
+	offset  int64
+	partial []byte
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewTail returns a Tail of path not yet started
+func NewTail(path string, lines *Lines) *Tail {
+	return &Tail{path: path, lines: lines}
+}
+
+// Start skips what the file already holds then polls it in the background.
+// It does nothing if already started.
+func (t *Tail) Start() {
+	if t.stop != nil {
+		return
+	}
+	t.mu.Lock()
+	if fi, err := os.Stat(t.path); err == nil {
+		t.last, t.offset = fi, fi.Size()
+	}
+	t.mu.Unlock()
+	log.Printf("[NFO] tailing %s from offset %d", t.path, t.offset)
+
+	t.stop, t.done = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(t.done)
+		ticker := time.NewTicker(tailPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+				if err := t.Poll(); err != nil {
+					log.Println("[ERR]", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop waits for the background polling to end
+func (t *Tail) Stop() {
+	if t.stop == nil {
+		return
+	}
+	close(t.stop)
+	<-t.done
+	t.stop = nil
+}
+
+// Poll reads the lines appended since the previous Poll
+func (t *Tail) Poll() (err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f, err := os.Open(t.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Not created yet or being rotated
+			err = nil
+		}
+		return
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return
+	}
+	if t.last != nil && (!os.SameFile(t.last, fi) || fi.Size() < t.offset) {
+		log.Printf("[NFO] %s was truncated or replaced", t.path)
+		t.offset, t.partial = 0, nil
+	}
+	t.last = fi
+
+	if _, err = f.Seek(t.offset, io.SeekStart); err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	n, err := buf.ReadFrom(f)
+	if err != nil {
+		return
+	}
+	t.offset += n
+
+	t.partial = append(t.partial, buf.Bytes()...)
+	for {
+		i := bytes.IndexByte(t.partial, '\n')
+		if i < 0 {
+			break
+		}
+		t.lines.Add(t.path, string(bytes.TrimSuffix(t.partial[:i], []byte{'\r'})))
+		t.partial = t.partial[i+1:]
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package capture
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func appendTo(t *testing.T, path, data string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func lines(ls *Lines, since time.Time) (texts []string) {
+	for _, l := range ls.Window(since, time.Now()) {
+		texts = append(texts, l.GetLine())
+	}
+	return
+}
+
+func TestTail(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monkey-capture")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	path := filepath.Join(tmp, "server.log")
+	appendTo(t, path, "from a previous run\n")
+
+	ls := NewLines(DefaultMaxLines)
+	tail := NewTail(path, ls)
+	tail.Start()
+	defer tail.Stop()
+	since := time.Now()
+
+	appendTo(t, path, "GET /pets 200\nPOST /pets 5")
+	require.NoError(t, tail.Poll())
+	require.Equal(t, []string{"GET /pets 200"}, lines(ls, since))
+
+	appendTo(t, path, "00\r\n")
+	require.NoError(t, tail.Poll())
+	require.Equal(t, []string{"GET /pets 200", "POST /pets 500"}, lines(ls, since))
+
+	// Truncated
+	require.NoError(t, ioutil.WriteFile(path, []byte("a\n"), 0644))
+	require.NoError(t, tail.Poll())
+	require.Equal(t, []string{"GET /pets 200", "POST /pets 500", "a"}, lines(ls, since))
+
+	// Rotated
+	require.NoError(t, os.Rename(path, path+".1"))
+	require.NoError(t, tail.Poll())
+	appendTo(t, path, "b\nc\n")
+	require.NoError(t, tail.Poll())
+	require.Equal(t, []string{"GET /pets 200", "POST /pets 500", "a", "b", "c"}, lines(ls, since))
+}
+
+func TestTailPollsInTheBackground(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monkey-capture")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	path := filepath.Join(tmp, "server.log")
+
+	ls := NewLines(DefaultMaxLines)
+	tail := NewTail(path, ls)
+	tail.Start()
+	tail.Start()
+	since := time.Now()
+	appendTo(t, path, "created\n")
+	require.Eventually(t, func() bool { return len(lines(ls, since)) == 1 }, time.Second, 10*time.Millisecond)
+	tail.Stop()
+	tail.Stop()
+}
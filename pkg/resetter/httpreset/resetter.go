@@ -0,0 +1,202 @@
+package httpreset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"go.starlark.net/starlark"
+)
+
+const (
+	defaultMethod  = http.MethodPost
+	defaultTimeout = 10 * time.Second
+	// maxBodyBytes bounds how much of a response body is reported
+	maxBodyBytes = 64 * 1024
+)
+
+var (
+	_ resetter.Interface = (*Resetter)(nil)
+	_ starlark.Value     = (*Resetter)(nil)
+)
+
+// Resetter implements resetter.Interface by requesting an admin endpoint
+type Resetter struct {
+	fm.Clt_Fuzz_Resetter_HTTP
+
+	client *http.Client
+}
+
+// Builtin is a Starlark builtin describing a request that resets the SUT:
+// HTTPResetter(url="http://localhost:8080/__reset", expected_status=204)
+func Builtin(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		method         = starlark.String(defaultMethod)
+		u, body        starlark.String
+		headers        *starlark.Dict
+		expectedStatus starlark.Int
+		timeout        = starlark.String(defaultTimeout.String())
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"url", &u,
+		"method?", &method,
+		"headers?", &headers,
+		"body?", &body,
+		"expected_status?", &expectedStatus,
+		"timeout?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+
+	r := &Resetter{}
+	r.Method = strings.ToUpper(method.GoString())
+	r.Body = body.GoString()
+
+	r.Url = u.GoString()
+	if pu, err := url.Parse(r.Url); err != nil || (pu.Scheme != "http" && pu.Scheme != "https") || pu.Host == "" {
+		return nil, fmt.Errorf("%s: url must be a URL such as http://localhost:8080/__reset, got: %q", b.Name(), r.Url)
+	}
+
+	if headers != nil {
+		r.Headers = make(map[string]string, headers.Len())
+		for _, kv := range headers.Items() {
+			k, ok := kv[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: headers keys must be strings, got: %s", b.Name(), kv[0].Type())
+			}
+			v, ok := kv[1].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: headers values must be strings, got: %s", b.Name(), kv[1].Type())
+			}
+			r.Headers[http.CanonicalHeaderKey(k.GoString())] = v.GoString()
+		}
+	}
+
+	status, ok := expectedStatus.Int64()
+	if !ok || (status != 0 && (status < 100 || status > 599)) {
+		return nil, fmt.Errorf("%s: expected_status must be an HTTP status code, got: %s", b.Name(), expectedStatus)
+	}
+	r.ExpectedStatus = uint32(status)
+
+	d, err := time.ParseDuration(timeout.GoString())
+	if err != nil || d <= 0 {
+		return nil, fmt.Errorf("%s: timeout must be a positive duration such as \"10s\", got: %s", b.Name(), timeout.GoString())
+	}
+	r.TimeoutNs = int64(d)
+	return r, nil
+}
+
+func (r *Resetter) Type() string          { return "http_resetter" }
+func (r *Resetter) Freeze()               {}
+func (r *Resetter) Truth() starlark.Bool  { return starlark.True }
+func (r *Resetter) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", r.Type()) }
+func (r *Resetter) String() string {
+	return fmt.Sprintf("HTTPResetter(method=%q, url=%q)", r.Method, r.Url)
+}
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (r *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Http{
+			Http: &r.Clt_Fuzz_Resetter_HTTP,
+		}}
+}
+
+// Env passes envs read during startup
+func (r *Resetter) Env(read map[string]string) {}
+
+// ExecStart executes the setup phase of the System Under Test
+func (r *Resetter) ExecStart(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	log.Println("[NFO] no start phase for", r)
+	return nil
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (r *Resetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) (err error) {
+	if r.client == nil {
+		r.client = &http.Client{Timeout: time.Duration(r.TimeoutNs)}
+	}
+
+	var body io.Reader
+	if r.Body != "" {
+		body = strings.NewReader(r.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.Url, body)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if ua, ok := ctx.Value(ctxvalues.UserAgent).(string); ok {
+		req.Header.Set("User-Agent", ua)
+	}
+	keys := make([]string, 0, len(r.Headers))
+	for key := range r.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		req.Header.Set(key, r.Headers[key])
+	}
+
+	start := time.Now()
+	rep, err := r.client.Do(req)
+	if err != nil {
+		log.Println("[ERR]", err)
+		err = resetter.NewError([]string{fmt.Sprintf("%s %s: %v", r.Method, r.Url, err)})
+		return
+	}
+	defer rep.Body.Close()
+	repBody, err := ioutil.ReadAll(io.LimitReader(rep.Body, maxBodyBytes))
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	log.Printf("[NFO] %s %s: %s in %s", r.Method, r.Url, rep.Status, time.Since(start))
+
+	if r.acceptable(rep.StatusCode) {
+		return
+	}
+	want := "a 2XX status"
+	if r.ExpectedStatus != 0 {
+		want = fmt.Sprintf("status %d", r.ExpectedStatus)
+	}
+	reason := fmt.Sprintf("%s %s: got %s, expected %s", r.Method, r.Url, rep.Status, want)
+	log.Println("[ERR]", reason)
+	var bt []string
+	if len(repBody) != 0 {
+		bt = strings.Split(strings.TrimRight(string(repBody), "\n"), "\n")
+	}
+	err = resetter.NewError(append(bt, reason))
+	return
+}
+
+func (r *Resetter) acceptable(status int) bool {
+	if r.ExpectedStatus != 0 {
+		return uint32(status) == r.ExpectedStatus
+	}
+	return status >= 200 && status <= 299
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (r *Resetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	log.Println("[NFO] no stop phase for", r)
+	return nil
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (r *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) error {
+	if r.client != nil {
+		r.client.CloseIdleConnections()
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package httpreset
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/resettertest"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+var builtins = starlark.StringDict{"HTTPResetter": starlark.NewBuiltin("HTTPResetter", Builtin)}
+
+func newResetter(t *testing.T, code string) *Resetter {
+	return resettertest.New(t, builtins, code).(*Resetter)
+}
+
+func TestBuiltinKwargs(t *testing.T) {
+	resettertest.CheckKwargs(t, builtins, map[string]string{
+		`HTTPResetter(url = "http://localhost:8080/__reset")`:                                         "",
+		`HTTPResetter(url = "https://h/r", method = "delete", expected_status = 204, timeout = "1s")`: "",
+		`HTTPResetter(url = "http://h/r", headers = {"X-Admin": "yes"}, body = "{}")`:                 "",
+		`HTTPResetter()`: "HTTPResetter: missing argument for url",
+		`HTTPResetter(url = "localhost:8080/__reset")`:           `HTTPResetter: url must be a URL such as http://localhost:8080/__reset, got: "localhost:8080/__reset"`,
+		`HTTPResetter(url = "http://h/r", headers = {"A": 1})`:   "HTTPResetter: headers values must be strings, got: int",
+		`HTTPResetter(url = "http://h/r", expected_status = 42)`: "HTTPResetter: expected_status must be an HTTP status code, got: 42",
+		`HTTPResetter(url = "http://h/r", timeout = "-1s")`:      `HTTPResetter: timeout must be a positive duration such as "10s", got: -1s`,
+	})
+}
+
+func TestExecReset(t *testing.T) {
+	var status int
+	var response string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DELETE", r.Method)
+		require.Equal(t, "/__reset", r.URL.Path)
+		require.Equal(t, "yes", r.Header.Get("X-Admin"))
+		require.Equal(t, "monkeh", r.Header.Get("User-Agent"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"all": true}`, string(body))
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	r := newResetter(t, `HTTPResetter(
+    method = "DELETE",
+    url = "`+srv.URL+`/__reset",
+    headers = {"x-admin": "yes"},
+    body = '{"all": true}',
+    expected_status = 204,
+)`)
+	ctx := context.WithValue(context.Background(), ctxvalues.UserAgent, "monkeh")
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+
+	status, response = http.StatusNoContent, ""
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+
+	status, response = http.StatusOK, "reset\n"
+	err := r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	require.Equal(t, []string{
+		"reset",
+		"DELETE " + srv.URL + "/__reset: got 200 OK, expected status 204",
+	}, err.(*resetter.Error).Reason())
+}
+
+func TestExecResetAcceptsAny2XXByDefault(t *testing.T) {
+	var status int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		w.WriteHeader(status)
+		if status >= 400 {
+			w.Write([]byte("database is locked\n"))
+		}
+	}))
+	defer srv.Close()
+
+	r := newResetter(t, `HTTPResetter(url = "`+srv.URL+`/__reset")`)
+	ctx := context.Background()
+	for code, ok := range map[int]bool{200: true, 202: true, 204: true, 302: false, 500: false} {
+		status = code
+		err := r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false)
+		if ok {
+			require.NoError(t, err, code)
+			continue
+		}
+		require.IsType(t, &resetter.Error{}, err, code)
+	}
+
+	status = http.StatusServiceUnavailable
+	err := r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false)
+	require.Equal(t, []string{
+		"database is locked",
+		"POST " + srv.URL + "/__reset: got 503 Service Unavailable, expected a 2XX status",
+	}, err.(*resetter.Error).Reason())
+}
+
+func TestExecResetUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	r := newResetter(t, `HTTPResetter(url = "`+srv.URL+`/__reset", timeout = "1s")`)
+	err := r.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	require.Contains(t, err.(*resetter.Error).Reason()[0], "POST "+srv.URL+"/__reset: ")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 )
@@ -28,6 +29,12 @@ type Interface interface {
 	Terminate(context.Context, io.Writer, io.Writer) error
 }
 
+// LogsCapturer is implemented by resetters capturing the System Under Test's output
+type LogsCapturer interface {
+	// Logs returns lines output between since and until
+	Logs(since, until time.Time) []*fm.Clt_CallResponseRaw_LogLine
+}
+
 var _ error = (*Error)(nil)
 
 // Error describes a resetter error
@@ -0,0 +1,22 @@
+//+build !windows
+
+package process
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup makes the process lead a new group so its children
+// get signaled along with it
+func setProcessGroup(exe *exec.Cmd) {
+	exe.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func terminateGroup(exe *exec.Cmd) error {
+	return syscall.Kill(-exe.Process.Pid, syscall.SIGTERM)
+}
+
+func killGroup(exe *exec.Cmd) error {
+	return syscall.Kill(-exe.Process.Pid, syscall.SIGKILL)
+}
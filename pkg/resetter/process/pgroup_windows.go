@@ -0,0 +1,18 @@
+//+build windows
+
+package process
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup is a no-op: only the process itself gets signaled
+func setProcessGroup(exe *exec.Cmd) {}
+
+func terminateGroup(exe *exec.Cmd) error {
+	return exe.Process.Kill()
+}
+
+func killGroup(exe *exec.Cmd) error {
+	return exe.Process.Kill()
+}
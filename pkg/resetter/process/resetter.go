@@ -0,0 +1,583 @@
+package process
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/capture"
+	"go.starlark.net/starlark"
+)
+
+const (
+	defaultReadyTimeout = 30 * time.Second
+	readyPollInterval   = 100 * time.Millisecond
+	readyProbeTimeout   = time.Second
+	// stopTimeout is how long the process group has to exit once asked to
+	stopTimeout = 5 * time.Second
+	// tailSize is how many lines of output are kept to describe failures
+	tailSize = 50
+	// drainTimeout is how long output may keep flowing once the process exited,
+	// as long as e.g. a daemonized grandchild holds on to our pipes
+	drainTimeout = time.Second
+	// flushTimeout bounds how long reading output written so far may take
+	flushTimeout = time.Second
+	// flushPollInterval is also how long a read must block for its pipe to be deemed empty
+	flushPollInterval = 5 * time.Millisecond
+)
+
+// readyClient probes ready_http directly, whatever HTTP_PROXY says
+var readyClient = &http.Client{
+	Transport: &http.Transport{Proxy: nil},
+	Timeout:   readyProbeTimeout,
+}
+
+var (
+	_ resetter.Interface    = (*Resetter)(nil)
+	_ resetter.LogsCapturer = (*Resetter)(nil)
+	_ starlark.Value        = (*Resetter)(nil)
+)
+
+// Resetter implements resetter.Interface by supervising the System Under Test
+type Resetter struct {
+	fm.Clt_Fuzz_Resetter_Process
+
+	read map[string]string
+
+	mu      sync.Mutex
+	running *child
+	tail    []string
+
+	logs    *capture.Lines
+	logFile *capture.Tail
+}
+
+// child is a started process
+type child struct {
+	exe    *exec.Cmd
+	exited chan struct{}
+	err    error
+
+	readers []*trackingReader
+
+	mu      sync.Mutex
+	writers []*os.File // our ends of the output pipes, closed once the process exits
+}
+
+// trackingReader knows whether its reader is blocked waiting for more data
+type trackingReader struct {
+	r io.Reader
+
+	mu      sync.Mutex
+	reading bool
+	since   time.Time
+	done    bool
+}
+
+func (tr *trackingReader) Read(p []byte) (int, error) {
+	tr.mu.Lock()
+	tr.reading, tr.since = true, time.Now()
+	tr.mu.Unlock()
+	n, err := tr.r.Read(p)
+	tr.mu.Lock()
+	tr.reading = false
+	tr.mu.Unlock()
+	return n, err
+}
+
+func (tr *trackingReader) finish() {
+	tr.mu.Lock()
+	tr.done = true
+	tr.mu.Unlock()
+}
+
+// caughtUp is true once everything written before until has been read:
+// either the stream ended or a read that started after until is blocked.
+func (tr *trackingReader) caughtUp(until time.Time) bool {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return tr.done || (tr.reading && tr.since.After(until) && time.Since(tr.since) >= flushPollInterval)
+}
+
+// Builtin is a Starlark builtin describing a process to start & supervise:
+// Process(cmd=["./server", "--port=8080"], ready_tcp=":8080")
+func Builtin(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		cmd                 *starlark.List
+		env                 *starlark.Dict
+		readyHTTP, readyTCP starlark.String
+		readyTimeout        = starlark.String(defaultReadyTimeout.String())
+		restartOnReset      starlark.Bool
+		logFile             starlark.String
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"cmd", &cmd,
+		"env?", &env,
+		"ready_http?", &readyHTTP,
+		"ready_tcp?", &readyTCP,
+		"ready_timeout?", &readyTimeout,
+		"restart_on_reset?", &restartOnReset,
+		"log_file?", &logFile,
+	); err != nil {
+		return nil, err
+	}
+
+	r := &Resetter{logs: capture.NewLines(capture.DefaultMaxLines)}
+	r.RestartOnReset = bool(restartOnReset)
+	if r.LogFile = logFile.GoString(); r.LogFile != "" {
+		r.logFile = capture.NewTail(r.LogFile, r.logs)
+	}
+
+	for i := 0; i < cmd.Len(); i++ {
+		arg, ok := cmd.Index(i).(starlark.String)
+		if !ok {
+			return nil, fmt.Errorf("%s: cmd must be a list of strings, got: %s", b.Name(), cmd.Index(i).Type())
+		}
+		r.Cmd = append(r.Cmd, arg.GoString())
+	}
+	if len(r.Cmd) == 0 || r.Cmd[0] == "" {
+		return nil, fmt.Errorf("%s: cmd must not be empty", b.Name())
+	}
+
+	if env != nil {
+		r.Clt_Fuzz_Resetter_Process.Env = make(map[string]string, env.Len())
+		for _, kv := range env.Items() {
+			k, ok := kv[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: env keys must be strings, got: %s", b.Name(), kv[0].Type())
+			}
+			v, ok := kv[1].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: env values must be strings, got: %s", b.Name(), kv[1].Type())
+			}
+			r.Clt_Fuzz_Resetter_Process.Env[k.GoString()] = v.GoString()
+		}
+	}
+
+	if r.ReadyHttp = readyHTTP.GoString(); r.ReadyHttp != "" {
+		if u, err := url.Parse(r.ReadyHttp); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%s: ready_http must be a URL such as http://localhost:8080/health, got: %s", b.Name(), r.ReadyHttp)
+		}
+	}
+	if r.ReadyTcp = readyTCP.GoString(); r.ReadyTcp != "" {
+		if _, _, err := net.SplitHostPort(r.ReadyTcp); err != nil {
+			return nil, fmt.Errorf("%s: ready_tcp must be an address such as :8080, got: %s", b.Name(), r.ReadyTcp)
+		}
+	}
+	timeout, err := time.ParseDuration(readyTimeout.GoString())
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("%s: ready_timeout must be a positive duration such as \"30s\", got: %s", b.Name(), readyTimeout.GoString())
+	}
+	r.ReadyTimeoutNs = int64(timeout)
+	return r, nil
+}
+
+func (r *Resetter) Type() string          { return "process" }
+func (r *Resetter) Freeze()               {}
+func (r *Resetter) Truth() starlark.Bool  { return starlark.True }
+func (r *Resetter) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", r.Type()) }
+func (r *Resetter) String() string {
+	return fmt.Sprintf("Process(cmd=%q)", r.Cmd)
+}
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (r *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Process_{
+			Process: &r.Clt_Fuzz_Resetter_Process,
+		}}
+}
+
+// Env passes envs read during startup
+func (r *Resetter) Env(read map[string]string) {
+	r.read = read
+}
+
+// ExecStart executes the setup phase of the System Under Test
+func (r *Resetter) ExecStart(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if !only {
+		return r.start(ctx, nil, nil)
+	}
+
+	// Makes $ monkey exec start run the process in the foreground
+	if err := r.start(ctx, stdout, stderr); err != nil {
+		return err
+	}
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, os.Interrupt)
+	defer signal.Stop(sigC)
+	c := r.current()
+	select {
+	case <-c.exited:
+		return r.exitError(c)
+	case <-sigC:
+		log.Println("[NFO] received ^C: stopping process")
+		return r.stop()
+	}
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (r *Resetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if only {
+		// Nothing is running outside of tests: start & stop the process
+		if err := r.start(ctx, stdout, stderr); err != nil {
+			return err
+		}
+		return r.stop()
+	}
+
+	c := r.current()
+	switch {
+	case c == nil:
+	case c.isRunning():
+		if !r.RestartOnReset {
+			log.Println("[NFO] process still running")
+			return nil
+		}
+		log.Println("[NFO] restarting process")
+		if err := r.stop(); err != nil {
+			return err
+		}
+	default:
+		// The process exited on its own, probably crashing during the last test
+		err := r.exitError(c)
+		log.Println("[NFO] restarting process")
+		if errS := r.start(ctx, nil, nil); errS != nil {
+			log.Println("[ERR]", errS)
+		}
+		return err
+	}
+	return r.start(ctx, nil, nil)
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (r *Resetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if !r.isRunning() {
+		log.Println("[NFO] no process to stop")
+		return nil
+	}
+	return r.stop()
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (r *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) error {
+	if r.logFile != nil {
+		r.logFile.Stop()
+	}
+	return r.ExecStop(ctx, stdout, stderr, false)
+}
+
+// Logs returns lines output between since and until
+func (r *Resetter) Logs(since, until time.Time) []*fm.Clt_CallResponseRaw_LogLine {
+	if r.logs == nil {
+		return nil
+	}
+	if c := r.current(); c != nil {
+		c.flush(until)
+	}
+	if r.logFile != nil {
+		if err := r.logFile.Poll(); err != nil {
+			log.Println("[ERR]", err)
+		}
+	}
+	// Lines are timestamped when read: include those output by until
+	return r.logs.Window(since, time.Now())
+}
+
+// current is the last started process, nil once stopped
+func (r *Resetter) current() *child {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.running
+}
+
+// flush returns once output written to the pipes by until has been read
+func (c *child) flush(until time.Time) {
+	deadline := time.Now().Add(flushTimeout)
+	for _, tr := range c.readers {
+		for !tr.caughtUp(until) {
+			if time.Now().After(deadline) {
+				log.Println("[ERR] output not read after", flushTimeout)
+				return
+			}
+			time.Sleep(flushPollInterval)
+		}
+	}
+}
+
+func (r *Resetter) isRunning() bool {
+	c := r.current()
+	return c != nil && c.isRunning()
+}
+
+func (c *child) isRunning() bool {
+	select {
+	case <-c.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// environ lists the process' environment: ours, then Env(...)s read, then env
+func (r *Resetter) environ() []string {
+	environ := os.Environ()
+	for _, vars := range []map[string]string{r.read, r.Clt_Fuzz_Resetter_Process.Env} {
+		keys := make([]string, 0, len(vars))
+		for k := range vars {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			environ = append(environ, k+"="+vars[k])
+		}
+	}
+	return environ
+}
+
+// start spawns the process then waits for it to be ready.
+// Its output is logged and forwarded to stdout & stderr when they are non-nil.
+func (r *Resetter) start(ctx context.Context, stdout, stderr io.Writer) (err error) {
+	r.mu.Lock()
+	r.tail = nil
+	r.mu.Unlock()
+
+	exe := exec.Command(r.Cmd[0], r.Cmd[1:]...)
+	exe.Env = r.environ()
+	setProcessGroup(exe)
+
+	// Our own pipes so that exe.Wait() does not wait on their readers
+	var outs, ins [2]*os.File
+	for i := range outs {
+		if outs[i], ins[i], err = os.Pipe(); err != nil {
+			log.Println("[ERR]", err)
+			closeAll(outs[:i])
+			closeAll(ins[:i])
+			return
+		}
+	}
+	exe.Stdout, exe.Stderr = ins[0], ins[1]
+
+	log.Printf("[NFO] starting process %q", r.Cmd)
+	if err = exe.Start(); err != nil {
+		log.Println("[ERR]", err)
+		closeAll(ins[:])
+		closeAll(outs[:])
+		return
+	}
+	pid := exe.Process.Pid
+
+	if r.logFile != nil {
+		r.logFile.Start()
+	}
+
+	c := &child{
+		exe:     exe,
+		exited:  make(chan struct{}),
+		readers: []*trackingReader{{r: outs[0]}, {r: outs[1]}},
+		writers: ins[:],
+	}
+	r.mu.Lock()
+	r.running = c
+	r.mu.Unlock()
+	streamed := make(chan struct{})
+	var streams sync.WaitGroup
+	streams.Add(2)
+	go r.stream(&streams, "STDOUT", pid, outs[0], c.readers[0], stdout)
+	go r.stream(&streams, "STDERR", pid, outs[1], c.readers[1], stderr)
+	go func() {
+		streams.Wait()
+		close(streamed)
+	}()
+	go func() {
+		c.err = exe.Wait()
+		log.Printf("[NFO] process %d exited: %v", pid, c.err)
+		c.mu.Lock()
+		closeAll(c.writers)
+		c.writers = nil
+		c.mu.Unlock()
+		// Collect the last lines of output unless someone else keeps the pipes open
+		select {
+		case <-streamed:
+		case <-time.After(drainTimeout):
+			log.Printf("[NFO] closing output pipes of process %d", pid)
+			closeAll(outs[:])
+			<-streamed
+		}
+		close(c.exited)
+	}()
+
+	if err = r.waitReady(ctx, c); err != nil {
+		if errS := r.stop(); errS != nil {
+			log.Println("[ERR]", errS)
+		}
+	}
+	return
+}
+
+func (r *Resetter) stream(wg *sync.WaitGroup, source string, pid int, rc io.Closer, tr *trackingReader, w io.Writer) {
+	defer wg.Done()
+	defer rc.Close()
+	defer tr.finish()
+	br := bufio.NewReader(tr)
+	for {
+		// No line length limit: a blocked pipe would hang the process
+		line, err := br.ReadString('\n')
+		if line == "" && err != nil {
+			if err != io.EOF {
+				log.Println("[ERR]", err)
+			}
+			// Keep draining whatever remains
+			_, _ = io.Copy(ioutil.Discard, br)
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		log.Printf("[NFO] %s:%d: %q", source, pid, line)
+		if r.logs != nil {
+			r.logs.Add(source, line)
+		}
+		if w != nil {
+			fmt.Fprintln(w, line)
+		}
+
+		r.mu.Lock()
+		if r.tail = append(r.tail, line); len(r.tail) > tailSize {
+			r.tail = r.tail[len(r.tail)-tailSize:]
+		}
+		r.mu.Unlock()
+	}
+}
+
+func (r *Resetter) waitReady(ctx context.Context, c *child) error {
+	timeout := time.Duration(r.ReadyTimeoutNs)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+	for {
+		ready, probeErr := r.probe(ctx)
+		if ready {
+			log.Printf("[NFO] process ready after %s", time.Since(start))
+			return nil
+		}
+
+		select {
+		case <-c.exited:
+			return r.exitError(c)
+		case <-ctx.Done():
+			if ctx.Err() == context.Canceled {
+				return ctx.Err()
+			}
+			reason := fmt.Sprintf("process not ready after %s", timeout)
+			if probeErr != nil {
+				reason += ": " + probeErr.Error()
+			}
+			return r.failure(reason)
+		case <-ticker.C:
+		}
+	}
+}
+
+// probe is true once all readiness conditions hold
+func (r *Resetter) probe(ctx context.Context) (bool, error) {
+	if r.ReadyTcp != "" {
+		d := net.Dialer{Timeout: readyProbeTimeout}
+		conn, err := d.DialContext(ctx, "tcp", r.ReadyTcp)
+		if err != nil {
+			return false, err
+		}
+		conn.Close()
+	}
+
+	if r.ReadyHttp != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.ReadyHttp, nil)
+		if err != nil {
+			return false, err
+		}
+		rep, err := readyClient.Do(req)
+		if err != nil {
+			return false, err
+		}
+		rep.Body.Close()
+		if rep.StatusCode < 200 || rep.StatusCode > 299 {
+			return false, fmt.Errorf("%s answered %s", r.ReadyHttp, rep.Status)
+		}
+	}
+	return true, nil
+}
+
+// stop terminates the process group, killing it if it takes too long
+func (r *Resetter) stop() error {
+	r.mu.Lock()
+	c := r.running
+	r.running = nil
+	r.mu.Unlock()
+	if c == nil {
+		return nil
+	}
+
+	pid := c.exe.Process.Pid
+	log.Printf("[NFO] stopping process %d", pid)
+	if err := terminateGroup(c.exe); err != nil {
+		log.Println("[ERR]", err)
+	}
+
+	select {
+	case <-c.exited:
+		return nil
+	case <-time.After(stopTimeout):
+	}
+
+	log.Printf("[NFO] killing process %d after %s", pid, stopTimeout)
+	if err := killGroup(c.exe); err != nil {
+		log.Println("[ERR]", err)
+		return err
+	}
+	<-c.exited
+	return nil
+}
+
+func (r *Resetter) exitError(c *child) error {
+	err := c.err
+	if err == nil {
+		err = errors.New("exit status 0")
+	}
+	return r.failure(fmt.Sprintf("process exited: %v", err))
+}
+
+// failure describes an error along with the last lines the process output
+func (r *Resetter) failure(reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bt := make([]string, 0, len(r.tail)+1)
+	bt = append(bt, r.tail...)
+	bt = append(bt, reason)
+	log.Println("[ERR]", reason)
+	return resetter.NewError(bt)
+}
+
+func closeAll(fs []*os.File) {
+	for _, f := range fs {
+		if f != nil {
+			f.Close()
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/resettertest"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const envHelper = "MONKEY_TEST_HELPER_PROCESS"
+
+var builtins = starlark.StringDict{"Process": starlark.NewBuiltin("Process", Builtin)}
+
+// TestHelperProcess is not a test: it is the process being supervised
+func TestHelperProcess(t *testing.T) {
+	mode := os.Getenv(envHelper)
+	if mode == "" {
+		return
+	}
+	switch mode {
+	case "serve":
+		fmt.Println("listening on", os.Getenv("ADDR"))
+		http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(os.Stderr, "served", r.URL.Path)
+			fmt.Fprintln(w, os.Getpid())
+		})
+		http.HandleFunc("/crash", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(os.Stderr, "panic: nil map")
+			os.Exit(2)
+		})
+		fmt.Fprintln(os.Stderr, http.ListenAndServe(os.Getenv("ADDR"), nil))
+	case "crash":
+		fmt.Println("starting...")
+		fmt.Fprintln(os.Stderr, "no database found")
+		os.Exit(3)
+	case "sleep":
+		time.Sleep(time.Minute)
+	case "long-line":
+		fmt.Println(strings.Repeat("x", 1<<20))
+		fmt.Println("after")
+		time.Sleep(time.Minute)
+	case "daemonize":
+		grandchild := exec.Command(os.Args[0], os.Args[1:]...)
+		grandchild.Env = append(os.Environ(), envHelper+"=sleep")
+		grandchild.Stdout, grandchild.Stderr = os.Stdout, os.Stderr
+		if err := grandchild.Start(); err != nil {
+			panic(err)
+		}
+		fmt.Println("daemonized")
+	}
+	os.Exit(0)
+}
+
+func newHelper(t *testing.T, mode string, kwargs ...starlark.Tuple) *Resetter {
+	kwargs = append([]starlark.Tuple{
+		{starlark.String("cmd"), starlark.NewList([]starlark.Value{
+			starlark.String(os.Args[0]),
+			starlark.String("-test.run=TestHelperProcess"),
+		})},
+		{starlark.String("env"), starlarkDict(map[string]string{envHelper: mode})},
+	}, kwargs...)
+	v, err := Builtin(nil, starlark.NewBuiltin("Process", Builtin), nil, kwargs)
+	require.NoError(t, err)
+	return v.(*Resetter)
+}
+
+func starlarkDict(kvs map[string]string) *starlark.Dict {
+	d := starlark.NewDict(len(kvs))
+	for k, v := range kvs {
+		d.SetKey(starlark.String(k), starlark.String(v))
+	}
+	return d
+}
+
+func health(t *testing.T, addr string) string {
+	rep, err := http.Get("http://" + addr + "/health")
+	require.NoError(t, err)
+	defer rep.Body.Close()
+	body, err := ioutil.ReadAll(rep.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestBuiltinKwargs(t *testing.T) {
+	resettertest.CheckKwargs(t, builtins, map[string]string{
+		`Process(cmd = ["./server"])`:                                                  "",
+		`Process(cmd = ["./server"], ready_tcp = ":8080", ready_timeout = "1m")`:       "",
+		`Process(cmd = ["./server"], env = {"PORT": "8080"}, restart_on_reset = True)`: "",
+		`Process(cmd = ["./server"], log_file = "/var/log/server.log")`:                "",
+		`Process()`:           "Process: missing argument for cmd",
+		`Process(cmd = [])`:   "Process: cmd must not be empty",
+		`Process(cmd = [42])`: "Process: cmd must be a list of strings, got: int",
+		`Process(cmd = ["s"], env = {"PORT": 8080})`:            "Process: env values must be strings, got: int",
+		`Process(cmd = ["s"], ready_http = "localhost/health")`: "Process: ready_http must be a URL such as http://localhost:8080/health, got: localhost/health",
+		`Process(cmd = ["s"], ready_tcp = "8080")`:              "Process: ready_tcp must be an address such as :8080, got: 8080",
+		`Process(cmd = ["s"], ready_timeout = "soon")`:          `Process: ready_timeout must be a positive duration such as "30s", got: soon`,
+	})
+}
+
+func TestStartsThenRestartsOnReset(t *testing.T) {
+	addr := resettertest.FreeAddr(t)
+	r := newHelper(t, "serve",
+		starlark.Tuple{starlark.String("ready_http"), starlark.String("http://" + addr + "/health")},
+		starlark.Tuple{starlark.String("restart_on_reset"), starlark.True},
+	)
+	r.Env(map[string]string{"ADDR": addr})
+	ctx := context.Background()
+
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	require.True(t, r.isRunning())
+	pid := health(t, addr)
+
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	require.True(t, r.isRunning())
+	require.NotEqual(t, pid, health(t, addr))
+
+	require.NoError(t, r.Terminate(ctx, ioutil.Discard, ioutil.Discard))
+	require.False(t, r.isRunning())
+	_, err := http.Get("http://" + addr + "/health")
+	require.Error(t, err)
+}
+
+func TestKeepsRunningAcrossResets(t *testing.T) {
+	addr := resettertest.FreeAddr(t)
+	r := newHelper(t, "serve",
+		starlark.Tuple{starlark.String("ready_tcp"), starlark.String(addr)},
+	)
+	r.Env(map[string]string{"ADDR": addr})
+	ctx := context.Background()
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	pid := health(t, addr)
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	require.Equal(t, pid, health(t, addr))
+}
+
+func TestCapturesOutputDuringCalls(t *testing.T) {
+	addr := resettertest.FreeAddr(t)
+	r := newHelper(t, "serve",
+		starlark.Tuple{starlark.String("ready_tcp"), starlark.String(addr)},
+	)
+	r.Env(map[string]string{"ADDR": addr})
+	ctx := context.Background()
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+
+	since := time.Now()
+	health(t, addr)
+	logs := r.Logs(since, time.Now())
+	require.Len(t, logs, 1)
+	require.Equal(t, "STDERR", logs[0].GetSource())
+	require.Equal(t, "served /health", logs[0].GetLine())
+}
+
+func TestEarlyExitIsAResetterError(t *testing.T) {
+	r := newHelper(t, "crash",
+		starlark.Tuple{starlark.String("ready_tcp"), starlark.String(resettertest.FreeAddr(t))},
+	)
+	err := r.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Contains(t, reason, "starting...")
+	require.Contains(t, reason, "no database found")
+	require.Equal(t, "process exited: exit status 3", reason[len(reason)-1])
+}
+
+func TestNotReadyInTime(t *testing.T) {
+	r := newHelper(t, "sleep",
+		starlark.Tuple{starlark.String("ready_tcp"), starlark.String(resettertest.FreeAddr(t))},
+		starlark.Tuple{starlark.String("ready_timeout"), starlark.String("300ms")},
+	)
+	err := r.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Contains(t, reason[len(reason)-1], "process not ready after 300ms: ")
+	require.False(t, r.isRunning())
+}
+
+func TestCrashDuringTestsIsReportedOnReset(t *testing.T) {
+	addr := resettertest.FreeAddr(t)
+	r := newHelper(t, "serve",
+		starlark.Tuple{starlark.String("ready_tcp"), starlark.String(addr)},
+	)
+	r.Env(map[string]string{"ADDR": addr})
+	ctx := context.Background()
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+
+	_, err := http.Get("http://" + addr + "/crash")
+	require.Error(t, err)
+	<-r.current().exited
+
+	err = r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Contains(t, reason, "panic: nil map")
+	require.Equal(t, "process exited: exit status 2", reason[len(reason)-1])
+	require.True(t, r.isRunning())
+	health(t, addr)
+}
+
+func TestLongLinesDoNotBlockTheProcess(t *testing.T) {
+	r := newHelper(t, "long-line")
+	ctx := context.Background()
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.tail) != 0 && r.tail[len(r.tail)-1] == "after"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExitIsSeenDespiteGrandchildren(t *testing.T) {
+	r := newHelper(t, "daemonize")
+	ctx := context.Background()
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	c := r.current()
+	defer killGroup(c.exe)
+
+	select {
+	case <-c.exited:
+	case <-time.After(drainTimeout + 5*time.Second):
+		t.Fatal("process exit not observed")
+	}
+	require.NoError(t, c.err)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.Contains(t, r.tail, "daemonized")
+}
+
+func TestFlushReadsOutputWrittenSoFar(t *testing.T) {
+	r := &Resetter{}
+	pr, pw, err := os.Pipe()
+	require.NoError(t, err)
+	c := &child{readers: []*trackingReader{{r: pr}}}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go r.stream(&wg, "STDOUT", 0, pr, c.readers[0], nil)
+
+	for _, line := range []string{"abc", "def"} {
+		_, err = io.WriteString(pw, line+"\n")
+		require.NoError(t, err)
+		c.flush(time.Now())
+		r.mu.Lock()
+		require.Equal(t, line, r.tail[len(r.tail)-1])
+		r.mu.Unlock()
+	}
+
+	_, _ = io.WriteString(pw, "gh")
+	c.flush(time.Now())
+	_, _ = io.WriteString(pw, "i\n")
+	pw.Close()
+	wg.Wait()
+	require.Equal(t, []string{"abc", "def", "ghi"}, r.tail)
+	c.flush(time.Now())
+}
@@ -0,0 +1,43 @@
+// Package resettertest provides utilities for testing resetters.
+package resettertest
+
+import (
+	"net"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+// New evaluates code, a call to one of the predeclared builtins
+func New(t *testing.T, predeclared starlark.StringDict, code string) resetter.Interface {
+	v, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", code, predeclared)
+	require.NoError(t, err)
+	require.Implements(t, (*resetter.Interface)(nil), v)
+	return v.(resetter.Interface)
+}
+
+// CheckKwargs evaluates each call to one of the predeclared builtins
+// and compares its error to the expected one, if any.
+func CheckKwargs(t *testing.T, predeclared starlark.StringDict, expectations map[string]string) {
+	for code, expected := range expectations {
+		t.Run(code, func(t *testing.T) {
+			v, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", code, predeclared)
+			if expected == "" {
+				require.NoError(t, err)
+				require.Implements(t, (*resetter.Interface)(nil), v)
+			} else {
+				require.EqualError(t, err, expected)
+			}
+		})
+	}
+}
+
+// FreeAddr returns a local address nothing listens on
+func FreeAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
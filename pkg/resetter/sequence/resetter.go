@@ -0,0 +1,109 @@
+package sequence
+
+import (
+	"context"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+var (
+	_ resetter.Interface    = (*Resetter)(nil)
+	_ resetter.LogsCapturer = (*Resetter)(nil)
+)
+
+// Resetter implements resetter.Interface by composing resetters:
+// they start & reset in order then stop in reverse order.
+type Resetter struct {
+	rsttrs []resetter.Interface
+}
+
+// New composes resetters, in order
+func New(rsttrs ...resetter.Interface) *Resetter {
+	return &Resetter{rsttrs: rsttrs}
+}
+
+// Resetters lists the composed resetters, in order
+func (r *Resetter) Resetters() []resetter.Interface {
+	return r.rsttrs
+}
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (r *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	seq := &fm.Clt_Fuzz_Resetter_Sequence{
+		Resetters: make([]*fm.Clt_Fuzz_Resetter, 0, len(r.rsttrs)),
+	}
+	for _, rsttr := range r.rsttrs {
+		seq.Resetters = append(seq.Resetters, rsttr.ToProto())
+	}
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Sequence_{
+			Sequence: seq,
+		}}
+}
+
+// Env passes envs read during startup
+func (r *Resetter) Env(read map[string]string) {
+	for _, rsttr := range r.rsttrs {
+		rsttr.Env(read)
+	}
+}
+
+// ExecStart executes the setup phase of the System Under Test
+func (r *Resetter) ExecStart(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	for _, rsttr := range r.rsttrs {
+		if err := rsttr.ExecStart(ctx, stdout, stderr, only); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (r *Resetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	for _, rsttr := range r.rsttrs {
+		if err := rsttr.ExecReset(ctx, stdout, stderr, only); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (r *Resetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	for i := len(r.rsttrs) - 1; i >= 0; i-- {
+		if err := r.rsttrs[i].ExecStop(ctx, stdout, stderr, only); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (r *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) (err error) {
+	for i := len(r.rsttrs) - 1; i >= 0; i-- {
+		if errT := r.rsttrs[i].Terminate(ctx, stdout, stderr); errT != nil {
+			log.Println("[ERR]", errT)
+			if err == nil {
+				err = errT
+			}
+			// Keep going
+		}
+	}
+	return
+}
+
+// Logs returns lines output between since and until, merged in time order
+func (r *Resetter) Logs(since, until time.Time) (logs []*fm.Clt_CallResponseRaw_LogLine) {
+	for _, rsttr := range r.rsttrs {
+		if lc, ok := rsttr.(resetter.LogsCapturer); ok {
+			logs = append(logs, lc.Logs(since, until)...)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].GetOffsetNs() < logs[j].GetOffsetNs() })
+	return
+}
@@ -0,0 +1,110 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	name  string
+	calls *[]string
+	fail  string
+	logs  []*fm.Clt_CallResponseRaw_LogLine
+}
+
+func (r *recorder) record(phase string) error {
+	*r.calls = append(*r.calls, phase+" "+r.name)
+	if phase == r.fail {
+		return errors.New(phase + " " + r.name + " failed")
+	}
+	return nil
+}
+
+func (r *recorder) ToProto() *fm.Clt_Fuzz_Resetter {
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Shell_{
+			Shell: &fm.Clt_Fuzz_Resetter_Shell{Rst: r.name},
+		}}
+}
+func (r *recorder) Env(read map[string]string) { _ = r.record("env") }
+func (r *recorder) ExecStart(context.Context, io.Writer, io.Writer, bool) error {
+	return r.record("start")
+}
+func (r *recorder) ExecReset(context.Context, io.Writer, io.Writer, bool) error {
+	return r.record("reset")
+}
+func (r *recorder) ExecStop(context.Context, io.Writer, io.Writer, bool) error {
+	return r.record("stop")
+}
+func (r *recorder) Terminate(context.Context, io.Writer, io.Writer) error {
+	return r.record("terminate")
+}
+func (r *recorder) Logs(since, until time.Time) []*fm.Clt_CallResponseRaw_LogLine {
+	return r.logs
+}
+
+func TestOrdering(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	seq := New(&recorder{name: "a", calls: &calls}, &recorder{name: "b", calls: &calls})
+
+	seq.Env(nil)
+	require.NoError(t, seq.ExecStart(ctx, nil, nil, false))
+	require.NoError(t, seq.ExecReset(ctx, nil, nil, false))
+	require.NoError(t, seq.ExecStop(ctx, nil, nil, false))
+	require.NoError(t, seq.Terminate(ctx, nil, nil))
+	require.Equal(t, []string{
+		"env a", "env b",
+		"start a", "start b",
+		"reset a", "reset b",
+		"stop b", "stop a",
+		"terminate b", "terminate a",
+	}, calls)
+
+	rsttrs := seq.ToProto().GetSequence().GetResetters()
+	require.Len(t, rsttrs, 2)
+	require.Equal(t, "a", rsttrs[0].GetShell().GetRst())
+	require.Equal(t, "b", rsttrs[1].GetShell().GetRst())
+}
+
+func TestFailures(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	seq := New(
+		&recorder{name: "a", calls: &calls, fail: "terminate"},
+		&recorder{name: "b", calls: &calls, fail: "reset"},
+		&recorder{name: "c", calls: &calls, fail: "terminate"},
+	)
+
+	err := seq.ExecReset(ctx, nil, nil, false)
+	require.EqualError(t, err, "reset b failed")
+	err = seq.Terminate(ctx, nil, nil)
+	require.EqualError(t, err, "terminate c failed")
+	require.Equal(t, []string{
+		"reset a", "reset b",
+		"terminate c", "terminate b", "terminate a",
+	}, calls)
+}
+
+func TestLogsAreMerged(t *testing.T) {
+	line := func(source string, offset int64) *fm.Clt_CallResponseRaw_LogLine {
+		return &fm.Clt_CallResponseRaw_LogLine{Source: source, Line: source, OffsetNs: offset}
+	}
+	var calls []string
+	seq := New(
+		&recorder{name: "a", calls: &calls, logs: []*fm.Clt_CallResponseRaw_LogLine{line("a", 1), line("a", 4)}},
+		&recorder{name: "b", calls: &calls, logs: []*fm.Clt_CallResponseRaw_LogLine{line("b", 2), line("b", 3)}},
+	)
+
+	logs := seq.Logs(time.Now(), time.Now())
+	require.Len(t, logs, 4)
+	for i, offset := range []int64{1, 2, 3, 4} {
+		require.Equal(t, offset, logs[i].GetOffsetNs())
+	}
+}
@@ -0,0 +1,157 @@
+package shell
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const defaultShell = "/bin/bash"
+
+// interpreter describes how a shell runs scripts and persists their variables
+type interpreter struct {
+	// cmd is the shell's command line, e.g. /usr/bin/env bash
+	cmd []string
+	// scriptArgs run a script file, stdinArgs run a script read from stdin
+	scriptArgs, stdinArgs []string
+	// dump prints all variables in a form the shell can source back
+	dump string
+	// source is the builtin that reads & runs a file in the current shell
+	source string
+	// sourceFirst sources the env file before declaring readonly envs,
+	// for shells that abort on assigning a readonly variable
+	sourceFirst bool
+	// readonly declares a readonly variable
+	readonly func(k, v string) string
+	// options are set around the user's script
+	options []string
+	// filter drops variables from a dump that must not be sourced back, if set
+	filter func(dump []byte) []byte
+}
+
+// shSpecialVars are maintained by sh itself: sourcing them back
+// would e.g. change directory, reset getopts or fail on readonly ones.
+var shSpecialVars = map[string]struct{}{
+	"IFS":    {},
+	"LINENO": {},
+	"OLDPWD": {},
+	"OPTARG": {},
+	"OPTIND": {},
+	"PPID":   {},
+	"PS1":    {},
+	"PS2":    {},
+	"PS4":    {},
+	"PWD":    {},
+	"_":      {},
+}
+
+// newInterpreter picks the strategy matching the shell named last in cmd
+func newInterpreter(shell string) (*interpreter, error) {
+	cmd := strings.Fields(shell)
+	if len(cmd) == 0 {
+		cmd = []string{defaultShell}
+	}
+
+	switch name := filepath.Base(cmd[len(cmd)-1]); name {
+	case "bash":
+		return &interpreter{
+			cmd:        cmd,
+			scriptArgs: []string{"--norc", "--"},
+			stdinArgs:  []string{"--norc", "-s"},
+			dump:       "declare -p",
+			source:     "source",
+			readonly: func(k, v string) string {
+				return fmt.Sprintf("declare -p %s >/dev/null 2>&1 || declare -r %s=%s", k, k, quote(v))
+			},
+			options: []string{"errexit", "errtrace", "nounset", "pipefail", "xtrace"},
+		}, nil
+
+	case "zsh":
+		return &interpreter{
+			cmd:        cmd,
+			scriptArgs: []string{"--no-rcs", "--"},
+			stdinArgs:  []string{"--no-rcs", "-s"},
+			dump:       "typeset -p",
+			source:     "source",
+			readonly: func(k, v string) string {
+				return fmt.Sprintf("typeset -p %s >/dev/null 2>&1 || typeset -r %s=%s", k, k, quote(v))
+			},
+			options: []string{"errexit", "nounset", "pipefail", "xtrace"},
+		}, nil
+
+	case "sh", "ash", "dash":
+		return &interpreter{
+			cmd:         cmd,
+			scriptArgs:  []string{"--"},
+			stdinArgs:   []string{"-s"},
+			dump:        "set",
+			source:      ".",
+			sourceFirst: true,
+			readonly: func(k, v string) string {
+				return fmt.Sprintf("readonly %s=%s", k, quote(v))
+			},
+			options: []string{"errexit", "nounset", "xtrace"},
+			filter: func(dump []byte) []byte {
+				return filterAssignments(dump, shSpecialVars)
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported shell %q: pick one of bash, zsh or sh", name)
+	}
+}
+
+// command returns the shell's executable and its arguments
+func (i *interpreter) command(args ...string) (string, []string) {
+	all := make([]string, 0, len(i.cmd)-1+len(args))
+	all = append(all, i.cmd[1:]...)
+	return i.cmd[0], append(all, args...)
+}
+
+// quote single-quotes s for all supported shells
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// filterAssignments drops the NAME=value lines of set's output naming one of skip.
+// Values may be quoted and span lines.
+func filterAssignments(dump []byte, skip map[string]struct{}) []byte {
+	kept := make([]byte, 0, len(dump))
+	for len(dump) != 0 {
+		end := assignmentEnd(dump)
+		name := dump[:end]
+		if i := bytes.IndexByte(name, '='); i >= 0 {
+			name = name[:i]
+		}
+		if _, ok := skip[string(name)]; !ok {
+			kept = append(kept, dump[:end]...)
+		}
+		dump = dump[end:]
+	}
+	return kept
+}
+
+// assignmentEnd returns the length of the first assignment, its newline included
+func assignmentEnd(dump []byte) int {
+	var quote byte
+	for i := 0; i < len(dump); i++ {
+		switch c := dump[i]; {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			}
+		case c == '\\':
+			i++
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '\n':
+			return i + 1
+		}
+	}
+	return len(dump)
+}
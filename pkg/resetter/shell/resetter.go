@@ -6,23 +6,32 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/capture"
+	"go.starlark.net/starlark"
 )
 
 const (
-	timeoutShort = 200 * time.Millisecond
-	timeoutLong  = 2 * time.Minute
+	defaultEnvSnapshotTimeout = 200 * time.Millisecond
+	defaultExecTimeout        = 2 * time.Minute
 )
 
-var _ resetter.Interface = (*Resetter)(nil)
+var (
+	_ resetter.Interface    = (*Resetter)(nil)
+	_ resetter.LogsCapturer = (*Resetter)(nil)
+	_ starlark.Value        = (*Resetter)(nil)
+)
 
 // Resetter implements resetter.Interface
 type Resetter struct {
@@ -30,7 +39,83 @@ type Resetter struct {
 
 	isNotFirstRun bool
 
-	setReadonlyEnvs func(Y io.Writer)
+	read map[string]string
+
+	logs    *capture.Lines
+	logFile *capture.Tail
+}
+
+// Builtin is a Starlark builtin describing shell scripts resetting the SUT:
+// Shell(reset="make reset", shell="sh", exec_timeout="5m")
+// Only lines appended to log_file are captured during calls: output of
+// processes that start runs in the background is not, so redirect it there.
+func Builtin(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		start, rst, stop   starlark.String
+		sh                 = starlark.String(defaultShell)
+		execTimeout        = starlark.String(defaultExecTimeout.String())
+		envSnapshotTimeout = starlark.String(defaultEnvSnapshotTimeout.String())
+		logFile            starlark.String
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"start?", &start,
+		"reset?", &rst,
+		"stop?", &stop,
+		"shell?", &sh,
+		"exec_timeout?", &execTimeout,
+		"env_snapshot_timeout?", &envSnapshotTimeout,
+		"log_file?", &logFile,
+	); err != nil {
+		return nil, err
+	}
+
+	s := &Resetter{}
+	s.Start, s.Rst, s.Stop = start.GoString(), rst.GoString(), stop.GoString()
+	var (
+		hasStart = strings.TrimSpace(s.Start) != ""
+		hasReset = strings.TrimSpace(s.Rst) != ""
+		hasStop  = strings.TrimSpace(s.Stop) != ""
+	)
+	if hasStart != hasStop {
+		return nil, fmt.Errorf("%s: start and stop must be set together", b.Name())
+	}
+	if !(hasReset || hasStart) {
+		return nil, fmt.Errorf("%s: at least one of reset or start & stop is required", b.Name())
+	}
+
+	s.Shell = sh.GoString()
+	if _, err := newInterpreter(s.Shell); err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+
+	for _, x := range []struct {
+		kwarg string
+		value starlark.String
+		ns    *int64
+	}{
+		{"exec_timeout", execTimeout, &s.ExecTimeoutNs},
+		{"env_snapshot_timeout", envSnapshotTimeout, &s.EnvSnapshotTimeoutNs},
+	} {
+		d, err := time.ParseDuration(x.value.GoString())
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("%s: %s must be a positive duration such as \"30s\", got: %s", b.Name(), x.kwarg, x.value.GoString())
+		}
+		*x.ns = int64(d)
+	}
+
+	if s.LogFile = logFile.GoString(); s.LogFile != "" {
+		s.logs = capture.NewLines(capture.DefaultMaxLines)
+		s.logFile = capture.NewTail(s.LogFile, s.logs)
+	}
+	return s, nil
+}
+
+func (s *Resetter) Type() string          { return "shell" }
+func (s *Resetter) Freeze()               {}
+func (s *Resetter) Truth() starlark.Bool  { return starlark.True }
+func (s *Resetter) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", s.Type()) }
+func (s *Resetter) String() string {
+	return fmt.Sprintf("Shell(shell=%q)", s.shell())
 }
 
 // ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
@@ -43,11 +128,7 @@ func (s *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
 
 // Env passes envs read during startup
 func (s *Resetter) Env(read map[string]string) {
-	s.setReadonlyEnvs = func(Y io.Writer) {
-		for k, v := range read {
-			fmt.Fprintf(Y, "declare -p %s >/dev/null 2>&1 || declare -r %s=%s\n", k, k, v)
-		}
-	}
+	s.read = read
 }
 
 // ExecStart executes the setup phase of the System Under Test
@@ -71,7 +152,13 @@ func (s *Resetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Wr
 		s.isNotFirstRun = true
 	}
 
-	return s.exec(ctx, stdout, stderr, cmds)
+	if err := s.exec(ctx, stdout, stderr, cmds); err != nil {
+		return err
+	}
+	if s.logFile != nil && !only {
+		s.logFile.Start()
+	}
+	return nil
 }
 
 // ExecStop executes the cleanup phase of the System Under Test
@@ -81,6 +168,10 @@ func (s *Resetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Wri
 
 // Terminate cleans up after a resetter.Interface implementation instance
 func (s *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) (err error) {
+	if s.logFile != nil {
+		s.logFile.Stop()
+	}
+
 	if hasStop := strings.TrimSpace(s.Stop) != ""; hasStop {
 		if err = s.ExecStop(ctx, stdout, stderr, true); err != nil {
 			log.Println("[ERR]", err)
@@ -97,6 +188,18 @@ func (s *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Wr
 	return
 }
 
+// Logs returns lines appended to the log file between since and until
+func (s *Resetter) Logs(since, until time.Time) []*fm.Clt_CallResponseRaw_LogLine {
+	if s.logFile == nil {
+		return nil
+	}
+	if err := s.logFile.Poll(); err != nil {
+		log.Println("[ERR]", err)
+	}
+	// Lines are timestamped when read: include those written by until
+	return s.logs.Window(since, time.Now())
+}
+
 func (s *Resetter) commands() (cmds string, err error) {
 	var (
 		hasStart = strings.TrimSpace(s.Start) != ""
@@ -144,25 +247,43 @@ func (s *Resetter) exec(ctx context.Context, stdout io.Writer, stderr io.Writer,
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeoutLong)
+	sh, err := newInterpreter(s.shell())
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	timeout := durationOr(s.ExecTimeoutNs, defaultExecTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	envFile := cwid.EnvFile()
-	var fi os.FileInfo
-	if fi, err = os.Stat(envFile); err != nil {
+	if _, err = os.Stat(envFile); err != nil {
 		if !os.IsNotExist(err) {
 			log.Println("[ERR]", err)
 			return
 		}
 
-		if err = s.snapEnv(ctx, envFile); err != nil {
+		if err = s.snapEnv(ctx, sh, envFile); err != nil {
 			return
 		}
-		if fi, err = os.Stat(envFile); err != nil {
+	}
+	if sh.filter != nil {
+		var dump []byte
+		if dump, err = ioutil.ReadFile(envFile); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = ioutil.WriteFile(envFile, sh.filter(dump), 0600); err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
 	}
+	var fi os.FileInfo
+	if fi, err = os.Stat(envFile); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
 	originalMTime := fi.ModTime()
 
 	scriptFile := cwid.ScriptFile()
@@ -176,31 +297,34 @@ func (s *Resetter) exec(ctx context.Context, stdout io.Writer, stderr io.Writer,
 		defer script.Close()
 
 		Y := io.MultiWriter(script, &scriptListing)
-		s.setReadonlyEnvs(Y)
-		fmt.Fprintln(Y, "source", envFile, ">/dev/null 2>&1")
-		fmt.Fprintln(Y, "set -o errexit")
-		fmt.Fprintln(Y, "set -o errtrace")
-		fmt.Fprintln(Y, "set -o nounset")
-		fmt.Fprintln(Y, "set -o pipefail")
-		fmt.Fprintln(Y, "set -o xtrace")
+		if sh.sourceFirst {
+			fmt.Fprintln(Y, sh.source, envFile, ">/dev/null 2>&1")
+		}
+		s.setReadonlyEnvs(Y, sh)
+		if !sh.sourceFirst {
+			fmt.Fprintln(Y, sh.source, envFile, ">/dev/null 2>&1")
+		}
+		for _, option := range sh.options {
+			fmt.Fprintln(Y, "set -o", option)
+		}
 		fmt.Fprintln(Y, cmds)
-		fmt.Fprintln(Y, "set +o xtrace")
-		fmt.Fprintln(Y, "set +o pipefail")
-		fmt.Fprintln(Y, "set +o nounset")
-		fmt.Fprintln(Y, "set +o errtrace")
-		fmt.Fprintln(Y, "set +o errexit")
-		fmt.Fprintln(Y, "declare -p >", envFile)
+		for i := len(sh.options) - 1; i >= 0; i-- {
+			fmt.Fprintln(Y, "set +o", sh.options[i])
+		}
+		fmt.Fprintln(Y, sh.dump, ">", envFile)
 	}
 	defer os.Remove(scriptFile)
 
 	// NOTE: if piping script to Bash and the script calls exec,
 	// even in a subshell, bash will stop execution.
 	var stdboth bytes.Buffer
-	exe := exec.CommandContext(ctx, s.shell(), "--norc", "--", scriptFile)
+	name, args := sh.command(append(sh.scriptArgs, scriptFile)...)
+	exe := exec.CommandContext(ctx, name, args...)
 	exe.Stdin = nil
-	exe.Stdout = io.MultiWriter(&stdboth, stdout)
-	exe.Stderr = io.MultiWriter(&stdboth, stderr)
-	log.Printf("[DBG] executing script within %s:\n%s", timeoutLong, scriptListing.Bytes())
+	var mu sync.Mutex
+	exe.Stdout = &lockedWriter{mu: &mu, w: io.MultiWriter(&stdboth, stdout)}
+	exe.Stderr = &lockedWriter{mu: &mu, w: io.MultiWriter(&stdboth, stderr)}
+	log.Printf("[DBG] executing %s script within %s:\n%s", s.shell(), timeout, scriptListing.Bytes())
 
 	ch := make(chan error)
 	start := time.Now()
@@ -226,7 +350,10 @@ func (s *Resetter) exec(ctx context.Context, stdout io.Writer, stderr io.Writer,
 	}
 	log.Printf("[NFO] exec'd in %s", time.Since(start))
 	if err != nil {
+		// On timeout the script's outputs may still be being copied
+		mu.Lock()
 		reason := stdboth.String() + "\n" + err.Error()
+		mu.Unlock()
 		err = resetter.NewError(strings.Split(reason, "\n"))
 		return
 	}
@@ -250,7 +377,7 @@ func (s *Resetter) exec(ctx context.Context, stdout io.Writer, stderr io.Writer,
 	return
 }
 
-func (s *Resetter) snapEnv(ctx context.Context, envSerializedPath string) (err error) {
+func (s *Resetter) snapEnv(ctx context.Context, sh *interpreter, envSerializedPath string) (err error) {
 	envFile, err := os.OpenFile(envSerializedPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
 	if err != nil {
 		log.Println("[ERR]", err)
@@ -258,15 +385,17 @@ func (s *Resetter) snapEnv(ctx context.Context, envSerializedPath string) (err e
 	}
 	defer envFile.Close()
 
-	ctx, cancel := context.WithTimeout(ctx, timeoutShort)
+	timeout := durationOr(s.EnvSnapshotTimeoutNs, defaultEnvSnapshotTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	var script bytes.Buffer
-	fmt.Fprintln(&script, "declare -p") // bash specific
-	exe := exec.CommandContext(ctx, s.shell(), "--", "/dev/stdin")
+	fmt.Fprintln(&script, sh.dump)
+	name, args := sh.command(sh.stdinArgs...)
+	exe := exec.CommandContext(ctx, name, args...)
 	exe.Stdin = &script
 	exe.Stdout = envFile
-	log.Printf("[DBG] executing script within %s:\n%s", timeoutShort, script.Bytes())
+	log.Printf("[DBG] executing %s script within %s:\n%s", s.shell(), timeout, script.Bytes())
 
 	if err = exe.Run(); err != nil {
 		log.Println("[ERR]", err)
@@ -278,5 +407,39 @@ func (s *Resetter) snapEnv(ctx context.Context, envSerializedPath string) (err e
 }
 
 func (s *Resetter) shell() string {
-	return "/bin/bash"
+	if s.Shell == "" {
+		return defaultShell
+	}
+	return s.Shell
+}
+
+// setReadonlyEnvs declares envs read during startup, sorted by name
+func (s *Resetter) setReadonlyEnvs(Y io.Writer, sh *interpreter) {
+	keys := make([]string, 0, len(s.read))
+	for k := range s.read {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintln(Y, sh.readonly(k, s.read[k]))
+	}
+}
+
+// lockedWriter muxes a command's stdout & stderr, which are copied concurrently
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+func (lw *lockedWriter) Write(p []byte) (int, error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	return lw.w.Write(p)
+}
+
+func durationOr(ns int64, otherwise time.Duration) time.Duration {
+	if ns <= 0 {
+		return otherwise
+	}
+	return time.Duration(ns)
 }
@@ -0,0 +1,89 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/resettertest"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestBuiltinKwargs(t *testing.T) {
+	resettertest.CheckKwargs(t, starlark.StringDict{"Shell": starlark.NewBuiltin("Shell", Builtin)}, map[string]string{
+		`Shell(reset = "make reset")`: "",
+		`Shell(start = "s", reset = "r", stop = "S", shell = "/usr/bin/env bash")`: "",
+		`Shell(start = "s", stop = "S", shell = "zsh", exec_timeout = "10m")`:      "",
+		`Shell(reset = "r", log_file = "/var/log/server.log")`:                     "",
+		`Shell(reset = "r", shell = "sh", env_snapshot_timeout = "5s")`:            "",
+		`Shell()`:                                         "Shell: at least one of reset or start & stop is required",
+		`Shell(start = "s", reset = "r")`:                 "Shell: start and stop must be set together",
+		`Shell(reset = "r", shell = "fish")`:              `Shell: unsupported shell "fish": pick one of bash, zsh or sh`,
+		`Shell(reset = "r", exec_timeout = 1)`:            `Shell: for parameter "exec_timeout": got int, want string`,
+		`Shell(reset = "r", env_snapshot_timeout = "0s")`: `Shell: env_snapshot_timeout must be a positive duration such as "30s", got: 0s`,
+	})
+}
+
+func TestPersistsVariablesAcrossScripts(t *testing.T) {
+	require.NoError(t, cwid.MakePwdID("monkey-shell-test", 0))
+
+	for _, shell := range []string{"bash", "/usr/bin/env bash", "sh", "zsh"} {
+		t.Run(shell, func(t *testing.T) {
+			sh, err := newInterpreter(shell)
+			require.NoError(t, err)
+			if _, err := exec.LookPath(sh.cmd[len(sh.cmd)-1]); err != nil {
+				t.Skip(err)
+			}
+
+			s := &Resetter{}
+			s.Shell = shell
+			s.Start = `COUNT=1; echo "started with $GREETING"`
+			s.Rst = `COUNT=$((COUNT + 1)); echo "count=$COUNT"`
+			s.Stop = `echo stopped`
+			s.Env(map[string]string{"GREETING": "it's me"})
+			ctx := context.Background()
+			defer s.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+
+			var stdout bytes.Buffer
+			require.NoError(t, s.ExecReset(ctx, &stdout, ioutil.Discard, false))
+			require.Equal(t, "started with it's me\ncount=2\n", stdout.String())
+
+			stdout.Reset()
+			require.NoError(t, s.ExecReset(ctx, &stdout, ioutil.Discard, false))
+			require.Equal(t, "count=3\n", stdout.String())
+
+			stdout.Reset()
+			s.Rst = `GREETING=changed`
+			err = s.ExecReset(ctx, &stdout, ioutil.Discard, false)
+			require.IsType(t, &resetter.Error{}, err)
+		})
+	}
+}
+
+func TestExecTimeout(t *testing.T) {
+	require.NoError(t, cwid.MakePwdID("monkey-shell-test", 0))
+	defer os.Remove(cwid.EnvFile())
+
+	s := &Resetter{}
+	s.Shell = "sh"
+	s.Rst = `sleep 5`
+	s.ExecTimeoutNs = int64(200 * 1000 * 1000)
+	s.Env(nil)
+	err := s.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Equal(t, context.DeadlineExceeded.Error(), reason[len(reason)-1])
+}
+
+func TestFilterAssignments(t *testing.T) {
+	dump := "A='x'\"'\"'y\nz'\nB='a\\'\nIFS=' \t\n'\nOPTIND='1'\nPATH='/usr/bin:/bin'\nPPID='14074'\nPWD='/tmp'\nQ=\"a\\\"\nb\"\n"
+	require.Equal(t,
+		"A='x'\"'\"'y\nz'\nB='a\\'\nPATH='/usr/bin:/bin'\nQ=\"a\\\"\nb\"\n",
+		string(filterAssignments([]byte(dump), shSpecialVars)))
+}
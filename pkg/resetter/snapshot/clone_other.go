@@ -0,0 +1,13 @@
+//+build !linux
+
+package snapshot
+
+import (
+	"errors"
+	"os"
+)
+
+// clone is not supported here: files get copied instead
+func clone(dst, src *os.File) error {
+	return errors.New("reflinks are not supported on this platform")
+}
@@ -0,0 +1,65 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// copyFile clones src into a new file dst, falling back to copying bytes
+func copyFile(dst, src string, mode os.FileMode) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode.Perm())
+	if err != nil {
+		return
+	}
+	defer func() {
+		if errC := out.Close(); errC != nil && err == nil {
+			err = errC
+		}
+	}()
+
+	errC := clone(out, in)
+	if errC == nil {
+		return
+	}
+	log.Printf("[DBG] copying %s: %v", src, errC)
+	_, err = io.Copy(out, in)
+	return
+}
+
+// copyTree copies the file or directory src to dst, which must not exist
+func copyTree(dst, src string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.Mkdir(target, mode.Perm())
+		case mode.IsRegular():
+			return copyFile(target, path, mode)
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		default:
+			return fmt.Errorf("cannot snapshot %s: unsupported file type %s", path, mode.Type())
+		}
+	})
+}
@@ -0,0 +1,303 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"go.starlark.net/starlark"
+)
+
+var (
+	_ resetter.Interface    = (*Resetter)(nil)
+	_ resetter.LogsCapturer = (*Resetter)(nil)
+	_ starlark.Value        = (*Resetter)(nil)
+)
+
+// Resetter implements resetter.Interface by restoring files & directories
+// to the state they had once the System Under Test first started.
+type Resetter struct {
+	fm.Clt_Fuzz_Resetter_Snapshot
+
+	process *process.Resetter
+	saved   []*saved
+}
+
+// saved is a path's snapshot, kept next to it so it can be cloned & renamed
+type saved struct {
+	path    string
+	dir     string
+	existed bool
+}
+
+// Builtin is a Starlark builtin describing paths to snapshot & restore:
+// Snapshot(paths=["app.db"], process=Process(cmd=["./server"]))
+func Builtin(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		paths *starlark.List
+		proc  starlark.Value = starlark.None
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"paths", &paths,
+		"process?", &proc,
+	); err != nil {
+		return nil, err
+	}
+
+	r := &Resetter{}
+	seen := make(map[string]struct{}, paths.Len())
+	for i := 0; i < paths.Len(); i++ {
+		path, ok := paths.Index(i).(starlark.String)
+		if !ok {
+			return nil, fmt.Errorf("%s: paths must be a list of strings, got: %s", b.Name(), paths.Index(i).Type())
+		}
+		p := path.GoString()
+		if p == "" {
+			return nil, fmt.Errorf("%s: paths must not contain empty strings", b.Name())
+		}
+		clean := filepath.Clean(p)
+		if _, ok := seen[clean]; ok {
+			return nil, fmt.Errorf("%s: paths must be unique, got %q twice", b.Name(), p)
+		}
+		seen[clean] = struct{}{}
+		r.Paths = append(r.Paths, p)
+	}
+	if len(r.Paths) == 0 {
+		return nil, fmt.Errorf("%s: paths must not be empty", b.Name())
+	}
+
+	switch p := proc.(type) {
+	case starlark.NoneType:
+	case *process.Resetter:
+		r.process = p
+		r.Process = &p.Clt_Fuzz_Resetter_Process
+	default:
+		return nil, fmt.Errorf("%s: process must be a Process(...), got: %s", b.Name(), proc.Type())
+	}
+	return r, nil
+}
+
+func (r *Resetter) Type() string          { return "snapshot" }
+func (r *Resetter) Freeze()               {}
+func (r *Resetter) Truth() starlark.Bool  { return starlark.True }
+func (r *Resetter) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", r.Type()) }
+func (r *Resetter) String() string {
+	return fmt.Sprintf("Snapshot(paths=%q)", r.Paths)
+}
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (r *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Snapshot_{
+			Snapshot: &r.Clt_Fuzz_Resetter_Snapshot,
+		}}
+}
+
+// Env passes envs read during startup
+func (r *Resetter) Env(read map[string]string) {
+	if r.process != nil {
+		r.process.Env(read)
+	}
+}
+
+// ExecStart executes the setup phase of the System Under Test
+func (r *Resetter) ExecStart(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if r.process == nil {
+		log.Println("[NFO] no start phase for", r)
+		return nil
+	}
+	// Snapshots only live during fuzzing so $ monkey exec start just starts the process
+	return r.process.ExecStart(ctx, stdout, stderr, only)
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (r *Resetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) (err error) {
+	if only {
+		// Makes $ monkey exec reset go through a whole snapshot & restore cycle
+		defer func() {
+			if errT := r.Terminate(ctx, stdout, stderr); errT != nil && err == nil {
+				err = errT
+			}
+		}()
+	}
+
+	if r.saved == nil {
+		if err = r.take(ctx); err != nil || !only {
+			return
+		}
+	}
+	return r.restore(ctx)
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (r *Resetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if r.process == nil {
+		log.Println("[NFO] no stop phase for", r)
+		return nil
+	}
+	return r.process.ExecStop(ctx, stdout, stderr, only)
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (r *Resetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) (err error) {
+	if r.process != nil {
+		err = r.process.Terminate(ctx, stdout, stderr)
+	}
+	for _, s := range r.saved {
+		if errR := os.RemoveAll(s.dir); errR != nil {
+			log.Println("[ERR]", errR)
+			if err == nil {
+				err = errR
+			}
+		}
+	}
+	r.saved = nil
+	return
+}
+
+// Logs returns lines output by the managed process between since and until
+func (r *Resetter) Logs(since, until time.Time) []*fm.Clt_CallResponseRaw_LogLine {
+	if r.process == nil {
+		return nil
+	}
+	return r.process.Logs(since, until)
+}
+
+// take starts the process then snapshots paths while it is stopped
+func (r *Resetter) take(ctx context.Context) (err error) {
+	if r.process != nil {
+		if err = r.process.ExecStart(ctx, nil, nil, false); err != nil {
+			return
+		}
+		if err = r.process.ExecStop(ctx, nil, nil, false); err != nil {
+			return
+		}
+	}
+
+	start := time.Now()
+	saveds := make([]*saved, 0, len(r.Paths))
+	for _, path := range r.Paths {
+		var s *saved
+		if s, err = save(path); err != nil {
+			log.Println("[ERR]", err)
+			for _, s := range saveds {
+				if errR := os.RemoveAll(s.dir); errR != nil {
+					log.Println("[ERR]", errR)
+				}
+			}
+			return r.failure("snapshotting", err)
+		}
+		saveds = append(saveds, s)
+	}
+	r.saved = saveds
+	log.Printf("[NFO] snapshotted %d paths in %s", len(r.saved), time.Since(start))
+
+	if r.process != nil {
+		err = r.process.ExecStart(ctx, nil, nil, false)
+	}
+	return
+}
+
+// restore stops the process, swaps paths with their snapshots then restarts it
+func (r *Resetter) restore(ctx context.Context) (err error) {
+	if r.process != nil {
+		if err = r.process.ExecStop(ctx, nil, nil, false); err != nil {
+			return
+		}
+	}
+
+	start := time.Now()
+	for _, s := range r.saved {
+		if err = s.restore(); err != nil {
+			log.Println("[ERR]", err)
+			return r.failure("restoring", err)
+		}
+	}
+	log.Printf("[NFO] restored %d paths in %s", len(r.saved), time.Since(start))
+
+	if r.process != nil {
+		err = r.process.ExecStart(ctx, nil, nil, false)
+	}
+	return
+}
+
+func (r *Resetter) failure(doing string, err error) error {
+	return resetter.NewError(strings.Split(fmt.Sprintf("%s %q: %v", doing, r.Paths, err), "\n"))
+}
+
+func save(path string) (s *saved, err error) {
+	s = &saved{path: path}
+	if _, err = os.Lstat(path); err != nil {
+		if !os.IsNotExist(err) {
+			return
+		}
+		// Restoring removes paths that did not exist when snapshotting
+		err = nil
+		log.Printf("[NFO] %s does not exist yet", path)
+		return
+	}
+
+	if s.dir, err = ioutil.TempDir(filepath.Dir(path), "."+filepath.Base(path)+".monkey-snapshot-"); err != nil {
+		return
+	}
+	if err = copyTree(s.data(), path); err != nil {
+		if errR := os.RemoveAll(s.dir); errR != nil {
+			log.Println("[ERR]", errR)
+		}
+		return
+	}
+	s.existed = true
+	return
+}
+
+func (s *saved) data() string { return filepath.Join(s.dir, "data") }
+
+// restore replaces the path with a fresh copy of its snapshot.
+// Files are swapped atomically, directories with two renames.
+func (s *saved) restore() (err error) {
+	if !s.existed {
+		return os.RemoveAll(s.path)
+	}
+
+	fresh := filepath.Join(s.dir, "fresh")
+	if err = os.RemoveAll(fresh); err != nil {
+		return
+	}
+	if err = copyTree(fresh, s.data()); err != nil {
+		return
+	}
+
+	info, err := os.Lstat(s.path)
+	switch {
+	case err == nil && !info.IsDir():
+		if freshInfo, errL := os.Lstat(fresh); errL == nil && !freshInfo.IsDir() {
+			return os.Rename(fresh, s.path)
+		}
+	case os.IsNotExist(err):
+		return os.Rename(fresh, s.path)
+	case err != nil:
+		return
+	}
+
+	old := filepath.Join(s.dir, "old")
+	if err = os.Rename(s.path, old); err != nil {
+		return
+	}
+	if err = os.Rename(fresh, s.path); err != nil {
+		if errR := os.Rename(old, s.path); errR != nil {
+			err = errors.New(err.Error() + "\n" + errR.Error())
+		}
+		return
+	}
+	return os.RemoveAll(old)
+}
@@ -0,0 +1,167 @@
+package snapshot
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/resettertest"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+var builtins = starlark.StringDict{
+	"Process":  starlark.NewBuiltin("Process", process.Builtin),
+	"Snapshot": starlark.NewBuiltin("Snapshot", Builtin),
+}
+
+func newResetter(t *testing.T, code string) *Resetter {
+	return resettertest.New(t, builtins, code).(*Resetter)
+}
+
+func TestBuiltinKwargs(t *testing.T) {
+	resettertest.CheckKwargs(t, builtins, map[string]string{
+		`Snapshot(paths = ["app.db"])`:                                            "",
+		`Snapshot(paths = ["app.db", "data/"], process = Process(cmd = ["./s"]))`: "",
+		`Snapshot()`:                             "Snapshot: missing argument for paths",
+		`Snapshot(paths = [])`:                   "Snapshot: paths must not be empty",
+		`Snapshot(paths = [42])`:                 "Snapshot: paths must be a list of strings, got: int",
+		`Snapshot(paths = [""])`:                 "Snapshot: paths must not contain empty strings",
+		`Snapshot(paths = ["a/b", "a/./b"])`:     `Snapshot: paths must be unique, got "a/./b" twice`,
+		`Snapshot(paths = ["a"], process = "s")`: "Snapshot: process must be a Process(...), got: string",
+	})
+}
+
+func write(t *testing.T, path, data string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0644))
+}
+
+func read(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestRestoresFilesAndDirectories(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monkey-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	db := filepath.Join(tmp, "app.db")
+	wal := filepath.Join(tmp, "app.db-wal")
+	dir := filepath.Join(tmp, "data")
+	write(t, db, "initial")
+	write(t, filepath.Join(dir, "a"), "A")
+	write(t, filepath.Join(dir, "sub", "b"), "B")
+	require.NoError(t, os.Symlink("a", filepath.Join(dir, "link")))
+
+	r := newResetter(t, `Snapshot(paths = ["`+db+`", "`+wal+`", "`+dir+`"])`)
+	ctx := context.Background()
+
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	require.Len(t, r.saved, 3)
+
+	for i := 0; i < 2; i++ {
+		write(t, db, "mutated")
+		write(t, wal, "pending")
+		write(t, filepath.Join(dir, "a"), "AA")
+		write(t, filepath.Join(dir, "c"), "C")
+		require.NoError(t, os.RemoveAll(filepath.Join(dir, "sub")))
+
+		require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+
+		require.Equal(t, "initial", read(t, db))
+		_, err = os.Lstat(wal)
+		require.True(t, os.IsNotExist(err))
+		require.Equal(t, "A", read(t, filepath.Join(dir, "a")))
+		require.Equal(t, "B", read(t, filepath.Join(dir, "sub", "b")))
+		require.Equal(t, "A", read(t, filepath.Join(dir, "link")))
+		_, err = os.Lstat(filepath.Join(dir, "c"))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	require.NoError(t, r.Terminate(ctx, ioutil.Discard, ioutil.Discard))
+	entries, err := ioutil.ReadDir(tmp)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.Equal(t, []string{"app.db", "data"}, names)
+}
+
+func TestExecResetOnlyCleansUp(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monkey-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	db := filepath.Join(tmp, "app.db")
+	write(t, db, "initial")
+
+	r := newResetter(t, `Snapshot(paths = ["`+db+`"])`)
+	require.NoError(t, r.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, true))
+	require.Nil(t, r.saved)
+	require.Equal(t, "initial", read(t, db))
+	entries, err := ioutil.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+const envHelper = "MONKEY_TEST_HELPER_PROCESS"
+
+// TestHelperProcess is not a test: it is the process being supervised
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv(envHelper) == "" {
+		return
+	}
+	f, err := os.OpenFile(os.Getenv("DB"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		os.Exit(2)
+	}
+	f.WriteString("started\n")
+	f.Close()
+	l, err := net.Listen("tcp", os.Getenv("ADDR"))
+	if err != nil {
+		os.Exit(3)
+	}
+	defer l.Close()
+	time.Sleep(time.Minute)
+	os.Exit(0)
+}
+
+func TestStopsAndStartsProcessAroundRestores(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "monkey-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	db := filepath.Join(tmp, "app.db")
+	addr := resettertest.FreeAddr(t)
+
+	r := newResetter(t, `Snapshot(
+    paths = ["`+db+`"],
+    process = Process(
+        cmd = ["`+os.Args[0]+`", "-test.run=TestHelperProcess"],
+        env = {"`+envHelper+`": "1", "DB": "`+db+`", "ADDR": "`+addr+`"},
+        ready_tcp = "`+addr+`",
+    ),
+)`)
+	ctx := context.Background()
+	defer r.Terminate(ctx, ioutil.Discard, ioutil.Discard)
+
+	// Snapshot is taken while the process is stopped, after it first started
+	require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+	require.Equal(t, "started\nstarted\n", read(t, db))
+	require.Equal(t, "started\n", read(t, r.saved[0].data()))
+
+	for i := 0; i < 2; i++ {
+		write(t, db, "mutated\n")
+		require.NoError(t, r.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+		require.Equal(t, "started\nstarted\n", read(t, db))
+	}
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
@@ -13,7 +14,7 @@ import (
 
 // inputAsValue exposes request data as a Starlark value for user assertions.
 func inputAsValue(i *fm.Clt_CallRequestRaw_Input) starlark.Value {
-	s := make(starlark.StringDict, 5)
+	s := make(starlark.StringDict, 6)
 	switch x := i.GetInput().(type) {
 
 	case *fm.Clt_CallRequestRaw_Input_HttpRequest_:
@@ -35,10 +36,50 @@ func inputAsValue(i *fm.Clt_CallRequestRaw_Input) starlark.Value {
 			Members: headers,
 		}
 
+		var cookies []*http.Cookie
+		if values := reqProto.Headers["Cookie"].GetValues(); len(values) != 0 {
+			cookies = (&http.Request{Header: http.Header{"Cookie": values}}).Cookies()
+		}
+		s["cookies"] = cookiesAsValue(cookies)
+
 		if len(reqProto.Body) != 0 {
 			s["body"] = starlarkvalue.FromProtoValue(reqProto.BodyDecoded)
 		}
 
+		if op := reqProto.GetGraphql(); op != nil {
+			s["query"] = starlark.String(op.GetQuery())
+			if variables := op.GetVariables(); variables != nil {
+				s["variables"] = starlarkvalue.FromProtoValue(variables)
+			} else {
+				s["variables"] = starlark.NewDict(0)
+			}
+		}
+
+	case *fm.Clt_CallRequestRaw_Input_GrpcRequest_:
+		reqProto := i.GetGrpcRequest()
+		s["method"] = starlark.String(reqProto.Method)
+		s["target"] = starlark.String(reqProto.Target)
+		s["content"] = starlark.String(reqProto.Body)
+
+		md := make(map[string][]string, len(reqProto.Metadata))
+		for key, values := range reqProto.Metadata {
+			md[key] = values.GetValues()
+		}
+		s["metadata"] = metadataAsValue(md)
+
+		if reqProto.BodyDecoded != nil {
+			s["body"] = starlarkvalue.FromProtoValue(reqProto.BodyDecoded)
+		}
+
+	case *fm.Clt_CallRequestRaw_Input_WebsocketRequest:
+		reqProto := i.GetWebsocketRequest()
+		s["url"] = starlark.String(reqProto.Url)
+		s["channel"] = starlark.String(reqProto.Channel)
+		s["content"] = starlark.String(reqProto.Body)
+		if reqProto.BodyDecoded != nil {
+			s["body"] = starlarkvalue.FromProtoValue(reqProto.BodyDecoded)
+		}
+
 	default:
 		panic(fmt.Errorf("unhandled output %T: %+v", x, i))
 	}
@@ -50,7 +91,7 @@ func inputAsValue(i *fm.Clt_CallRequestRaw_Input) starlark.Value {
 
 // outputAsValue exposes response data as a Starlark value for user assertions.
 func outputAsValue(o *fm.Clt_CallResponseRaw_Output) starlark.Value {
-	s := make(starlark.StringDict, 6)
+	s := make(starlark.StringDict, 10)
 	switch x := o.GetOutput().(type) {
 
 	case *fm.Clt_CallResponseRaw_Output_HttpResponse_:
@@ -59,26 +100,133 @@ func outputAsValue(o *fm.Clt_CallResponseRaw_Output) starlark.Value {
 		s["reason"] = starlark.String(repProto.Reason)
 		s["content"] = starlark.String(repProto.Body)
 		s["elapsed_ns"] = starlark.MakeInt64(repProto.ElapsedNs)
+		timings := repProto.GetTimings()
+		s["timings"] = &starlarkstruct.Module{
+			Name: "timings",
+			Members: starlark.StringDict{
+				"dns_ns":           starlark.MakeInt64(timings.GetDnsNs()),
+				"connect_ns":       starlark.MakeInt64(timings.GetConnectNs()),
+				"tls_handshake_ns": starlark.MakeInt64(timings.GetTlsHandshakeNs()),
+				"ttfb_ns":          starlark.MakeInt64(timings.GetTtfbNs()),
+				"transfer_ns":      starlark.MakeInt64(timings.GetTransferNs()),
+			},
+		}
 		// "error": repProto.Error Checks make this unreachable
-		// "history" :: []Rep (redirects)?
+		s["headers"] = responseHeadersAsValue(repProto.Headers)
 
-		headers := make(starlark.StringDict, len(repProto.Headers))
-		for key, values := range repProto.Headers {
-			vs := make([]starlark.Value, 0, len(values.GetValues()))
-			for _, value := range values.GetValues() {
-				vs = append(vs, starlark.String(value))
+		var cookies []*http.Cookie
+		if values := repProto.Headers["Set-Cookie"].GetValues(); len(values) != 0 {
+			cookies = (&http.Response{Header: http.Header{"Set-Cookie": values}}).Cookies()
+		}
+		s["cookies"] = cookiesAsValue(cookies)
+
+		history := make([]starlark.Value, 0, len(repProto.History))
+		for _, hop := range repProto.History {
+			history = append(history, &starlarkstruct.Module{
+				Name: "redirect",
+				Members: starlark.StringDict{
+					"url":         starlark.String(hop.Url),
+					"status_code": starlark.MakeUint(uint(hop.StatusCode)),
+					"reason":      starlark.String(hop.Reason),
+					"location":    starlark.String(hop.Location),
+					"headers":     responseHeadersAsValue(hop.Headers),
+				},
+			})
+		}
+		s["history"] = starlark.NewList(history)
+
+		conn := repProto.GetConnection()
+		var tls starlark.Value = starlark.None
+		if t := conn.GetTls(); t != nil {
+			tls = &starlarkstruct.Module{
+				Name: "tls",
+				Members: starlark.StringDict{
+					"version":      starlark.String(t.Version),
+					"cipher_suite": starlark.String(t.CipherSuite),
+					"peer_subject": starlark.String(t.PeerSubject),
+				},
 			}
-			headers[key] = starlark.NewList(vs)
 		}
-		s["headers"] = &starlarkstruct.Module{
-			Name:    "headers",
-			Members: headers,
+		s["connection"] = &starlarkstruct.Module{
+			Name: "connection",
+			Members: starlark.StringDict{
+				"remote_ip":   starlark.String(conn.GetRemoteIp()),
+				"remote_port": starlark.MakeUint(uint(conn.GetRemotePort())),
+				"local_ip":    starlark.String(conn.GetLocalIp()),
+				"local_port":  starlark.MakeUint(uint(conn.GetLocalPort())),
+				"protocol":    starlark.String(conn.GetProtocol()),
+				"reused":      starlark.Bool(conn.GetReused()),
+				"tls":         tls,
+			},
 		}
 
-		if len(repProto.Body) != 0 {
+		if repProto.BodyDecoded != nil {
 			s["body"] = starlarkvalue.FromProtoValue(repProto.BodyDecoded)
 		}
 
+		events := make([]starlark.Value, 0, len(repProto.Events))
+		for _, event := range repProto.Events {
+			var body starlark.Value = starlark.None
+			if event.DataDecoded != nil {
+				body = starlarkvalue.FromProtoValue(event.DataDecoded)
+			}
+			events = append(events, &starlarkstruct.Module{
+				Name: "event",
+				Members: starlark.StringDict{
+					"id":    starlark.String(event.Id),
+					"event": starlark.String(event.Event),
+					"data":  starlark.String(event.Data),
+					"body":  body,
+				},
+			})
+		}
+		s["events"] = starlark.NewList(events)
+
+	case *fm.Clt_CallResponseRaw_Output_GrpcResponse_:
+		repProto := o.GetGrpcResponse()
+		s["status_code"] = starlark.MakeUint(uint(repProto.Code))
+		s["status"] = starlark.String(repProto.Status)
+		s["message"] = starlark.String(repProto.Message)
+		s["content"] = starlark.String(repProto.Body)
+		s["elapsed_ns"] = starlark.MakeInt64(repProto.ElapsedNs)
+
+		headers := make(map[string][]string, len(repProto.Headers))
+		for key, values := range repProto.Headers {
+			headers[key] = values.GetValues()
+		}
+		s["headers"] = metadataAsValue(headers)
+		trailers := make(map[string][]string, len(repProto.Trailers))
+		for key, values := range repProto.Trailers {
+			trailers[key] = values.GetValues()
+		}
+		s["trailers"] = metadataAsValue(trailers)
+
+		if repProto.BodyDecoded != nil {
+			s["body"] = starlarkvalue.FromProtoValue(repProto.BodyDecoded)
+		}
+
+	case *fm.Clt_CallResponseRaw_Output_WebsocketResponse:
+		repProto := o.GetWebsocketResponse()
+		s["elapsed_ns"] = starlark.MakeInt64(repProto.ElapsedNs)
+
+		messages := make([]starlark.Value, 0, len(repProto.Messages))
+		for _, msg := range repProto.Messages {
+			var body starlark.Value = starlark.None
+			if msg.DataDecoded != nil {
+				body = starlarkvalue.FromProtoValue(msg.DataDecoded)
+			}
+			messages = append(messages, &starlarkstruct.Module{
+				Name: "message",
+				Members: starlark.StringDict{
+					"sent":       starlark.Bool(msg.Sent),
+					"data":       starlark.String(msg.Data),
+					"body":       body,
+					"elapsed_ns": starlark.MakeInt64(msg.ElapsedNs),
+				},
+			})
+		}
+		s["messages"] = starlark.NewList(messages)
+
 	default:
 		panic(fmt.Errorf("unhandled output %T: %+v", x, o))
 	}
@@ -87,3 +235,44 @@ func outputAsValue(o *fm.Clt_CallResponseRaw_Output) starlark.Value {
 		Members: s,
 	}
 }
+
+func responseHeadersAsValue(hs map[string]*fm.Clt_CallResponseRaw_Output_HttpResponse_HeaderValues) starlark.Value {
+	headers := make(starlark.StringDict, len(hs))
+	for key, values := range hs {
+		vs := make([]starlark.Value, 0, len(values.GetValues()))
+		for _, value := range values.GetValues() {
+			vs = append(vs, starlark.String(value))
+		}
+		headers[key] = starlark.NewList(vs)
+	}
+	return &starlarkstruct.Module{
+		Name:    "headers",
+		Members: headers,
+	}
+}
+
+// metadataAsValue maps gRPC metadata keys (often containing dashes) to their values
+func metadataAsValue(md map[string][]string) starlark.Value {
+	d := starlark.NewDict(len(md))
+	for key, values := range md {
+		vs := make([]starlark.Value, 0, len(values))
+		for _, value := range values {
+			vs = append(vs, starlark.String(value))
+		}
+		if err := d.SetKey(starlark.String(key), starlark.NewList(vs)); err != nil {
+			panic(err) // Unreachable: dict is not frozen and keys are hashable
+		}
+	}
+	return d
+}
+
+// cookiesAsValue maps cookie names to their last value
+func cookiesAsValue(cookies []*http.Cookie) starlark.Value {
+	d := starlark.NewDict(len(cookies))
+	for _, cookie := range cookies {
+		if err := d.SetKey(starlark.String(cookie.Name), starlark.String(cookie.Value)); err != nil {
+			panic(err) // Unreachable: dict is not frozen and keys are hashable
+		}
+	}
+	return d
+}
@@ -0,0 +1,118 @@
+//+build fakefs
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestGRPCCallsAsValues(t *testing.T) {
+	body := &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{
+		Fields: map[string]*types.Value{"name": {Kind: &types.Value_StringValue{StringValue: "Rex"}}},
+	}}}
+	env := starlark.StringDict{
+		"request": inputAsValue(&fm.Clt_CallRequestRaw_Input{
+			Input: &fm.Clt_CallRequestRaw_Input_GrpcRequest_{
+				GrpcRequest: &fm.Clt_CallRequestRaw_Input_GrpcRequest{
+					Target: "localhost:50051",
+					Method: "/petstore.v1.PetStore/GetPet",
+					Metadata: map[string]*fm.Clt_CallRequestRaw_Input_GrpcRequest_MetadataValues{
+						"x-token": {Values: []string{"s3cr3t"}},
+					},
+					Body: []byte(`{"id":"42"}`),
+					BodyDecoded: &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{
+						Fields: map[string]*types.Value{"id": {Kind: &types.Value_StringValue{StringValue: "42"}}},
+					}}},
+				},
+			},
+		}),
+		"response": outputAsValue(&fm.Clt_CallResponseRaw_Output{
+			Output: &fm.Clt_CallResponseRaw_Output_GrpcResponse_{
+				GrpcResponse: &fm.Clt_CallResponseRaw_Output_GrpcResponse{
+					Code:   0,
+					Status: "OK",
+					Headers: map[string]*fm.Clt_CallResponseRaw_Output_GrpcResponse_MetadataValues{
+						"content-type": {Values: []string{"application/grpc"}},
+					},
+					Body:        []byte(`{"name":"Rex"}`),
+					BodyDecoded: body,
+					ElapsedNs:   1337,
+				},
+			},
+		}),
+	}
+
+	for expr, expected := range map[string]string{
+		`request.method`:                      `"/petstore.v1.PetStore/GetPet"`,
+		`request.target`:                      `"localhost:50051"`,
+		`request.metadata["x-token"]`:         `["s3cr3t"]`,
+		`request.body["id"]`:                  `"42"`,
+		`response.status_code`:                `0`,
+		`response.status`:                     `"OK"`,
+		`response.headers["content-type"][0]`: `"application/grpc"`,
+		`response.body["name"]`:               `"Rex"`,
+		`response.content`:                    `"{\"name\":\"Rex\"}"`,
+		`len(response.trailers)`:              `0`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			v, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", expr, env)
+			require.NoError(t, err)
+			require.Equal(t, expected, v.String())
+		})
+	}
+}
+
+func TestWebSocketCallsAsValues(t *testing.T) {
+	ack := &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{
+		Fields: map[string]*types.Value{"ok": {Kind: &types.Value_BoolValue{BoolValue: true}}},
+	}}}
+	env := starlark.StringDict{
+		"request": inputAsValue(&fm.Clt_CallRequestRaw_Input{
+			Input: &fm.Clt_CallRequestRaw_Input_WebsocketRequest{
+				WebsocketRequest: &fm.Clt_CallRequestRaw_Input_WebSocketRequest{
+					Url:         "ws://localhost:8080/chat",
+					Channel:     "/chat",
+					Body:        []byte(`"hi"`),
+					BodyDecoded: &types.Value{Kind: &types.Value_StringValue{StringValue: "hi"}},
+				},
+			},
+		}),
+		"response": outputAsValue(&fm.Clt_CallResponseRaw_Output{
+			Output: &fm.Clt_CallResponseRaw_Output_WebsocketResponse{
+				WebsocketResponse: &fm.Clt_CallResponseRaw_Output_WebSocketResponse{
+					Messages: []*fm.Clt_CallResponseRaw_Output_WebSocketResponse_Message{
+						{Sent: true, Data: []byte(`"hi"`)},
+						{Data: []byte(`{"ok":true}`), DataDecoded: ack, ElapsedNs: 42},
+						{Data: []byte(`nope`), ElapsedNs: 43},
+					},
+					ElapsedNs: 1337,
+				},
+			},
+		}),
+	}
+
+	for expr, expected := range map[string]string{
+		`request.url`:                     `"ws://localhost:8080/chat"`,
+		`request.channel`:                 `"/chat"`,
+		`request.body`:                    `"hi"`,
+		`len(response.messages)`:          `3`,
+		`response.messages[0].sent`:       `True`,
+		`response.messages[1].sent`:       `False`,
+		`response.messages[1].body["ok"]`: `True`,
+		`response.messages[1].elapsed_ns`: `42`,
+		`response.messages[2].body`:       `None`,
+		`response.messages[2].data`:       `"nope"`,
+		`response.elapsed_ns`:             `1337`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			v, err := starlark.Eval(&starlark.Thread{Name: "test"}, "test", expr, env)
+			require.NoError(t, err)
+			require.Equal(t, expected, v.String())
+		})
+	}
+}
@@ -278,3 +278,108 @@ Check(
 		require.Equal(t, uint64(7), v.ExecutionSteps)
 	}
 }
+
+func TestCheckAssertsOnTimings(t *testing.T) {
+	name := "asserts_on_timings"
+	rt, err := newFakeMonkey(simplestPrelude + `
+Check(
+	name = "` + name + `",
+	after_response = lambda ctx: assert.that(ctx.response.timings.ttfb_ns).is_within(1000).of(543210),
+)`)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+
+	for range make([]struct{}, iters) {
+		v := rt.runFakeUserCheck(t, name)
+		require.Equal(t, name, v.Name)
+		require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+		require.Equal(t, fm.Clt_CallVerifProgress_after_response, v.Origin)
+		require.Empty(t, v.Reason)
+		require.NotEmpty(t, v.ElapsedNs)
+		require.Equal(t, uint64(17), v.ExecutionSteps)
+	}
+}
+
+func TestCheckAssertsOnConnection(t *testing.T) {
+	name := "asserts_on_connection"
+	rt, err := newFakeMonkey(simplestPrelude + `
+Check(
+	name = "` + name + `",
+	after_response = lambda ctx: assert.that(ctx.response.connection.tls.version).is_equal_to("TLS 1.3"),
+)`)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+
+	for range make([]struct{}, iters) {
+		v := rt.runFakeUserCheck(t, name)
+		require.Equal(t, name, v.Name)
+		require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+		require.Equal(t, fm.Clt_CallVerifProgress_after_response, v.Origin)
+		require.Empty(t, v.Reason)
+		require.NotEmpty(t, v.ElapsedNs)
+		require.Equal(t, uint64(15), v.ExecutionSteps)
+	}
+}
+
+func TestCheckAssertsOnCookies(t *testing.T) {
+	name := "asserts_on_cookies"
+	rt, err := newFakeMonkey(simplestPrelude + `
+Check(
+	name = "` + name + `",
+	after_response = lambda ctx: assert.that(ctx.response.cookies["session"]).is_not_equal_to(ctx.request.cookies["session"]),
+)`)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+
+	for range make([]struct{}, iters) {
+		v := rt.runFakeUserCheck(t, name)
+		require.Equal(t, name, v.Name)
+		require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+		require.Equal(t, fm.Clt_CallVerifProgress_after_response, v.Origin)
+		require.Empty(t, v.Reason)
+		require.NotEmpty(t, v.ElapsedNs)
+		require.Equal(t, uint64(19), v.ExecutionSteps)
+	}
+}
+
+func TestCheckAssertsOnEvents(t *testing.T) {
+	name := "asserts_on_events"
+	rt, err := newFakeMonkey(simplestPrelude + `
+Check(
+	name = "` + name + `",
+	after_response = lambda ctx: assert.that(ctx.response.events[0].body["id"]).is_equal_to(int(ctx.response.events[0].id)),
+)`)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+
+	for range make([]struct{}, iters) {
+		v := rt.runFakeUserCheck(t, name)
+		require.Equal(t, name, v.Name)
+		require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+		require.Equal(t, fm.Clt_CallVerifProgress_after_response, v.Origin)
+		require.Empty(t, v.Reason)
+		require.NotEmpty(t, v.ElapsedNs)
+		require.Equal(t, uint64(25), v.ExecutionSteps)
+	}
+}
+
+func TestCheckAssertsOnGraphQLRequests(t *testing.T) {
+	name := "asserts_on_graphql_requests"
+	rt, err := newFakeMonkey(simplestPrelude + `
+Check(
+	name = "` + name + `",
+	after_response = lambda ctx: assert.that(ctx.request.query).contains("todo(id: $id)") and assert.that(ctx.request.variables["id"]).is_equal_to("0"),
+)`)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+
+	for range make([]struct{}, iters) {
+		v := rt.runFakeUserCheck(t, name)
+		require.Equal(t, name, v.Name)
+		require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+		require.Equal(t, fm.Clt_CallVerifProgress_after_response, v.Origin)
+		require.Empty(t, v.Reason)
+		require.NotEmpty(t, v.ElapsedNs)
+		require.Equal(t, uint64(18), v.ExecutionSteps)
+	}
+}
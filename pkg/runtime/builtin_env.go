@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"go.starlark.net/starlark"
+	"golang.org/x/net/http/httpproxy"
 )
 
 func (rt *Runtime) bEnv(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
@@ -25,14 +26,7 @@ func (rt *Runtime) bEnv(th *starlark.Thread, b *starlark.Builtin, args starlark.
 	}
 	envStr := env.GoString()
 
-	if cachedStr, ok := rt.envRead[envStr]; ok {
-		log.Printf("[NFO] read (cached) env %q", envStr)
-		return starlark.String(cachedStr), nil
-	}
-
-	if read, ok := os.LookupEnv(envStr); ok {
-		rt.envRead[envStr] = read
-		log.Printf("[NFO] read env %q: %q", envStr, read)
+	if read, ok := rt.lookupEnv(envStr); ok {
 		return starlark.String(read), nil
 	}
 
@@ -44,3 +38,36 @@ func (rt *Runtime) bEnv(th *starlark.Thread, b *starlark.Builtin, args starlark.
 	log.Printf("[NFO] read (unset) env %q: %q", envStr, defStr)
 	return def, nil
 }
+
+// lookupEnv reads an environment variable once and records it if it is set
+func (rt *Runtime) lookupEnv(envStr string) (string, bool) {
+	if cachedStr, ok := rt.envRead[envStr]; ok {
+		log.Printf("[NFO] read (cached) env %q", envStr)
+		return cachedStr, true
+	}
+
+	if read, ok := os.LookupEnv(envStr); ok {
+		rt.envRead[envStr] = read
+		log.Printf("[NFO] read env %q: %q", envStr, read)
+		return read, true
+	}
+	return "", false
+}
+
+// readProxyEnvs looks up the variables http.ProxyFromEnvironment uses,
+// the way Env(...) would.
+func (rt *Runtime) readProxyEnvs() *httpproxy.Config {
+	first := func(envs ...string) string {
+		for _, env := range envs {
+			if read, ok := rt.lookupEnv(env); ok && read != "" {
+				return read
+			}
+		}
+		return ""
+	}
+	return &httpproxy.Config{
+		HTTPProxy:  first("HTTP_PROXY", "http_proxy"),
+		HTTPSProxy: first("HTTPS_PROXY", "https_proxy"),
+		NoProxy:    first("NO_PROXY", "no_proxy"),
+	}
+}
@@ -64,3 +64,17 @@ func TestEnvUnsetVarWithDefault(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, rt.globals["value"], starlark.String("orelse"))
 }
+
+func TestProxyEnvsAreRecorded(t *testing.T) {
+	err := os.Setenv("HTTPS_PROXY", "http://127.0.0.1:3128")
+	require.NoError(t, err)
+	defer func() {
+		err := os.Unsetenv("HTTPS_PROXY")
+		require.NoError(t, err)
+	}()
+
+	rt, err := newFakeMonkey(simplestPrelude)
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:3128", rt.envRead["HTTPS_PROXY"])
+	require.Equal(t, "http://127.0.0.1:3128", rt.proxyEnvs.HTTPSProxy)
+}
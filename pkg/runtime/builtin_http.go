@@ -0,0 +1,128 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const defaultHTTPHelperTimeout = 10 * time.Second
+
+// httpHelperClient talks to the SUT directly, whatever HTTP_PROXY says.
+// Requests are bounded by their timeout and by the reset function's.
+var httpHelperClient = &http.Client{
+	Transport: &http.Transport{Proxy: nil},
+	Timeout:   defaultStarlarkResetTimeout,
+}
+
+// httpModule is a small HTTP client meant for Starlark-defined resets,
+// e.g. http.post("http://localhost:8080/__reset", headers = {"X-Admin": "1"})
+// Its functions may only be called while a reset function runs.
+func (rt *Runtime) httpModule() *starlarkstruct.Module {
+	members := make(starlark.StringDict, 5)
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+		method, name := method, strings.ToLower(method)
+		members[name] = starlark.NewBuiltin("http."+name,
+			func(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				return rt.httpRequest(th, b, append(starlark.Tuple{starlark.String(method)}, args...), kwargs)
+			})
+	}
+	members["request"] = starlark.NewBuiltin("http.request", rt.httpRequest)
+	return &starlarkstruct.Module{
+		Name:    "http",
+		Members: members,
+	}
+}
+
+func (rt *Runtime) httpRequest(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		method, url, body starlark.String
+		headers           *starlark.Dict
+		timeout           = starlark.String(defaultHTTPHelperTimeout.String())
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"method", &method,
+		"url", &url,
+		"headers?", &headers,
+		"body?", &body,
+		"timeout?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+
+	ctx, ok := th.Local(localThreadKeyForReset).(context.Context)
+	if !ok {
+		return nil, fmt.Errorf("%s: can only be called from ExecStart, ExecReset or ExecStop functions", b.Name())
+	}
+
+	d, err := time.ParseDuration(timeout.GoString())
+	if err != nil || d <= 0 {
+		return nil, fmt.Errorf("%s: timeout must be a positive duration such as \"10s\", got: %s", b.Name(), timeout.GoString())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method.GoString()), url.GoString(), strings.NewReader(body.GoString()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	req.Header.Set("User-Agent", rt.binTitle)
+	if headers != nil {
+		for _, kv := range headers.Items() {
+			k, ok := kv[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: headers keys must be strings, got: %s", b.Name(), kv[0].Type())
+			}
+			v, ok := kv[1].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s: headers values must be strings, got: %s", b.Name(), kv[1].Type())
+			}
+			req.Header.Set(k.GoString(), v.GoString())
+		}
+	}
+
+	log.Printf("[NFO] %s %s %s", b.Name(), req.Method, req.URL)
+	rep, err := httpHelperClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	defer rep.Body.Close()
+	content, err := ioutil.ReadAll(rep.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+
+	repHeaders := make(starlark.StringDict, len(rep.Header))
+	for key, values := range rep.Header {
+		vs := make([]starlark.Value, 0, len(values))
+		for _, value := range values {
+			vs = append(vs, starlark.String(value))
+		}
+		repHeaders[key] = starlark.NewList(vs)
+	}
+	s := starlark.StringDict{
+		"status_code": starlark.MakeInt(rep.StatusCode),
+		"reason":      starlark.String(rep.Status),
+		"headers":     &starlarkstruct.Module{Name: "headers", Members: repHeaders},
+		"content":     starlark.String(content),
+		"body":        starlark.None,
+	}
+	var x types.Value
+	if len(content) != 0 && jsonpb.UnmarshalString(string(content), &x) == nil {
+		s["body"] = starlarkvalue.FromProtoValue(&x)
+	}
+	return &starlarkstruct.Module{
+		Name:    "response",
+		Members: s,
+	}, nil
+}
@@ -3,6 +3,11 @@ package runtime
 import (
 	"fmt"
 
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/httpreset"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/shell"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/snapshot"
 	"go.starlark.net/starlark"
 )
 
@@ -17,6 +22,15 @@ func (rt *Runtime) builtins() map[string]builtin {
 	return map[string]builtin{
 		"Check": rt.bCheck,
 		"Env":   rt.bEnv,
+		// Request signers
+		"hmac":  openapiv3.HMAC,
+		"sigv4": openapiv3.SigV4,
+		// Resetters
+		"HTTPResetter": rt.namedResetter(httpreset.Builtin),
+		"Process":      rt.namedResetter(process.Builtin),
+		"Resetter":     rt.bResetter,
+		"Shell":        rt.namedResetter(shell.Builtin),
+		"Snapshot":     rt.namedResetter(snapshot.Builtin),
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarktruth"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
@@ -44,9 +45,14 @@ func (rt *Runtime) call(ctx context.Context, msg *fm.Srv_Call, tagsFilter *tags.
 	}
 	ctxer2 := ctxCurry(input.GetInput())
 
-	cllr.Do(ctx)
+	start := time.Now()
+	callerDo(ctx, cllr)
 
 	output := cllr.ResponseProto()
+	if lc, ok := rt.resetterInUse().(resetter.LogsCapturer); ok {
+		output.Logs = lc.Logs(start, time.Now())
+		log.Printf("[NFO] captured %d log lines", len(output.Logs))
+	}
 	log.Printf("[NFO] call output: %.999v", output)
 	if errT := rt.client.Send(ctx, &fm.Clt{Msg: &fm.Clt_CallResponseRaw_{
 		CallResponseRaw: output,
@@ -58,8 +64,17 @@ func (rt *Runtime) call(ctx context.Context, msg *fm.Srv_Call, tagsFilter *tags.
 		return errT
 	}
 
+	passed, errT := rt.callerRetries(ctx, cllr)
+	if errT != nil {
+		return errT
+	}
+	if !passed {
+		// Server kept rate limiting: the response cannot be checked
+		return nil
+	}
+
 	// Just the amount of checks needed to be able to call cllr.Response()
-	passed, errT := rt.callerChecks(ctx, cllr)
+	passed, errT = rt.callerChecks(ctx, cllr)
 	if errT != nil {
 		return errT
 	}
@@ -100,6 +115,72 @@ func cvp(msg *fm.Clt_CallVerifProgress) *fm.Clt {
 	return &fm.Clt{Msg: &fm.Clt_CallVerifProgress_{CallVerifProgress: msg}}
 }
 
+// callerDo performs the call, receiving messages one by one from streaming callers
+func callerDo(ctx context.Context, cllr modeler.Caller) {
+	sc, ok := cllr.(modeler.StreamingCaller)
+	if !ok {
+		cllr.Do(ctx)
+		return
+	}
+	sc.Send(ctx)
+	received := 0
+	for sc.Receive(ctx) {
+		received++
+		log.Printf("[NFO] received message #%d", received)
+	}
+}
+
+// callerRetries reports retries made by the caller as asked by the server,
+// with a status distinct from a check's result.
+// It fails a built-in check when the server was still throttling the call.
+func (rt *Runtime) callerRetries(ctx context.Context, cllr modeler.Caller) (bool, error) {
+	rc, ok := cllr.(modeler.RetryingCaller)
+	if !ok {
+		return true, nil
+	}
+	retries, throttled := rc.Retries()
+
+	if len(retries) != 0 {
+		v := &fm.Clt_CallVerifProgress{
+			Name:   "Retry-After honored",
+			Origin: fm.Clt_CallVerifProgress_built_in,
+			Status: fm.Clt_CallVerifProgress_retried,
+			Reason: retries,
+		}
+		log.Printf("[NFO] call retried: %v", retries)
+		rt.progress.CheckSkipped(v.Name, retries[len(retries)-1])
+
+		if errT := rt.client.Send(ctx, cvp(v)); errT != nil {
+			log.Println("[ERR]", errT)
+			return false, errT
+		}
+		if errT := rt.recvFuzzingProgress(ctx); errT != nil {
+			return false, errT
+		}
+	}
+
+	if throttled == "" {
+		return true, nil
+	}
+	v := &fm.Clt_CallVerifProgress{
+		Name:   "server stopped rate limiting",
+		Origin: fm.Clt_CallVerifProgress_built_in,
+		Status: fm.Clt_CallVerifProgress_failure,
+		Reason: []string{throttled},
+	}
+	log.Printf("[NFO] check failed: %v", v.Reason)
+	rt.progress.CheckFailed(v.Name, v.Reason)
+
+	if errT := rt.client.Send(ctx, cvp(v)); errT != nil {
+		log.Println("[ERR]", errT)
+		return false, errT
+	}
+	if errT := rt.recvFuzzingProgress(ctx); errT != nil {
+		return false, errT
+	}
+	return false, nil
+}
+
 // NOTE: callerChecks are applied sequentially in order of definition.
 // Model state can be mutated by each check.
 func (rt *Runtime) callerChecks(ctx context.Context, cllr modeler.Caller) (bool, error) {
@@ -1,15 +1,21 @@
 package runtime
 
 import (
+	"fmt"
+
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 )
 
-func (rt *Runtime) FilterEndpoints(criteria []string) (err error) {
+// FilterEndpoints selects the endpoints to test
+func (rt *Runtime) FilterEndpoints(filter *modeler.EndpointsFilter) (err error) {
 	var mdl modeler.Interface
 	for _, mdl = range rt.models {
 		break
 	}
 
-	rt.eIds, err = mdl.FilterEndpoints(criteria)
+	showf := func(format string, s ...interface{}) {
+		fmt.Printf(format+"\n", s...)
+	}
+	rt.eIds, err = mdl.FilterEndpoints(filter, showf)
 	return
 }
@@ -50,7 +50,7 @@ func (rt *Runtime) Fuzz(
 	for _, mdl = range rt.models {
 		break
 	}
-	rsttr := mdl.GetResetter()
+	rsttr := rt.resetterInUse()
 	rsttr.Env(rt.envRead)
 
 	log.Printf("[DBG] sending initial msg")
@@ -41,6 +41,13 @@ func (rt *Runtime) runFakeUserCheck(t *testing.T, chkname string) *fm.Clt_CallVe
 				Url:    "https://jsonplaceholder.typicode.com/todos/0",
 				Headers: map[string]*fm.Clt_CallRequestRaw_Input_HttpRequest_HeaderValues{
 					"Accept": {Values: []string{"application/json"}},
+					"Cookie": {Values: []string{"session=s3cr3t; lang=en"}},
+				},
+				Graphql: &fm.Clt_CallRequestRaw_Input_HttpRequest_GraphQL{
+					Query: "query($id: ID!) { todo(id: $id) { id } }",
+					Variables: &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{
+						Fields: map[string]*types.Value{"id": {Kind: &types.Value_StringValue{StringValue: "0"}}},
+					}}},
 				},
 			},
 		},
@@ -64,10 +71,37 @@ func (rt *Runtime) runFakeUserCheck(t *testing.T, chkname string) *fm.Clt_CallVe
 					"Etag":                             {Values: []string{`W/"2-vyGp6PvFo4RvsFtPoIWeCReyIC8"`}},
 					"Expect-Ct":                        {Values: []string{`max-age=604800, report-uri="https://report-uri.cloudflare.com/cdn-cgi/beacon/expect-ct"`}},
 					"Expires":                          {Values: []string{"-1"}},
+					"Set-Cookie":                       {Values: []string{"session=n3w; Path=/; HttpOnly"}},
 				},
 				Body:        []byte("{}"),
 				BodyDecoded: &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{}}},
 				ElapsedNs:   776674,
+				Timings: &fm.Clt_CallResponseRaw_Output_HttpResponse_Timings{
+					DnsNs:          1337,
+					ConnectNs:      12345,
+					TlsHandshakeNs: 123456,
+					TtfbNs:         543210,
+					TransferNs:     4321,
+				},
+				Connection: &fm.Clt_CallResponseRaw_Output_HttpResponse_Connection{
+					RemoteIp:   "104.21.32.1",
+					RemotePort: 443,
+					LocalIp:    "192.168.1.42",
+					LocalPort:  54321,
+					Protocol:   "HTTP/2.0",
+					Tls: &fm.Clt_CallResponseRaw_Output_HttpResponse_Connection_TLS{
+						Version:     "TLS 1.3",
+						CipherSuite: "TLS_AES_128_GCM_SHA256",
+						PeerSubject: "CN=typicode.com",
+					},
+				},
+				Events: []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event{{
+					Id:   "1",
+					Data: `{"id":1}`,
+					DataDecoded: &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{
+						Fields: map[string]*types.Value{"id": {Kind: &types.Value_NumberValue{NumberValue: 1}}},
+					}}},
+				}},
 			},
 		},
 	})
@@ -13,5 +13,12 @@ func (rt *Runtime) Lint(ctx context.Context, showSpec bool) error {
 		break
 	}
 
-	return mdl.Lint(ctx, showSpec)
+	if err := mdl.Lint(ctx, showSpec); err != nil {
+		return err
+	}
+
+	for path, contents := range mdl.Files() {
+		rt.files[path] = contents
+	}
+	return nil
 }
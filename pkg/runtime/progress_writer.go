@@ -3,6 +3,7 @@ package runtime
 import (
 	"bytes"
 	"io"
+	"sync"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 )
@@ -11,19 +12,23 @@ var _ io.Writer = (*progressWriter)(nil)
 
 // progressWriter redirects buffer lines through to a Progresser
 type progressWriter struct {
+	mu     *sync.Mutex
 	printf modeler.ShowFunc
 }
 
-func newProgressWriter(cb modeler.ShowFunc) *progressWriter {
-	return &progressWriter{printf: cb}
+// newProgressWriters muxes stdout & stderr lines through to a Progresser
+func newProgressWriters(stdout, stderr modeler.ShowFunc) (*progressWriter, *progressWriter) {
+	var mu sync.Mutex
+	return &progressWriter{mu: &mu, printf: stdout}, &progressWriter{mu: &mu, printf: stderr}
 }
 
 func (pw *progressWriter) Write(p []byte) (n int, err error) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
 	n = len(p)
 	for _, pp := range bytes.Split(p, []byte{'\r', '\n'}) {
 		for _, ppp := range bytes.Split(pp, []byte{'\n'}) {
 			if len(ppp) != 0 {
-				// TODO: mux stderr+stdout and fwd to server to track progress
 				pw.printf("%s", ppp)
 			}
 		}
@@ -4,16 +4,26 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"unicode"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/asyncapi"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/graphql"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/grpc"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openrpc"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
 	"go.starlark.net/starlark"
 )
 
 var registeredModelers = map[string]modeler.Interface{
+	"AsyncAPI":  (*asyncapi.T)(nil),
+	"GRPC":      (*grpc.T)(nil),
+	"GraphQL":   (*graphql.T)(nil),
 	"OpenAPIv3": (*openapiv3.T)(nil),
+	"OpenRPC":   (*openrpc.T)(nil),
 }
 
 func (rt *Runtime) modelMaker(modelerName string, mdlr modeler.Func) builtin {
@@ -31,7 +41,10 @@ func (rt *Runtime) modelMaker(modelerName string, mdlr modeler.Func) builtin {
 			return
 		}
 
-		var modelName string
+		var (
+			modelName string
+			named     starlark.Value
+		)
 		u := make(starlark.StringDict, len(kwargs))
 		r := make(starlark.StringDict, len(kwargs))
 		for _, kv := range kwargs {
@@ -51,16 +64,25 @@ func (rt *Runtime) modelMaker(modelerName string, mdlr modeler.Func) builtin {
 				}
 			}
 
-			var reserved bool
-			if reserved, err = printableASCIItmp(key); err != nil { // FIXME: no more CamelReserved kwargs
+			if err = printableASCII(key); err != nil {
 				err = fmt.Errorf("illegal field: %v", err)
 				log.Println("[ERR]", err)
 				return
 			}
-			if !reserved {
-				u[key] = v
-			} else {
+			switch key {
+			case "resetter":
+				named = v
+			case tExecStart, tExecReset, tExecStop, tExecTimeout, tResetter:
+				// Legacy kwargs coupling a resetter to this model only
 				r[key] = v
+			default:
+				if unicode.IsUpper(rune(key[0])) {
+					err = fmt.Errorf("%s(%s = ...) is unknown: capitalized fields are %s, %s, %s, %s & %s",
+						fname, key, tExecStart, tExecReset, tExecStop, tExecTimeout, tResetter)
+					log.Println("[ERR]", err)
+					return
+				}
+				u[key] = v
 			}
 		}
 
@@ -82,9 +104,18 @@ func (rt *Runtime) modelMaker(modelerName string, mdlr modeler.Func) builtin {
 			return
 		}
 
-		// FIXME: actually only couple resetter & modeler through model name
 		var rsttr resetter.Interface
-		if rsttr, err = newFromKwargs(fname, r); err != nil {
+		if named != nil {
+			if len(r) != 0 {
+				err = fmt.Errorf("%s(resetter = ...) cannot be combined with: %s", fname, strings.Join(r.Keys(), ", "))
+				log.Println("[ERR]", err)
+				return
+			}
+			if rsttr, err = rt.resetterNamed(fname, named); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+		} else if rsttr, err = rt.newFromKwargs(fname, r); err != nil {
 			return
 		}
 		model.SetResetter(rsttr)
@@ -94,6 +125,11 @@ func (rt *Runtime) modelMaker(modelerName string, mdlr modeler.Func) builtin {
 			log.Println("[ERR]", err)
 			return
 		}
+		if len(rt.models) != 0 {
+			err = errors.New("only one model is supported for now")
+			log.Println("[ERR]", err)
+			return
+		}
 		rt.models[modelName] = model
 		return
 	}
@@ -0,0 +1,35 @@
+//+build fakefs
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelKwargsErrors(t *testing.T) {
+	for kwargs, expected := range map[string]string{
+		`ExecRest = "make reset"`: "OpenAPIv3(ExecRest = ...) is unknown: capitalized fields are ExecStart, ExecReset, ExecStop, ExecTimeout & Resetter",
+		`Host = "http://h"`:       "OpenAPIv3(Host = ...) is unknown: capitalized fields are ExecStart, ExecReset, ExecStop, ExecTimeout & Resetter",
+		`hots = "http://h"`:       "OpenAPIv3(hots = ...) must be unset (unknown field), got: string",
+	} {
+		t.Run(kwargs, func(t *testing.T) {
+			rt, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + "    " + kwargs + ",\n)")
+			require.EqualError(t, err, expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestOnlyOneModel(t *testing.T) {
+	rt, err := newFakeMonkey(simplestPrelude + `
+OpenAPIv3(
+    name = "other_model",
+    file = "pkg/modeler/openapiv3/testdata/jsonplaceholder.typicode.comv1.0.0_openapiv3.0.1_spec.yml",
+    host = "https://jsonplaceholder.typicode.com",
+)
+`)
+	require.EqualError(t, err, "only one model is supported for now")
+	require.Nil(t, rt)
+}
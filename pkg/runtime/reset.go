@@ -11,7 +11,12 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/httpreset"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/sequence"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/shell"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/snapshot"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
 	"go.starlark.net/starlark"
 )
 
@@ -25,16 +30,16 @@ func (rt *Runtime) Cleanup(ctx context.Context) (err error) {
 	as.ColorNFO.Println("Cleaning up...")
 
 	log.Println("[NFO] terminating resetter")
-	var rsttr resetter.Interface
-	for _, mdl := range rt.models {
-		rsttr = mdl.GetResetter()
-		break
-	}
-	if errR := rsttr.Terminate(ctx, os.Stdout, os.Stderr); errR != nil && err == nil {
+	if errR := rt.resetterInUse().Terminate(ctx, os.Stdout, os.Stderr); errR != nil && err == nil {
 		err = errR
 		// Keep going
 	}
 
+	log.Println("[NFO] closing connections")
+	for _, mdl := range rt.models {
+		mdl.Close()
+	}
+
 	rt.cleanedup = true
 	return
 }
@@ -92,10 +97,8 @@ func (rt *Runtime) reset(ctx context.Context) error {
 }
 
 func (rt *Runtime) runReset(ctx context.Context) (err error) {
-	var rsttr resetter.Interface
 	for _, mdl := range rt.models {
-		rsttr = mdl.GetResetter()
-		break
+		mdl.ResetCaller()
 	}
 
 	for name, chk := range rt.checks {
@@ -106,52 +109,286 @@ func (rt *Runtime) runReset(ctx context.Context) (err error) {
 	}
 	log.Println("[NFO] re-initialized model state")
 
-	stdout := newProgressWriter(rt.progress.Printf)
-	stderr := newProgressWriter(rt.progress.Errorf)
-	err = rsttr.ExecReset(ctx, stdout, stderr, false)
+	stdout, stderr := newProgressWriters(rt.progress.Printf, rt.progress.Errorf)
+	err = rt.resetterInUse().ExecReset(ctx, stdout, stderr, false)
 	return
 }
 
-func newFromKwargs(modelerName string, r starlark.StringDict) (resetter.Interface, error) {
-	const (
-		tExecReset = "ExecReset"
-		tExecStart = "ExecStart"
-		tExecStop  = "ExecStop"
-	)
+// resetterInUse is the resetter of the only model
+func (rt *Runtime) resetterInUse() (rsttr resetter.Interface) {
+	for _, mdl := range rt.models {
+		rsttr = mdl.GetResetter()
+	}
+	return
+}
+
+// Legacy kwargs coupling a resetter to a modeler
+const (
+	tExecReset   = "ExecReset"
+	tExecStart   = "ExecStart"
+	tExecStop    = "ExecStop"
+	tExecTimeout = "ExecTimeout"
+	tResetter    = "Resetter"
+)
+
+func (rt *Runtime) newFromKwargs(modelerName string, r starlark.StringDict) (resetter.Interface, error) {
+	t := tResetter
+	if v, ok := r[t]; ok {
+		delete(r, t)
+		var rsttr resetter.Interface
+		switch vv := v.(type) {
+		case *process.Resetter:
+			rsttr = vv
+		case *httpreset.Resetter:
+			rsttr = vv
+		case *shell.Resetter:
+			rsttr = vv
+		case *snapshot.Resetter:
+			rsttr = vv
+		default:
+			return nil, fmt.Errorf("%s(%s = ...) must be a Process(...), an HTTPResetter(...), a Shell(...) or a Snapshot(...)", modelerName, t)
+		}
+		if len(r) != 0 {
+			return nil, fmt.Errorf("%s(%s = ...) cannot be combined with: %s", modelerName, t, strings.Join(r.Keys(), ", "))
+		}
+		return rsttr, nil
+	}
+
+	t = tExecTimeout
+	timeout, hasTimeout := r[t]
+	delete(r, t)
+	rsttr, err := rt.newFromExecs(modelerName, [3]string{tExecStart, tExecReset, tExecStop}, r)
+	if err != nil || !hasTimeout {
+		return rsttr, err
+	}
+	fnRsttr, ok := rsttr.(*starlarkResetter)
+	if !ok {
+		return nil, fmt.Errorf("%s(%s = ...) only applies to functions", modelerName, t)
+	}
+	d, ok := positiveDuration(timeout)
+	if !ok {
+		return nil, fmt.Errorf("%s(%s = ...) must be a positive duration such as \"2m\", got: %s", modelerName, t, timeout.String())
+	}
+	fnRsttr.ExecTimeoutNs = int64(d)
+	return fnRsttr, nil
+}
+
+func positiveDuration(v starlark.Value) (time.Duration, bool) {
+	str, ok := v.(starlark.String)
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(str.GoString())
+	return d, err == nil && d > 0
+}
+
+// newFromExecs pops start, reset & stop fields (named ts) off r then
+// makes a shell resetter out of strings or a Starlark one out of functions.
+func (rt *Runtime) newFromExecs(fname string, ts [3]string, r starlark.StringDict) (resetter.Interface, error) {
 	var (
-		ok bool
-		v  starlark.Value
-		vv starlark.String
-		t  string
-		// TODO: other Resetter.s
-		rsttr = &shell.Resetter{}
+		shellRsttr = &shell.Resetter{}
+		fnRsttr    = &starlarkResetter{rt: rt}
+		strs, fns  int
 	)
-	t = tExecStart
-	if v, ok = r[t]; ok {
+	for i, x := range []struct {
+		str, fname *string
+		fn         **starlark.Function
+	}{
+		{&shellRsttr.Start, &fnRsttr.Start, &fnRsttr.start},
+		{&shellRsttr.Rst, &fnRsttr.Rst, &fnRsttr.rst},
+		{&shellRsttr.Stop, &fnRsttr.Stop, &fnRsttr.stop},
+	} {
+		t := ts[i]
+		v, ok := r[t]
+		if !ok {
+			continue
+		}
 		delete(r, t)
-		if vv, ok = v.(starlark.String); !ok {
-			return nil, fmt.Errorf("%s(%s = ...) must be a string", modelerName, t)
+		switch vv := v.(type) {
+		case starlark.String:
+			*x.str = vv.GoString()
+			strs++
+		case *starlark.Function:
+			if vv.NumParams() != 0 || vv.HasVarargs() || vv.HasKwargs() {
+				return nil, fmt.Errorf("%s(%s = ...) must be a function taking no arguments", fname, t)
+			}
+			*x.fn, *x.fname = vv, vv.Name()
+			fns++
+		default:
+			return nil, fmt.Errorf("%s(%s = ...) must be a string or a function", fname, t)
 		}
-		rsttr.Start = vv.GoString()
 	}
-	t = tExecReset
-	if v, ok = r[t]; ok {
-		delete(r, t)
-		if vv, ok = v.(starlark.String); !ok {
-			return nil, fmt.Errorf("%s(%s = ...) must be a string", modelerName, t)
+	if strs != 0 && fns != 0 {
+		return nil, fmt.Errorf("%s(%s, %s & %s) must either all be strings or all be functions", fname, ts[0], ts[1], ts[2])
+	}
+	if fns != 0 {
+		return fnRsttr, nil
+	}
+	return shellRsttr, nil
+}
+
+// bResetter declares a resetter that models then reference by name.
+// Strings run as in Shell(...) whereas functions run as Starlark code:
+// Resetter(name = "db", reset = "make reset-db")
+func (rt *Runtime) bResetter(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	name, kwargs := popName(kwargs)
+	if name == nil {
+		err := fmt.Errorf("%s: missing argument for name", b.Name())
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	fns := false
+	for _, kv := range kwargs {
+		if _, ok := kv.Index(1).(*starlark.Function); ok {
+			fns = true
 		}
-		rsttr.Rst = vv.GoString()
 	}
-	t = tExecStop
-	if v, ok = r[t]; ok {
-		delete(r, t)
-		if vv, ok = v.(starlark.String); !ok {
-			return nil, fmt.Errorf("%s(%s = ...) must be a string", modelerName, t)
+	if !fns {
+		v, err := shell.Builtin(th, b, args, kwargs)
+		if err != nil {
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+		if err := rt.registerResetter(b.Name(), name, v.(resetter.Interface)); err != nil {
+			return nil, err
+		}
+		return starlark.None, nil
+	}
+
+	const fStart, fRst, fStop, fTimeout = "start", "reset", "stop", "exec_timeout"
+	var start, rst, stop, timeout starlark.Value
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		fStart+"?", &start,
+		fRst+"?", &rst,
+		fStop+"?", &stop,
+		fTimeout+"?", &timeout,
+	); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	if hasStart := start != nil; hasStart != (stop != nil) {
+		err := fmt.Errorf("%s: %s and %s must be set together", b.Name(), fStart, fStop)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	r := make(starlark.StringDict, 3)
+	for t, v := range map[string]starlark.Value{fStart: start, fRst: rst, fStop: stop} {
+		if v != nil {
+			r[t] = v
+		}
+	}
+
+	rsttr, err := rt.newFromExecs(b.Name(), [3]string{fStart, fRst, fStop}, r)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	if timeout != nil {
+		d, ok := positiveDuration(timeout)
+		if !ok {
+			err := fmt.Errorf("%s: %s must be a positive duration such as \"2m\", got: %s", b.Name(), fTimeout, timeout.String())
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+		rsttr.(*starlarkResetter).ExecTimeoutNs = int64(d)
+	}
+	if err := rt.registerResetter(b.Name(), name, rsttr); err != nil {
+		return nil, err
+	}
+	return starlark.None, nil
+}
+
+// namedResetter wraps a resetter builtin so it accepts an optional name
+// under which models can reference the resetter it returns.
+func (rt *Runtime) namedResetter(f builtin) builtin {
+	return func(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		name, kwargs := popName(kwargs)
+		v, err := f(th, b, args, kwargs)
+		if err != nil || name == nil {
+			return v, err
+		}
+		if err = rt.registerResetter(b.Name(), name, v.(resetter.Interface)); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func popName(kwargs []starlark.Tuple) (name starlark.Value, others []starlark.Tuple) {
+	others = make([]starlark.Tuple, 0, len(kwargs))
+	for _, kv := range kwargs {
+		if kv.Index(0).(starlark.String).GoString() == "name" {
+			name = kv.Index(1)
+			continue
+		}
+		others = append(others, kv)
+	}
+	return
+}
+
+func (rt *Runtime) registerResetter(fname string, v starlark.Value, rsttr resetter.Interface) (err error) {
+	str, ok := v.(starlark.String)
+	if !ok {
+		err = fmt.Errorf("%s: name must be a string, got: %s", fname, v.Type())
+		log.Println("[ERR]", err)
+		return
+	}
+	name := str.GoString()
+	if err = tags.LegalName(name); err != nil {
+		err = fmt.Errorf("%s: %v", fname, err)
+		log.Println("[ERR]", err)
+		return
+	}
+	if _, ok := rt.resetters[name]; ok {
+		err = fmt.Errorf("%s: resetter name %q is already defined", fname, name)
+		log.Println("[ERR]", err)
+		return
+	}
+	rt.resetters[name] = rsttr
+	return
+}
+
+// resetterNamed looks up resetters by name, composing them in order if there are many
+func (rt *Runtime) resetterNamed(modelerName string, v starlark.Value) (resetter.Interface, error) {
+	const field = "resetter"
+	var names []string
+	switch vv := v.(type) {
+	case starlark.String:
+		names = []string{vv.GoString()}
+	case *starlark.List, starlark.Tuple:
+		it := starlark.Iterate(vv)
+		defer it.Done()
+		var x starlark.Value
+		for it.Next(&x) {
+			name, ok := x.(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("%s(%s = ...) must be a list of strings, got: %s", modelerName, field, x.Type())
+			}
+			names = append(names, name.GoString())
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%s(%s = ...) must not be empty", modelerName, field)
+		}
+	default:
+		return nil, fmt.Errorf("%s(%s = ...) must be a string or a list of strings, got: %s", modelerName, field, v.Type())
+	}
+
+	rsttrs := make([]resetter.Interface, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("%s(%s = ...) must not name %q twice", modelerName, field, name)
+		}
+		seen[name] = struct{}{}
+		rsttr, ok := rt.resetters[name]
+		if !ok {
+			return nil, fmt.Errorf("%s(%s = ...): no resetter named %q, define it before the model", modelerName, field, name)
 		}
-		rsttr.Stop = vv.GoString()
+		rsttrs = append(rsttrs, rsttr)
 	}
-	if len(r) != 0 {
-		return nil, fmt.Errorf("unexpected arguments to %s(): %s", modelerName, strings.Join(r.Keys(), ", "))
+	if len(rsttrs) == 1 {
+		return rsttrs[0], nil
 	}
-	return rsttr, nil
+	return sequence.New(rsttrs...), nil
 }
@@ -0,0 +1,198 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarktruth"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
+	"go.starlark.net/starlark"
+)
+
+const defaultStarlarkResetTimeout = 2 * time.Minute
+
+// localThreadKeyForReset holds the context of the reset function a thread runs
+const localThreadKeyForReset = "monkey.reset"
+
+var _ resetter.Interface = (*starlarkResetter)(nil)
+
+// starlarkResetter implements resetter.Interface with Starlark functions.
+// Dicts returned by these seed the states of the Checks they name.
+type starlarkResetter struct {
+	fm.Clt_Fuzz_Resetter_Starlark
+
+	rt               *Runtime
+	start, rst, stop *starlark.Function
+
+	isNotFirstRun bool
+}
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (s *starlarkResetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	return &fm.Clt_Fuzz_Resetter{
+		Resetter: &fm.Clt_Fuzz_Resetter_Starlark_{
+			Starlark: &s.Clt_Fuzz_Resetter_Starlark,
+		}}
+}
+
+// Env passes envs read during startup. It does nothing here:
+// functions see the values Env(...) returned when the config was loaded.
+func (s *starlarkResetter) Env(read map[string]string) {}
+
+// ExecStart executes the setup phase of the System Under Test
+func (s *starlarkResetter) ExecStart(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	return s.call(ctx, stdout, s.start)
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (s *starlarkResetter) ExecReset(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	if only {
+		// Makes $ monkey exec reset run as if in between tests
+		s.isNotFirstRun = true
+	}
+
+	fns, err := s.functions()
+	if err != nil {
+		return err
+	}
+	s.isNotFirstRun = true
+
+	for _, fn := range fns {
+		if err := s.call(ctx, stdout, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (s *starlarkResetter) ExecStop(ctx context.Context, stdout io.Writer, stderr io.Writer, only bool) error {
+	return s.call(ctx, stdout, s.stop)
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (s *starlarkResetter) Terminate(ctx context.Context, stdout io.Writer, stderr io.Writer) (err error) {
+	if s.stop != nil {
+		if err = s.ExecStop(ctx, stdout, stderr, true); err != nil {
+			log.Println("[ERR]", err)
+		}
+	}
+	return
+}
+
+func (s *starlarkResetter) functions() (fns []*starlark.Function, err error) {
+	switch {
+	case s.rst != nil && (s.start == nil || s.isNotFirstRun):
+		log.Println("[NFO] running", s.Rst)
+		fns = []*starlark.Function{s.rst}
+
+	case s.rst != nil:
+		log.Println("[NFO] running", s.Start, "then", s.Rst)
+		fns = []*starlark.Function{s.start, s.rst}
+
+	case s.start != nil && s.stop != nil && s.isNotFirstRun:
+		log.Println("[NFO] running", s.Stop, "then", s.Start)
+		fns = []*starlark.Function{s.stop, s.start}
+
+	case s.start != nil:
+		log.Println("[NFO] running", s.Start)
+		fns = []*starlark.Function{s.start}
+
+	default:
+		err = errors.New("unhandled Reset() case")
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func (s *starlarkResetter) call(ctx context.Context, stdout io.Writer, fn *starlark.Function) (err error) {
+	if fn == nil {
+		err = errors.New("no usable function")
+		return
+	}
+
+	timeout := defaultStarlarkResetTimeout
+	if s.ExecTimeoutNs > 0 {
+		timeout = time.Duration(s.ExecTimeoutNs)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	th := &starlark.Thread{
+		Name:  fn.Name(),
+		Load:  loadDisabled,
+		Print: func(_ *starlark.Thread, msg string) { fmt.Fprintln(stdout, msg) },
+	}
+	th.SetLocal(localThreadKeyForReset, ctx)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			th.Cancel(ctx.Err().Error())
+		case <-done:
+		}
+	}()
+
+	start := time.Now()
+	ret, err := starlark.Call(th, fn, nil, nil)
+	if err == nil {
+		err = starlarktruth.Close(th)
+	}
+	if err == nil {
+		err = s.seed(fn.Name(), ret)
+	}
+	log.Printf("[NFO] called %s in %s", fn.Name(), time.Since(start))
+	if err != nil {
+		log.Println("[ERR]", err)
+		var reason string
+		if e, ok := err.(*starlark.EvalError); ok {
+			reason = e.Backtrace()
+		} else {
+			reason = err.Error()
+		}
+		err = resetter.NewError(strings.Split(reason, "\n"))
+	}
+	return
+}
+
+// seed merges states returned by a reset function into Checks' states
+func (s *starlarkResetter) seed(fname string, ret starlark.Value) error {
+	if ret == starlark.None {
+		return nil
+	}
+	states, ok := ret.(*starlark.Dict)
+	if !ok {
+		return newUserError("%s must return None or a dict of Check names to states, got (%s) %s", fname, ret.Type(), ret.String())
+	}
+
+	for _, kv := range states.Items() {
+		chkname, ok := kv[0].(starlark.String)
+		if !ok {
+			return newUserError("%s must return Check names as keys, got (%s) %s", fname, kv[0].Type(), kv[0].String())
+		}
+		chk, ok := s.rt.checks[chkname.GoString()]
+		if !ok {
+			return newUserError("%s returned a state for Check %s which does not exist", fname, chkname.String())
+		}
+		if err := ensureStateDict(chkname.GoString(), kv[1]); err != nil {
+			return err
+		}
+		if err := starlarkvalue.ProtoCompatible(kv[1]); err != nil {
+			return err
+		}
+		for _, item := range kv[1].(*starlark.Dict).Items() {
+			if err := chk.state.SetKey(item[0], item[1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+//+build fakefs
+
+package runtime
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/httpreset"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/sequence"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/shell"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+const processPrelude = `
+OpenAPIv3(
+    name = "some_model",
+    file = "pkg/modeler/openapiv3/testdata/jsonplaceholder.typicode.comv1.0.0_openapiv3.0.1_spec.yml",
+    host = "http://localhost:8080",
+    Resetter = Process(
+        cmd = ["./server", "--port=8080"],
+        ready_http = "http://localhost:8080/health",
+    ),
+`
+
+func TestResetterIsAProcess(t *testing.T) {
+	rt, err := newFakeMonkey(processPrelude + `)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &process.Resetter{}, rsttr)
+	p := rsttr.ToProto().GetProcess()
+	require.Equal(t, []string{"./server", "--port=8080"}, p.GetCmd())
+	require.Equal(t, "http://localhost:8080/health", p.GetReadyHttp())
+}
+
+func TestResetterIsNotAShellToo(t *testing.T) {
+	rt, err := newFakeMonkey(processPrelude + `    ExecReset = "echo reset",
+)`)
+	require.EqualError(t, err, `OpenAPIv3(Resetter = ...) cannot be combined with: ExecReset`)
+	require.Nil(t, rt)
+}
+
+func TestResetterMustBeAProcess(t *testing.T) {
+	rt, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + `    Resetter = "./server",
+)`)
+	require.EqualError(t, err, `OpenAPIv3(Resetter = ...) must be a Process(...), an HTTPResetter(...), a Shell(...) or a Snapshot(...)`)
+	require.Nil(t, rt)
+}
+
+func TestResetterIsAnHTTPRequest(t *testing.T) {
+	rt, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + `    Resetter = HTTPResetter(
+        url = "http://localhost:8080/__reset",
+        headers = {"authorization": "Bearer s3cr3t"},
+        expected_status = 204,
+    ),
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &httpreset.Resetter{}, rsttr)
+	p := rsttr.ToProto().GetHttp()
+	require.Equal(t, "POST", p.GetMethod())
+	require.Equal(t, "http://localhost:8080/__reset", p.GetUrl())
+	require.Equal(t, map[string]string{"Authorization": "Bearer s3cr3t"}, p.GetHeaders())
+	require.Equal(t, uint32(204), p.GetExpectedStatus())
+}
+
+func TestResetterIsAShell(t *testing.T) {
+	rt, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + `    Resetter = Shell(
+        reset = "make reset",
+        shell = "/usr/bin/env sh",
+        exec_timeout = "5m",
+        env_snapshot_timeout = "1s",
+        log_file = "server.log",
+    ),
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &shell.Resetter{}, rsttr)
+	p := rsttr.ToProto().GetShell()
+	require.Equal(t, "make reset", p.GetRst())
+	require.Equal(t, "/usr/bin/env sh", p.GetShell())
+	require.Equal(t, int64(5*time.Minute), p.GetExecTimeoutNs())
+	require.Equal(t, int64(time.Second), p.GetEnvSnapshotTimeoutNs())
+	require.Equal(t, "server.log", p.GetLogFile())
+}
+
+func TestResetterIsASnapshot(t *testing.T) {
+	rt, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + `    Resetter = Snapshot(
+        paths = ["app.db", "app.db-wal"],
+        process = Process(cmd = ["./server"], ready_tcp = ":8080"),
+    ),
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &snapshot.Resetter{}, rsttr)
+	p := rsttr.ToProto().GetSnapshot()
+	require.Equal(t, []string{"app.db", "app.db-wal"}, p.GetPaths())
+	require.Equal(t, []string{"./server"}, p.GetProcess().GetCmd())
+}
+
+func TestResetterIsStarlarkFunctions(t *testing.T) {
+	var calls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		require.Equal(t, "monkeh", r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"pets": 3}`))
+	}))
+	defer srv.Close()
+
+	rt, err := newFakeMonkey(`
+def start():
+    http.put("` + srv.URL + `/__start")
+
+def reset():
+    rep = http.post("` + srv.URL + `/__reset", headers = {"X-Admin": "1"})
+    assert.that(rep.status_code).is_equal_to(200)
+    return {"counts_pets": {"count": rep.body["pets"]}}
+
+def stop():
+    http.delete("` + srv.URL + `/__stop")
+
+OpenAPIv3(
+    name = "some_model",
+    file = "pkg/modeler/openapiv3/testdata/jsonplaceholder.typicode.comv1.0.0_openapiv3.0.1_spec.yml",
+    host = "https://jsonplaceholder.typicode.com",
+    ExecStart = start,
+    ExecReset = reset,
+    ExecStop = stop,
+)
+
+Check(
+    name = "counts_pets",
+    after_response = lambda ctx: None,
+    state = {"count": 0, "seen": []},
+)
+`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	p := rsttr.ToProto().GetStarlark()
+	require.Equal(t, "start", p.GetStart())
+	require.Equal(t, "reset", p.GetRst())
+	require.Equal(t, "stop", p.GetStop())
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, rt.checks["counts_pets"].reset("counts_pets"))
+		require.NoError(t, rsttr.ExecReset(ctx, ioutil.Discard, ioutil.Discard, false))
+		require.Equal(t, `{"count": 3.0, "seen": []}`, rt.checks["counts_pets"].state.String())
+	}
+	require.NoError(t, rsttr.Terminate(ctx, ioutil.Discard, ioutil.Discard))
+	require.Equal(t, []string{"PUT /__start", "POST /__reset", "POST /__reset", "DELETE /__stop"}, calls)
+}
+
+func TestHTTPOnlyWithinResetFunctions(t *testing.T) {
+	_, err := newFakeMonkey(`
+rep = http.get("http://localhost:1/")
+` + simplestPrelude)
+	require.EqualError(t, err, "http.get: can only be called from ExecStart, ExecReset or ExecStop functions")
+}
+
+func TestResetterFunctionTimesOut(t *testing.T) {
+	rt, err := newFakeMonkey(`
+def reset():
+    for _ in range(1 << 40):
+        pass
+
+` + simplestPrelude[:len(simplestPrelude)-2] + `    ExecReset = reset,
+    ExecTimeout = "100ms",
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.Equal(t, int64(100*time.Millisecond), rsttr.ToProto().GetStarlark().GetExecTimeoutNs())
+	err = rsttr.ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Contains(t, reason[len(reason)-1], context.DeadlineExceeded.Error())
+}
+
+func TestResetterExecTimeoutKwarg(t *testing.T) {
+	for kwargs, expected := range map[string]string{
+		`ExecReset = "make reset", ExecTimeout = "1m"`:   "OpenAPIv3(ExecTimeout = ...) only applies to functions",
+		`ExecReset = lambda: None, ExecTimeout = "-1m"`: `OpenAPIv3(ExecTimeout = ...) must be a positive duration such as "2m", got: "-1m"`,
+		`ExecReset = lambda: None, ExecTimeout = 60`:    `OpenAPIv3(ExecTimeout = ...) must be a positive duration such as "2m", got: 60`,
+	} {
+		t.Run(kwargs, func(t *testing.T) {
+			_, err := newFakeMonkey(simplestPrelude[:len(simplestPrelude)-2] + "    " + kwargs + ",\n)")
+			require.EqualError(t, err, expected)
+		})
+	}
+}
+
+func TestResetterFunctionFails(t *testing.T) {
+	rt, err := newFakeMonkey(`
+def reset():
+    assert.that("database is locked").is_empty()
+
+` + simplestPrelude[:len(simplestPrelude)-2] + `    ExecReset = reset,
+)`)
+	require.NoError(t, err)
+	err = rt.models["some_model"].GetResetter().ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+	require.IsType(t, &resetter.Error{}, err)
+	reason := err.(*resetter.Error).Reason()
+	require.Equal(t, "Traceback (most recent call last):", reason[0])
+	require.Contains(t, reason[len(reason)-1], "database is locked")
+}
+
+func TestResetterFunctionReturnsBadStates(t *testing.T) {
+	for ret, expected := range map[string]string{
+		`42`:                     "reset must return None or a dict of Check names to states, got (int) 42",
+		`{"no_such_check": {}}`:  `reset returned a state for Check "no_such_check" which does not exist`,
+		`{"some_check": [1, 2]}`: `state for Check "some_check" must be dict, got (list) [1, 2]`,
+	} {
+		t.Run(ret, func(t *testing.T) {
+			rt, err := newFakeMonkey(`
+def reset():
+    return ` + ret + `
+
+` + simplestPrelude[:len(simplestPrelude)-2] + `    ExecReset = reset,
+)
+
+Check(
+    name = "some_check",
+    after_response = lambda ctx: None,
+)
+`)
+			require.NoError(t, err)
+			err = rt.models["some_model"].GetResetter().ExecReset(context.Background(), ioutil.Discard, ioutil.Discard, false)
+			require.EqualError(t, err, "\nscript failed during Reset:\n"+expected)
+		})
+	}
+}
+
+func TestResetterExecsAreAllStringsOrAllFunctions(t *testing.T) {
+	for kwargs, expected := range map[string]string{
+		`ExecStart = "./start.sh", ExecReset = reset`: `OpenAPIv3(ExecStart, ExecReset & ExecStop) must either all be strings or all be functions`,
+		`ExecReset = lambda x: x`:                     `OpenAPIv3(ExecReset = ...) must be a function taking no arguments`,
+		`ExecReset = 42`:                              `OpenAPIv3(ExecReset = ...) must be a string or a function`,
+	} {
+		t.Run(kwargs, func(t *testing.T) {
+			rt, err := newFakeMonkey(`
+def reset():
+    pass
+
+` + simplestPrelude[:len(simplestPrelude)-2] + `    ` + kwargs + `,
+)`)
+			require.EqualError(t, err, expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestNamedResetterIsReferencedByModel(t *testing.T) {
+	rt, err := newFakeMonkey(`
+Shell(name = "db", reset = "make reset-db")
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = "db",
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &shell.Resetter{}, rsttr)
+	require.Equal(t, "make reset-db", rsttr.ToProto().GetShell().GetRst())
+	require.Same(t, rt.resetters["db"], rsttr)
+	require.Same(t, rsttr, rt.resetterInUse())
+}
+
+func TestNamedResettersCompose(t *testing.T) {
+	rt, err := newFakeMonkey(`
+def seed():
+    pass
+
+Resetter(name = "db", start = "./db.sh start", reset = "./db.sh reset", stop = "./db.sh stop")
+Process(name = "app", cmd = ["./server"], ready_tcp = ":8080")
+Resetter(name = "fixtures", reset = seed)
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = ["db", "app", "fixtures"],
+)`)
+	require.NoError(t, err)
+	rsttr := rt.models["some_model"].GetResetter()
+	require.IsType(t, &sequence.Resetter{}, rsttr)
+	rsttrs := rsttr.ToProto().GetSequence().GetResetters()
+	require.Len(t, rsttrs, 3)
+	require.Equal(t, "./db.sh stop", rsttrs[0].GetShell().GetStop())
+	require.Equal(t, []string{"./server"}, rsttrs[1].GetProcess().GetCmd())
+	require.Equal(t, "seed", rsttrs[2].GetStarlark().GetRst())
+}
+
+func TestNamedResetterTakesShellOptions(t *testing.T) {
+	rt, err := newFakeMonkey(`
+Resetter(name = "db", reset = "./db.sh reset", shell = "sh", exec_timeout = "5m")
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = "db",
+)`)
+	require.NoError(t, err)
+	p := rt.models["some_model"].GetResetter().ToProto().GetShell()
+	require.Equal(t, "./db.sh reset", p.GetRst())
+	require.Equal(t, "sh", p.GetShell())
+	require.Equal(t, int64(5*time.Minute), p.GetExecTimeoutNs())
+}
+
+func TestNamedResetterFunctionsTimeout(t *testing.T) {
+	rt, err := newFakeMonkey(`
+Resetter(name = "fixtures", reset = lambda: None, exec_timeout = "1m")
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = "fixtures",
+)`)
+	require.NoError(t, err)
+	p := rt.resetterInUse().ToProto().GetStarlark()
+	require.Equal(t, int64(time.Minute), p.GetExecTimeoutNs())
+}
+
+func TestNamedResetterErrors(t *testing.T) {
+	for code, expected := range map[string]string{
+		simplestPrelude[:len(simplestPrelude)-2] + `    resetter = "db",
+)`: `OpenAPIv3(resetter = ...): no resetter named "db", define it before the model`,
+
+		`Shell(name = "db", reset = "true")
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = "db", ExecReset = "true",
+)`: `OpenAPIv3(resetter = ...) cannot be combined with: ExecReset`,
+
+		`Shell(name = "db", reset = "true")
+` + simplestPrelude[:len(simplestPrelude)-2] + `    resetter = ["db", "db"],
+)`: `OpenAPIv3(resetter = ...) must not name "db" twice`,
+
+		simplestPrelude[:len(simplestPrelude)-2] + `    resetter = [],
+)`: `OpenAPIv3(resetter = ...) must not be empty`,
+
+		simplestPrelude[:len(simplestPrelude)-2] + `    resetter = 42,
+)`: `OpenAPIv3(resetter = ...) must be a string or a list of strings, got: int`,
+
+		`Shell(name = "db", reset = "true")
+Resetter(name = "db", reset = "true")`: `Resetter: resetter name "db" is already defined`,
+
+		`Resetter(name = "db", start = "true")`:                  `Resetter: start and stop must be set together`,
+		`Resetter(name = "db", reset = 42)`:                      `Resetter: for parameter "reset": got int, want string`,
+		`Resetter(reset = "true")`:                               `Resetter: missing argument for name`,
+		`Resetter(name = "db", start = lambda: 1)`:               `Resetter: start and stop must be set together`,
+		`Resetter(name = "db", reset = lambda: 1, shell = "sh")`: `Resetter: unexpected keyword argument "shell"`,
+		`HTTPResetter(name = 42, url = "http://a")`:              `HTTPResetter: name must be a string, got: int`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(code)
+			require.EqualError(t, err, expected)
+			require.Nil(t, rt)
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
 	"go.starlark.net/starlark"
+	"golang.org/x/net/http/httpproxy"
 )
 
 const localCfg = "fuzzymonkey.star"
@@ -28,9 +30,11 @@ type Runtime struct {
 	thread  *starlark.Thread
 	globals starlark.StringDict
 
-	envRead map[string]string // holds all the envs looked up on initial run
-	models  map[string]modeler.Interface
-	files   map[string]string
+	envRead   map[string]string // holds all the envs looked up on initial run
+	proxyEnvs *httpproxy.Config // holds HTTP proxying envs, also found in envRead
+	models    map[string]modeler.Interface
+	resetters map[string]resetter.Interface // named resetters models may share
+	files     map[string]string
 
 	checks      map[string]*check
 	checksNames []string
@@ -82,9 +86,10 @@ func NewMonkey(name string, arglabels []string) (rt *Runtime, err error) {
 	}
 
 	r := &Runtime{
-		binTitle: name,
-		files:    map[string]string{localCfg: string(localCfgContents)},
-		models:   make(map[string]modeler.Interface, 1),
+		binTitle:  name,
+		files:     map[string]string{localCfg: string(localCfgContents)},
+		models:    make(map[string]modeler.Interface, 1),
+		resetters: make(map[string]resetter.Interface),
 		thread: &starlark.Thread{
 			Name:  "cfg",
 			Load:  loadDisabled,
@@ -105,6 +110,7 @@ func NewMonkey(name string, arglabels []string) (rt *Runtime, err error) {
 	for t, b := range r.builtins() {
 		r.globals[t] = starlark.NewBuiltin(t, b)
 	}
+	r.globals["http"] = r.httpModule()
 
 	log.Println("[NFO] loading starlark config from", localCfg)
 	start := time.Now()
@@ -143,6 +149,13 @@ func (rt *Runtime) loadCfg() (err error) {
 		return
 	}
 
+	rt.proxyEnvs = rt.readProxyEnvs()
+	for _, mdl := range rt.models {
+		if pc, ok := mdl.(modeler.ProxyConfigurer); ok {
+			pc.SetProxyEnvs(rt.proxyEnvs)
+		}
+	}
+
 	log.Printf("[NFO] frozen envs: %d", len(rt.envRead))
 	for k, v := range rt.envRead {
 		log.Printf("[NFO] env frozen %q: %+v", k, v)
@@ -5,12 +5,9 @@ import (
 	"unicode"
 )
 
-func printableASCIItmp(s string) (reserved bool, err error) {
+func printableASCII(s string) (err error) {
 	l := 0
-	for i, c := range s {
-		if i == 0 && unicode.IsUpper(c) {
-			reserved = true
-		}
+	for _, c := range s {
 		if !(c <= unicode.MaxASCII && unicode.IsPrint(c)) {
 			err = fmt.Errorf("string contains non-ASCII or non-printable characters: %q", s)
 			return
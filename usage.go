@@ -2,17 +2,19 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/code"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 	docopt "github.com/docopt/docopt-go"
 	"github.com/mitchellh/mapstructure"
 )
 
 type params struct {
 	Env, Fmt, Fuzz, Lint, Logs, Schema bool
-	Pastseed                           bool
+	Init, Pastseed                     bool
 	Update, Version                    bool
 	Exec, Start, Reset, Stop, Repl     bool
 	FmtW                               bool          `mapstructure:"-w"`
@@ -25,6 +27,10 @@ type params struct {
 	LogOffset                          uint64        `mapstructure:"--previous"`
 	Progress                           string        `mapstructure:"--progress"`
 	ValidateAgainst                    string        `mapstructure:"--validate-against"`
+	FromHAR                            string        `mapstructure:"--from-har"`
+	FromProxy                          string        `mapstructure:"--from-proxy"`
+	Upstream                           string        `mapstructure:"--upstream"`
+	SpecOut                            string        `mapstructure:"--spec"`
 	Tags                               *string       `mapstructure:"--tags"`
 	TagsExcluded                       *string       `mapstructure:"--exclude-tags"`
 	OverallBudgetTime                  time.Duration `mapstructure:"--time-budget-overall"`
@@ -32,7 +38,6 @@ type params struct {
 
 func usage() (args *params, ret int) {
 	B := as.ColorNFO.Sprintf(binName)
-	// TODO: B [-vvv] init [--with-magic] Auto fill-in schemas from random API calls
 	// TODO: B [-vvv] login [--user=USER] Authenticate on fuzzymonkey.co as USER
 	// TODO:          fuzz [--shrink=ID] Which failed test to minimize
 	usage := binTitle + `
@@ -46,6 +51,8 @@ Usage:
                      [--only=REGEX]... [--except=REGEX]...
                      [--calls-with-input=SCHEMA]... [--calls-without-input=SCHEMA]...
                      [--calls-with-output=SCHEMA]... [--calls-without-output=SCHEMA]...
+                     [--operation=ID]... [--spec-tag=TAG]... [--where=EXPR]...
+  ` + B + ` [-vvv] init (--from-har=HAR | --from-proxy=ADDR --upstream=URL) [--spec=FILE]
   ` + B + ` [-vvv] lint [--show-spec]
   ` + B + ` [-vvv] fmt [-w]
   ` + B + ` [-vvv] schema [--validate-against=REF]
@@ -71,13 +78,23 @@ Options:
   --except=REGEX                  Do not test these calls
   --calls-with-input=SCHEMA       Test calls which can take schema PTR as input
   --calls-without-output=SCHEMA   Test calls which never output schema PTR
+  --operation=ID                  Test calls with this operationId
+  --spec-tag=TAG                  Test calls tagged TAG in the spec
+  --where=EXPR                    Test calls for which the Go template or JSONPath is True
   --validate-against=REF          Schema $ref to validate STDIN against
+  --from-har=HAR                  Infer a spec from the HTTP Archive HAR
+  --from-proxy=ADDR               Infer a spec from calls proxied from ADDR to --upstream
+  --upstream=URL                  Where to proxy calls to (e.g. http://localhost:3000)
+  --spec=FILE                     Where to write the inferred spec (defaults: openapi.yaml)
 
 Try:
      export FUZZYMONKEY_API_KEY=42
   ` + B + ` update
+  ` + B + ` init --from-proxy=localhost:8080 --upstream=http://localhost:3000
   ` + B + ` exec reset
   ` + B + ` fuzz --only /pets --calls-without-input=NewPet --seed=$(monkey pastseed)
+  ` + B + ` fuzz --spec-tag=pets --where='{{.Method}} == "GET"'
+  ` + B + ` fuzz --where='"Pet" in $.Outputs && $.Method != "DELETE"'
   echo '"kitty"' | ` + B + ` schema --validate-against=#/components/schemas/PetKind`
 
 	// https://github.com/docopt/docopt.go/issues/59
@@ -123,3 +140,41 @@ Try:
 
 	return
 }
+
+var endpointsCriteria = map[string]modeler.EndpointsCriterionKind{
+	"--only":                 modeler.Only,
+	"--except":               modeler.Except,
+	"--calls-with-input":     modeler.CallsWithInput,
+	"--calls-without-input":  modeler.CallsWithoutInput,
+	"--calls-with-output":    modeler.CallsWithOutput,
+	"--calls-without-output": modeler.CallsWithoutOutput,
+	"--operation":            modeler.Operation,
+	"--spec-tag":             modeler.SpecTag,
+	"--where":                modeler.Where,
+}
+
+// endpointsFilter reads endpoints selection criteria in command line order
+// as docopt does not keep track of the order of repeated options.
+func endpointsFilter(osArgs []string) *modeler.EndpointsFilter {
+	filter := &modeler.EndpointsFilter{}
+	for i := 0; i < len(osArgs); i++ {
+		flag, value := osArgs[i], ""
+		eq := strings.Index(flag, "=")
+		if eq != -1 {
+			flag, value = flag[:eq], flag[eq+1:]
+		}
+		kind, ok := endpointsCriteria[flag]
+		if !ok {
+			continue
+		}
+		if eq == -1 && i+1 < len(osArgs) {
+			i++
+			value = osArgs[i]
+		}
+		filter.Criteria = append(filter.Criteria, modeler.EndpointsCriterion{
+			Kind:  kind,
+			Value: value,
+		})
+	}
+	return filter
+}
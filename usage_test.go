@@ -0,0 +1,25 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointsFilterKeepsCommandLineOrder(t *testing.T) {
+	filter := endpointsFilter([]string{
+		"monkey", "-v", "fuzz",
+		"--except", "POST",
+		"--only=/pets",
+		"--seed=42",
+		"--where", `{{.Method}} == "GET"`,
+		"--operation=listPets",
+	})
+	require.Equal(t, []modeler.EndpointsCriterion{
+		{Kind: modeler.Except, Value: "POST"},
+		{Kind: modeler.Only, Value: "/pets"},
+		{Kind: modeler.Where, Value: `{{.Method}} == "GET"`},
+		{Kind: modeler.Operation, Value: "listPets"},
+	}, filter.Criteria)
+}